@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var flagApprovalDigest string
+
+var approveCmd = &cobra.Command{
+	Use:   "approve <namespace>/<repo>",
+	Short: "deploy the new digest a probe is waiting to have approved",
+	Args:  cobra.ExactArgs(1),
+	RunE:  approveHndlr,
+}
+
+var rejectCmd = &cobra.Command{
+	Use:   "reject <namespace>/<repo>",
+	Short: "skip the new digest a probe is waiting to have approved, keeping its current digest running",
+	Args:  cobra.ExactArgs(1),
+	RunE:  rejectHndlr,
+}
+
+var approvalsCmd = &cobra.Command{
+	Use:   "approvals",
+	Short: "list the probes waiting for a new digest to be approved",
+	Args:  cobra.NoArgs,
+	RunE:  approvalsHndlr,
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{approveCmd, rejectCmd} {
+		cmd.Flags().StringVar(&flagApprovalDigest, "digest", "", "Only act if this is the digest waiting to be approved")
+	}
+
+	addOutputFlags(approvalsCmd)
+	beaconctl.AddCommand(approveCmd, rejectCmd, approvalsCmd)
+}
+
+func approveHndlr(cmd *cobra.Command, args []string) error {
+	namespace, repo, err := parseProbeRef(args[0])
+
+	if err != nil {
+		return err
+	}
+
+	params := v1.NewApproveProbeParamsWithContext(cmd.Context()).
+		WithNamespace(namespace).
+		WithRepo(repo).
+		WithDecision(&models.ServerDecisionRequest{Digest: flagApprovalDigest})
+
+	response, err := beacondClient().V1.ApproveProbe(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
+
+func rejectHndlr(cmd *cobra.Command, args []string) error {
+	namespace, repo, err := parseProbeRef(args[0])
+
+	if err != nil {
+		return err
+	}
+
+	params := v1.NewRejectProbeParamsWithContext(cmd.Context()).
+		WithNamespace(namespace).
+		WithRepo(repo).
+		WithDecision(&models.ServerDecisionRequest{Digest: flagApprovalDigest})
+
+	response, err := beacondClient().V1.RejectProbe(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
+
+func approvalsHndlr(cmd *cobra.Command, args []string) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		response, err := beacondClient().V1.ListApprovals(v1.NewListApprovalsParamsWithContext(ctx))
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		approvals := response.GetPayload()
+
+		return view{response: approvals, table: func(out io.Writer, wide bool) error {
+			w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+			if wide {
+				fmt.Fprintln(w, "PROBE\tTAG\tDIGEST\tPUSHED\tCURRENT\tFOUND")
+			} else {
+				fmt.Fprintln(w, "PROBE\tTAG\tDIGEST\tPUSHED\tCURRENT")
+			}
+
+			for _, approval := range approvals.Approvals {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", approval.Probe, approval.Tag, formatDigest(approval.Digest, wide), approval.PushedAt, formatDigest(approval.CurrentDigest, wide))
+
+				if wide {
+					fmt.Fprintf(w, "\t%s", approval.FoundAt)
+				}
+
+				fmt.Fprintln(w)
+			}
+
+			return w.Flush()
+		}}, nil
+	})
+}
+
+// parseProbeRef splits a probe given as <namespace>/<repo>
+func parseProbeRef(ref string) (string, string, error) {
+	namespace, repo, ok := strings.Cut(ref, "/")
+
+	if !ok || namespace == "" || repo == "" {
+		return "", "", fmt.Errorf("expected the probe as <namespace>/<repo>, got %q", ref)
+	}
+
+	return namespace, repo, nil
+}
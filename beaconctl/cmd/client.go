@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"errors"
+
+	"beacon/beacond/client"
+	"beacon/beacond/models"
+)
+
+var flagBeacondHost string
+
+func init() {
+	beaconctl.PersistentFlags().StringVar(&flagBeacondHost, "host", "localhost:1323", "The host and port beacond is listening on")
+}
+
+// beacondClient creates a client for the beacond API at --host
+func beacondClient() *client.BeacondAPI {
+	return client.NewHTTPClientWithConfig(nil, client.DefaultTransportConfig().WithHost(flagBeacondHost))
+}
+
+// apiError turns an error response from beacond into an error carrying the message it returned
+func apiError(err error) error {
+	var response interface {
+		GetPayload() *models.ServerErrorResponse
+	}
+
+	if errors.As(err, &response) && response.GetPayload() != nil {
+		return errors.New(response.GetPayload().Message)
+	}
+
+	return err
+}
@@ -0,0 +1,413 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// composeService is a compose service translated into a probe, with the compose features that couldn't be mapped
+type composeService struct {
+	Name  string
+	Probe probeSpec
+	// The services the probe depends on, which are resolved to probes once every service has been translated
+	DependsOn []string
+	Unmapped  []string
+}
+
+// translateCompose turns the services in a compose file into probes. Services whose image can't be mapped to a
+// probe are left out, and everything that couldn't be mapped is returned as a note for the user. Each probe with ports
+// is routed by the proxy at <service>.<domain>
+func translateCompose(data []byte, domain string) ([]probeSpec, []string, error) {
+	var file map[string]yaml.Node
+
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, nil, fmt.Errorf("error reading compose file: %s", err)
+	}
+
+	var unmapped []string
+	var services map[string]map[string]yaml.Node
+
+	for _, key := range sortedKeys(file) {
+		node := file[key]
+
+		switch key {
+		case "version", "name":
+		case "services":
+			if err := node.Decode(&services); err != nil {
+				return nil, nil, fmt.Errorf("error reading services from compose file: %s", err)
+			}
+		case "volumes":
+			// Named volumes are created for the probes that mount them, so their top-level declarations aren't needed
+		default:
+			unmapped = append(unmapped, fmt.Sprintf("top-level %s are not supported", key))
+		}
+	}
+
+	if len(services) == 0 {
+		return nil, nil, fmt.Errorf("compose file has no services")
+	}
+
+	var translated []composeService
+	probeRefs := map[string]string{}
+	owners := map[string]string{}
+
+	for _, name := range sortedKeys(services) {
+		service, err := translateComposeService(name, services[name], domain)
+
+		if err != nil {
+			unmapped = append(unmapped, fmt.Sprintf("service %s: %s, so it was left out", name, err))
+			continue
+		}
+
+		probeRef := service.Probe.Namespace + "/" + service.Probe.Repo
+
+		if owner, ok := owners[probeRef]; ok {
+			unmapped = append(unmapped, fmt.Sprintf("service %s: its image is also used by service %s, and a repo can only have one probe, so it was left out", name, owner))
+			continue
+		}
+
+		owners[probeRef] = name
+		probeRefs[name] = probeRef
+		translated = append(translated, service)
+	}
+
+	var probes []probeSpec
+
+	for _, service := range translated {
+		for _, dependency := range service.DependsOn {
+			if probeRef, ok := probeRefs[dependency]; ok {
+				service.Probe.DependsOn = append(service.Probe.DependsOn, probeRef)
+			} else {
+				service.Unmapped = append(service.Unmapped, fmt.Sprintf("depends_on: service %s has no probe", dependency))
+			}
+		}
+
+		for _, note := range service.Unmapped {
+			unmapped = append(unmapped, fmt.Sprintf("service %s: %s", service.Name, note))
+		}
+
+		probes = append(probes, service.Probe)
+	}
+
+	return probes, unmapped, nil
+}
+
+// translateComposeService maps the keys of a compose service that beacon has an equivalent for onto a probe
+func translateComposeService(name string, service map[string]yaml.Node, domain string) (composeService, error) {
+	translated := composeService{Name: name}
+	image, ok := service["image"]
+
+	if !ok {
+		return translated, fmt.Errorf("it has no image, and beacon can only run images pushed to a registry")
+	}
+
+	var ref string
+
+	if err := image.Decode(&ref); err != nil {
+		return translated, fmt.Errorf("error reading image: %s", err)
+	}
+
+	namespace, repo, notes, err := parseComposeImage(ref)
+
+	if err != nil {
+		return translated, err
+	}
+
+	translated.Probe.Namespace = namespace
+	translated.Probe.Repo = repo
+	translated.Unmapped = append(translated.Unmapped, notes...)
+
+	// Compose services reach each other by service name, while probes are reached as <repo>.<namespace>
+	if alias := strings.ToLower(repo + "." + namespace); alias != strings.ToLower(name) {
+		translated.Unmapped = append(translated.Unmapped, fmt.Sprintf("other containers reach it as %s rather than %s", alias, name))
+	}
+
+	for _, key := range sortedKeys(service) {
+		node := service[key]
+		var err error
+
+		switch key {
+		case "image":
+		case "environment":
+			err = translated.environment(node)
+		case "ports":
+			err = translated.ports(node, domain)
+		case "volumes":
+			err = translated.volumes(node)
+		case "depends_on":
+			err = translated.dependsOn(node)
+		case "restart":
+			err = translated.restart(node)
+		default:
+			translated.Unmapped = append(translated.Unmapped, fmt.Sprintf("%s is not supported", key))
+		}
+
+		if err != nil {
+			return translated, fmt.Errorf("error reading %s: %s", key, err)
+		}
+	}
+
+	return translated, nil
+}
+
+// parseComposeImage splits an image reference into the namespace and repo of a probe. Docker Hub's official images
+// are in the library namespace
+func parseComposeImage(ref string) (string, string, []string, error) {
+	var notes []string
+
+	if name, digest, ok := strings.Cut(ref, "@"); ok {
+		notes = append(notes, fmt.Sprintf("image is pinned to %s, but beacon deploys each new digest pushed to the repo", digest))
+		ref = name
+	}
+
+	tag := ""
+
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		ref, tag = ref[:i], ref[i+1:]
+	}
+
+	parts := strings.Split(ref, "/")
+
+	// As in docker, the first part is a registry host if it looks like one
+	if len(parts) > 1 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		notes = append(notes, fmt.Sprintf("image is from %s, but beacond probes the registry it was started with", parts[0]))
+		parts = parts[1:]
+	}
+
+	var namespace, repo string
+
+	switch len(parts) {
+	case 1:
+		namespace, repo = "library", parts[0]
+	case 2:
+		namespace, repo = parts[0], parts[1]
+	default:
+		return "", "", nil, fmt.Errorf("image %s can't be mapped to a <namespace>/<repo>", ref)
+	}
+
+	if namespace == "" || repo == "" {
+		return "", "", nil, fmt.Errorf("image %s can't be mapped to a <namespace>/<repo>", ref)
+	}
+
+	if tag != "" && tag != "latest" {
+		notes = append(notes, fmt.Sprintf("tag %s is not followed, as beacon deploys the most recently pushed tag of %s/%s", tag, namespace, repo))
+	}
+
+	return namespace, repo, notes, nil
+}
+
+// environment reads environment variables given as a map or as a list of VARIABLE=value
+func (s *composeService) environment(node yaml.Node) error {
+	env := map[string]*string{}
+
+	if node.Kind == yaml.SequenceNode {
+		var list []string
+
+		if err := node.Decode(&list); err != nil {
+			return err
+		}
+
+		for _, v := range list {
+			if name, value, ok := strings.Cut(v, "="); ok {
+				env[name] = &value
+			} else {
+				env[v] = nil
+			}
+		}
+	} else if err := node.Decode(&env); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(env) {
+		value := env[name]
+
+		switch {
+		case value == nil:
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("environment: %s takes its value from the shell running compose, so it was left out", name))
+			continue
+		case strings.Contains(*value, "${"):
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("environment: %s is set to %q without substituting variables", name, *value))
+		}
+
+		if s.Probe.Env == nil {
+			s.Probe.Env = map[string]string{}
+		}
+
+		s.Probe.Env[name] = *value
+	}
+
+	return nil
+}
+
+// ports routes the first container port through the proxy. Beacon doesn't publish ports on the host
+func (s *composeService) ports(node yaml.Node, domain string) error {
+	var ports []yaml.Node
+
+	if err := node.Decode(&ports); err != nil {
+		return err
+	}
+
+	for _, port := range ports {
+		var target, protocol string
+
+		if port.Kind == yaml.MappingNode {
+			var long struct {
+				Target   string `yaml:"target"`
+				Protocol string `yaml:"protocol"`
+			}
+
+			if err := port.Decode(&long); err != nil {
+				return err
+			}
+
+			target, protocol = long.Target, long.Protocol
+		} else {
+			var short string
+
+			if err := port.Decode(&short); err != nil {
+				return err
+			}
+
+			short, protocol, _ = strings.Cut(short, "/")
+			target = short[strings.LastIndex(short, ":")+1:]
+		}
+
+		containerPort, err := strconv.ParseInt(target, 10, 64)
+
+		switch {
+		case protocol != "" && protocol != "tcp":
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("ports: %s/%s is not routed, as the proxy only serves HTTP", target, protocol))
+		case err != nil:
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("ports: %s is not routed, as only single ports are", target))
+		case s.Probe.Port != 0:
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("ports: %d is not routed, as a probe only has one route", containerPort))
+		default:
+			s.Probe.Port = containerPort
+			s.Probe.Host = fmt.Sprintf("%s.%s", strings.ToLower(s.Name), domain)
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("ports: %d is served by beacon's proxy for host %s rather than published on the host", containerPort, s.Probe.Host))
+		}
+	}
+
+	return nil
+}
+
+// volumes mounts the named volumes. Bind mounts and anonymous volumes have no equivalent
+func (s *composeService) volumes(node yaml.Node) error {
+	var volumes []yaml.Node
+
+	if err := node.Decode(&volumes); err != nil {
+		return err
+	}
+
+	for _, volume := range volumes {
+		var source, target string
+		readOnly := false
+
+		if volume.Kind == yaml.MappingNode {
+			var long struct {
+				Type     string `yaml:"type"`
+				Source   string `yaml:"source"`
+				Target   string `yaml:"target"`
+				ReadOnly bool   `yaml:"read_only"`
+			}
+
+			if err := volume.Decode(&long); err != nil {
+				return err
+			}
+
+			if long.Type != "volume" {
+				s.Unmapped = append(s.Unmapped, fmt.Sprintf("volumes: %s mount of %s is not supported", long.Type, long.Target))
+				continue
+			}
+
+			source, target, readOnly = long.Source, long.Target, long.ReadOnly
+		} else {
+			var short string
+
+			if err := volume.Decode(&short); err != nil {
+				return err
+			}
+
+			parts := strings.Split(short, ":")
+
+			if len(parts) == 1 {
+				target = parts[0]
+			} else {
+				source, target = parts[0], parts[1]
+				readOnly = len(parts) > 2 && strings.Contains(parts[2], "ro")
+			}
+		}
+
+		switch {
+		case source == "":
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("volumes: anonymous volume at %s is not supported", target))
+		case strings.ContainsAny(source[:1], "./~"):
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("volumes: bind mount of %s is not supported", source))
+		default:
+			if readOnly {
+				s.Unmapped = append(s.Unmapped, fmt.Sprintf("volumes: %s is mounted read write", source))
+			}
+
+			s.Probe.Volumes = append(s.Probe.Volumes, fmt.Sprintf("%s:%s", source, target))
+		}
+	}
+
+	return nil
+}
+
+// dependsOn reads the services depended on, given as a list or as a map with a condition for each
+func (s *composeService) dependsOn(node yaml.Node) error {
+	if node.Kind == yaml.SequenceNode {
+		return node.Decode(&s.DependsOn)
+	}
+
+	var dependencies map[string]struct {
+		Condition string `yaml:"condition"`
+	}
+
+	if err := node.Decode(&dependencies); err != nil {
+		return err
+	}
+
+	for _, name := range sortedKeys(dependencies) {
+		// A dependency has to be running, and healthy if it has a health check, before its dependents are started
+		if condition := dependencies[name].Condition; condition == "service_completed_successfully" {
+			s.Unmapped = append(s.Unmapped, fmt.Sprintf("depends_on: %s waits for %s to be running rather than to complete", condition, name))
+		}
+
+		s.DependsOn = append(s.DependsOn, name)
+	}
+
+	return nil
+}
+
+// restart checks the restart policy. Beacon always restarts containers that exit
+func (s *composeService) restart(node yaml.Node) error {
+	var policy string
+
+	if err := node.Decode(&policy); err != nil {
+		return err
+	}
+
+	if policy != "always" && policy != "unless-stopped" {
+		s.Unmapped = append(s.Unmapped, fmt.Sprintf("restart: %s is not supported, as beacon always restarts containers that exit", policy))
+	}
+
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ComposeSuite struct {
+	suite.Suite
+}
+
+func TestComposeSuite(t *testing.T) {
+	suite.Run(t, new(ComposeSuite))
+}
+
+func (c *ComposeSuite) TestServicesAreTranslatedToProbes() {
+	compose := `
+version: "3.8"
+services:
+  web:
+    image: myorg/web
+    restart: always
+    ports:
+      - "127.0.0.1:8080:80/tcp"
+    environment:
+      - DATABASE_URL=postgres://postgres.library/app
+    depends_on:
+      - postgres
+    volumes:
+      - uploads:/srv/uploads
+  postgres:
+    image: postgres
+    environment:
+      POSTGRES_PASSWORD: example
+    volumes:
+      - type: volume
+        source: pgdata
+        target: /var/lib/postgresql/data
+volumes:
+  pgdata:
+  uploads:
+`
+
+	probes, unmapped, err := translateCompose([]byte(compose), "example.com")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), []probeSpec{
+		{
+			Namespace: "library",
+			Repo:      "postgres",
+			Env:       map[string]string{"POSTGRES_PASSWORD": "example"},
+			Volumes:   []string{"pgdata:/var/lib/postgresql/data"},
+		},
+		{
+			Namespace: "myorg",
+			Repo:      "web",
+			Env:       map[string]string{"DATABASE_URL": "postgres://postgres.library/app"},
+			Host:      "web.example.com",
+			Port:      80,
+			Volumes:   []string{"uploads:/srv/uploads"},
+			DependsOn: []string{"library/postgres"},
+		},
+	}, probes)
+	assert.Equal(c.T(), []string{
+		"service postgres: other containers reach it as postgres.library rather than postgres",
+		"service web: other containers reach it as web.myorg rather than web",
+		"service web: ports: 80 is served by beacon's proxy for host web.example.com rather than published on the host",
+	}, unmapped)
+}
+
+func (c *ComposeSuite) TestUnmappableFeaturesAreReported() {
+	compose := `
+services:
+  app:
+    image: ghcr.io/myorg/app:1.2@sha256:abc
+    restart: on-failure
+    command: ["serve"]
+    volumes:
+      - ./config:/etc/app
+      - /var/cache/app
+    depends_on:
+      migrate:
+        condition: service_completed_successfully
+  migrate:
+    build: .
+  copy:
+    image: myorg/app
+networks:
+  backend:
+`
+
+	probes, unmapped, err := translateCompose([]byte(compose), "localhost")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), []probeSpec{{Namespace: "myorg", Repo: "app"}}, probes)
+	assert.Equal(c.T(), []string{
+		"top-level networks are not supported",
+		"service copy: its image is also used by service app, and a repo can only have one probe, so it was left out",
+		"service migrate: it has no image, and beacon can only run images pushed to a registry, so it was left out",
+		"service app: image is pinned to sha256:abc, but beacon deploys each new digest pushed to the repo",
+		"service app: image is from ghcr.io, but beacond probes the registry it was started with",
+		"service app: tag 1.2 is not followed, as beacon deploys the most recently pushed tag of myorg/app",
+		"service app: other containers reach it as app.myorg rather than app",
+		"service app: command is not supported",
+		"service app: depends_on: service_completed_successfully waits for migrate to be running rather than to complete",
+		"service app: restart: on-failure is not supported, as beacon always restarts containers that exit",
+		"service app: volumes: bind mount of ./config is not supported",
+		"service app: volumes: anonymous volume at /var/cache/app is not supported",
+		"service app: depends_on: service migrate has no probe",
+	}, unmapped)
+}
+
+func (c *ComposeSuite) TestImagesAreSplitIntoNamespaceAndRepo() {
+	for image, expected := range map[string][2]string{
+		"redis":                     {"library", "redis"},
+		"redis:7-alpine":            {"library", "redis"},
+		"myorg/app:latest":          {"myorg", "app"},
+		"localhost:5000/myorg/app":  {"myorg", "app"},
+		"registry.example.com/app":  {"library", "app"},
+		"myorg/app@sha256:0123abcd": {"myorg", "app"},
+	} {
+		namespace, repo, _, err := parseComposeImage(image)
+
+		assert.NoError(c.T(), err, image)
+		assert.Equal(c.T(), expected, [2]string{namespace, repo}, image)
+	}
+
+	_, _, _, err := parseComposeImage("quay.io/org/team/app")
+
+	assert.EqualError(c.T(), err, "image quay.io/org/team/app can't be mapped to a <namespace>/<repo>")
+}
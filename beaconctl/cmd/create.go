@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var flagCreateFile string
+
+var createCmd = &cobra.Command{
+	Use:       "create probe -f <file>",
+	Short:     "create the probes in a beacon config file",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: RESOURCES,
+	RunE:      createHndlr,
+}
+
+// beaconConfig is a beacon config file, listing probes to create
+type beaconConfig struct {
+	Probes []probeSpec `yaml:"probes"`
+}
+
+// probeSpec is a probe in a beacon config file. Its fields are named after those of the POST /v1/probes body
+type probeSpec struct {
+	Namespace string            `yaml:"namespace"`
+	Repo      string            `yaml:"repo"`
+	Env       map[string]string `yaml:"env,omitempty"`
+	Host      string            `yaml:"host,omitempty"`
+	Port      int64             `yaml:"port,omitempty"`
+	Volumes   []string          `yaml:"volumes,omitempty"`
+	DependsOn []string          `yaml:"depends_on,omitempty"`
+}
+
+func init() {
+	createCmd.Flags().StringVarP(&flagCreateFile, "file", "f", "", "The beacon config file listing the probes to create")
+	createCmd.MarkFlagRequired("file")
+
+	beaconctl.AddCommand(createCmd)
+}
+
+func createHndlr(cmd *cobra.Command, args []string) error {
+	if args[0] != "probe" {
+		return fmt.Errorf("only probes can be created, got %q", args[0])
+	}
+
+	data, err := os.ReadFile(flagCreateFile)
+
+	if err != nil {
+		return fmt.Errorf("error reading %s: %s", flagCreateFile, err)
+	}
+
+	var config beaconConfig
+
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("error reading %s: %s", flagCreateFile, err)
+	}
+
+	return createProbes(cmd, config.Probes)
+}
+
+// createProbes creates each of the probes, carrying on past those that fail
+func createProbes(cmd *cobra.Command, probes []probeSpec) error {
+	failed := 0
+
+	for _, probe := range probes {
+		message, err := createProbe(cmd.Context(), probe)
+
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s/%s: %s\n", probe.Namespace, probe.Repo, err)
+			failed++
+
+			continue
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), message)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d probes could not be created", failed, len(probes))
+	}
+
+	return nil
+}
+
+func createProbe(ctx context.Context, probe probeSpec) (string, error) {
+	body := &models.ServerCreateProbeRequest{
+		Namespace: &probe.Namespace,
+		Repo:      &probe.Repo,
+		Env:       probe.Env,
+		DependsOn: probe.DependsOn,
+	}
+
+	if probe.Host != "" || probe.Port != 0 {
+		body.Route = &models.ServerRouteRequest{Host: probe.Host, Port: probe.Port}
+	}
+
+	// Volumes are given as <name>:<path in container>, which beacond checks
+	for _, volume := range probe.Volumes {
+		name, target, _ := strings.Cut(volume, ":")
+		body.Volumes = append(body.Volumes, &models.ServerVolumeRefRequest{Name: name, Target: target})
+	}
+
+	response, err := beacondClient().V1.CreateProbe(v1.NewCreateProbeParamsWithContext(ctx).WithProbe(body))
+
+	if err != nil {
+		return "", apiError(err)
+	}
+
+	return response.GetPayload().Message, nil
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"beacon/beacond/client/v1"
+
+	"github.com/spf13/cobra"
+)
+
+var flagPurge bool
+
+var deleteCmd = &cobra.Command{
+	Use:       "delete probe <namespace>/<repo>",
+	Short:     "delete a probe, keeping its volumes unless --purge is given",
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: RESOURCES,
+	RunE:      deleteHndlr,
+}
+
+func init() {
+	deleteCmd.Flags().BoolVar(&flagPurge, "purge", false, "Also remove the probe's containers and volumes")
+
+	beaconctl.AddCommand(deleteCmd)
+}
+
+func deleteHndlr(cmd *cobra.Command, args []string) error {
+	if args[0] != "probe" {
+		return fmt.Errorf("only probes can be deleted, got %q", args[0])
+	}
+
+	namespace, repo, err := parseProbeRef(args[1])
+
+	if err != nil {
+		return err
+	}
+
+	params := v1.NewDeleteProbeParamsWithContext(cmd.Context()).
+		WithNamespace(namespace).
+		WithRepo(repo).
+		WithPurge(&flagPurge)
+
+	response, err := beacondClient().V1.DeleteProbe(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var describeCmd = &cobra.Command{
+	Use:       "describe probe <namespace>/<repo> | describe beacon",
+	Short:     "describe a probe, with its containers, events and latest deploy, or the beacon",
+	Args:      cobra.RangeArgs(1, 2),
+	ValidArgs: append(RESOURCES, "beacon"),
+	RunE:      describeHndlr,
+}
+
+func init() {
+	addOutputFlags(describeCmd)
+	beaconctl.AddCommand(describeCmd)
+}
+
+func describeHndlr(cmd *cobra.Command, args []string) error {
+	switch {
+	case args[0] == "beacon" && len(args) == 1:
+		return describeBeacon(cmd)
+	case args[0] == "probe" && len(args) == 2:
+		return describeProbe(cmd, args[1])
+	}
+
+	return fmt.Errorf("expected probe <namespace>/<repo> or beacon, got %q", strings.Join(args, " "))
+}
+
+func describeBeacon(cmd *cobra.Command) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		response, err := beacondClient().V1.GetBeacon(v1.NewGetBeaconParamsWithContext(ctx))
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		beacon := response.GetPayload()
+
+		return view{response: beacon, table: func(out io.Writer, wide bool) error {
+			printBeacon(out, beacon)
+			return nil
+		}}, nil
+	})
+}
+
+func describeProbe(cmd *cobra.Command, ref string) error {
+	namespace, repo, err := parseProbeRef(ref)
+
+	if err != nil {
+		return err
+	}
+
+	return render(cmd, func(ctx context.Context) (view, error) {
+		response, err := beacondClient().V1.GetProbe(v1.NewGetProbeParamsWithContext(ctx).WithNamespace(namespace).WithRepo(repo))
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		probe := response.GetPayload()
+
+		return view{response: probe, table: func(out io.Writer, wide bool) error {
+			printProbe(out, probe, wide)
+			return nil
+		}}, nil
+	})
+}
+
+// printBeacon prints the beacon as a list of its fields
+func printBeacon(out io.Writer, beacon *models.ServerBeaconDescribeResponse) {
+	fmt.Fprintf(out, "Registry:  %s\n", beacon.Registry)
+	fmt.Fprintf(out, "Runtime:   %s\n", beacon.Runtime)
+
+	if beacon.Capacity != nil && beacon.Allocated != nil {
+		fmt.Fprintf(out, "CPUs:      %.2f of %.2f allocated\n", beacon.Allocated.Cpus, beacon.Capacity.Cpus)
+		fmt.Fprintf(out, "Memory:    %s of %s allocated\n", formatSize(beacon.Allocated.Memory), formatSize(beacon.Capacity.Memory))
+	}
+
+	fmt.Fprintf(out, "Probes:    %s\n", strings.Join(beacon.Probes, ", "))
+}
+
+// printProbe prints a probe as a list of its fields, followed by tables of its containers, hooks and events. Wide
+// output shows container IDs and digests in full
+func printProbe(out io.Writer, probe *models.ServerProbeResponse, wide bool) {
+	fmt.Fprintf(out, "Probe:           %s\n", probe.Probe)
+	fmt.Fprintf(out, "Status:          %s\n", probe.Status)
+	fmt.Fprintf(out, "Replicas:        %d/%d running\n", probe.Running, probe.Replicas)
+	fmt.Fprintf(out, "Current digest:  %s\n", probe.CurrentDigest)
+	fmt.Fprintf(out, "Latest digest:   %s\n", probe.LatestDigest)
+	fmt.Fprintf(out, "Last checked:    %s\n", probe.LastChecked)
+	fmt.Fprintf(out, "Last updated:    %s\n", probe.LastUpdated)
+	fmt.Fprintf(out, "Restarts:        %d\n", probe.Restarts)
+
+	if probe.Soaking != nil {
+		fmt.Fprintf(out, "Soaking:         %s (%s) since %s\n", probe.Soaking.Digest, probe.Soaking.Tag, probe.SoakingSince)
+	}
+
+	if probe.Candidate != nil {
+		fmt.Fprintf(out, "Awaiting:        %s (%s), pushed %s\n", probe.Candidate.Digest, probe.Candidate.Tag, probe.Candidate.PushedAt)
+	}
+
+	if probe.RejectedDigest != "" {
+		fmt.Fprintf(out, "Rejected digest: %s\n", probe.RejectedDigest)
+	}
+
+	if probe.LastError != nil {
+		fmt.Fprintf(out, "Last error:      %s: %s (%s)\n", probe.LastError.Reason, probe.LastError.Message, probe.LastError.LastSeen)
+	}
+
+	fmt.Fprintln(out, "\nContainers:")
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "  REPLICA\tCONTAINER\tDIGEST\tSTATUS\tHEALTH\tSTARTED")
+
+	for _, container := range probe.Containers {
+		status := container.Status
+
+		if container.Error != "" {
+			status = "unknown: " + container.Error
+		}
+
+		fmt.Fprintf(w, "  %d\t%s\t%s\t%s\t%s\t%s\n", container.Replica, formatDigest(container.ContainerID, wide), formatDigest(container.Digest, wide), status, container.Health, container.StartedAt)
+	}
+
+	w.Flush()
+
+	if deploy := probe.LastDeploy; deploy != nil {
+		result := "succeeded"
+
+		if !deploy.Succeeded {
+			result = "failed"
+		}
+
+		fmt.Fprintf(out, "\nLast deploy:     %s of %s at %s\n", result, deploy.Digest, deploy.FinishedAt)
+
+		if deploy.Error != "" {
+			fmt.Fprintf(out, "Error:           %s\n", deploy.Error)
+		}
+
+		if len(deploy.Hooks) > 0 {
+			w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+			fmt.Fprintln(w, "  HOOK\tPHASE\tEXIT CODE\tFINISHED\tERROR")
+
+			for _, hook := range deploy.Hooks {
+				fmt.Fprintf(w, "  %s\t%s\t%d\t%s\t%s\n", hook.Name, hook.Phase, hook.ExitCode, hook.FinishedAt, hook.Error)
+			}
+
+			w.Flush()
+		}
+	}
+
+	fmt.Fprintln(out, "\nEvents:")
+
+	w = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "  LAST SEEN\tREASON\tCOUNT\tMESSAGE")
+
+	for _, event := range probe.Events {
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%s\n", event.LastSeen, event.Reason, event.Count, event.Message)
+	}
+
+	w.Flush()
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var flagImportOutput string
+var flagImportDomain string
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "create probes from another tool's configuration",
+}
+
+var importComposeCmd = &cobra.Command{
+	Use:   "compose <file>",
+	Short: "create a probe for each service in a docker-compose file, reporting what couldn't be mapped",
+	Args:  cobra.ExactArgs(1),
+	RunE:  importComposeHndlr,
+}
+
+func init() {
+	importComposeCmd.Flags().StringVarP(&flagImportOutput, "output", "o", "", "Write the probes to this beacon config file instead of creating them (- for stdout)")
+	importComposeCmd.Flags().StringVar(&flagImportDomain, "domain", "localhost", "Services with ports are routed by beacon's proxy at <service>.<domain>")
+
+	importCmd.AddCommand(importComposeCmd)
+	beaconctl.AddCommand(importCmd)
+}
+
+func importComposeHndlr(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+
+	if err != nil {
+		return fmt.Errorf("error reading %s: %s", args[0], err)
+	}
+
+	probes, unmapped, err := translateCompose(data, flagImportDomain)
+
+	if err != nil {
+		return err
+	}
+
+	if len(unmapped) > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Some of %s could not be mapped to probes:\n", args[0])
+
+		for _, note := range unmapped {
+			fmt.Fprintf(cmd.ErrOrStderr(), "  - %s\n", note)
+		}
+	}
+
+	if flagImportOutput == "" {
+		return createProbes(cmd, probes)
+	}
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "# Imported from %s. Create the probes with: beaconctl create probe -f <this file>\n", args[0])
+
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(beaconConfig{Probes: probes}); err != nil {
+		return err
+	}
+
+	if flagImportOutput == "-" {
+		_, err = cmd.OutOrStdout().Write(out.Bytes())
+
+		return err
+	}
+
+	if err := os.WriteFile(flagImportOutput, out.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %s", flagImportOutput, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Wrote %d probe(s) to %s\n", len(probes), flagImportOutput)
+
+	return nil
+}
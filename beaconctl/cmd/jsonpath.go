@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a template that prints values picked out of a JSON document. It supports the subset of kubectl's
+// JSONPath most scripts need: text with expressions in braces, such as {.probes[0].status}, {.probes[*].probe} or
+// {"\n"}. Keys that are missing print nothing rather than failing, as they do with kubectl
+type jsonPath struct {
+	parts []templatePart
+}
+
+// templatePart is either text printed as is, or the path to the values printed in its place
+type templatePart struct {
+	text string
+	path []pathStep
+}
+
+// pathStep picks a field of an object, an element of an array, or every field or element
+type pathStep struct {
+	field   string
+	index   int
+	isIndex bool
+	all     bool
+}
+
+// parseJSONPath parses a template such as {.probe}{"\t"}{.status}
+func parseJSONPath(template string) (*jsonPath, error) {
+	j := &jsonPath{}
+	rest := template
+
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+
+		if start < 0 {
+			j.parts = append(j.parts, templatePart{text: rest})
+			break
+		}
+
+		if start > 0 {
+			j.parts = append(j.parts, templatePart{text: rest[:start]})
+		}
+
+		rest = rest[start+1:]
+
+		// A quoted string can hold braces of its own, so it is read up to its closing quote rather than the next brace
+		if trimmed := strings.TrimSpace(rest); strings.HasPrefix(trimmed, `"`) {
+			literal, err := strconv.QuotedPrefix(trimmed)
+
+			if err != nil {
+				return nil, fmt.Errorf("unterminated string in jsonpath template %q", template)
+			}
+
+			after := strings.TrimSpace(trimmed[len(literal):])
+
+			if !strings.HasPrefix(after, "}") {
+				return nil, fmt.Errorf("expected } after %s in jsonpath template %q", literal, template)
+			}
+
+			text, _ := strconv.Unquote(literal)
+			j.parts = append(j.parts, templatePart{text: text})
+			rest = after[1:]
+
+			continue
+		}
+
+		end := strings.IndexByte(rest, '}')
+
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed { in jsonpath template %q", template)
+		}
+
+		path, err := parsePath(strings.TrimSpace(rest[:end]))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid jsonpath template %q: %w", template, err)
+		}
+
+		j.parts = append(j.parts, templatePart{path: path})
+		rest = rest[end+1:]
+	}
+
+	return j, nil
+}
+
+// parsePath parses an expression such as .probes[*].probe or $.total. An expression of just . is the whole document
+func parsePath(expr string) ([]pathStep, error) {
+	expr = strings.TrimPrefix(expr, "$")
+	path := []pathStep{}
+
+	if expr == "." || expr == "" {
+		return path, nil
+	}
+
+	for expr != "" {
+		switch expr[0] {
+		case '.':
+			end := strings.IndexAny(expr[1:], ".[")
+
+			if end < 0 {
+				end = len(expr) - 1
+			}
+
+			name := expr[1 : end+1]
+			expr = expr[end+1:]
+
+			if name == "" {
+				return nil, fmt.Errorf("expected a field name after .")
+			}
+
+			path = append(path, pathStep{field: name, all: name == "*"})
+		case '[':
+			end := strings.IndexByte(expr, ']')
+
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [")
+			}
+
+			subscript := strings.TrimSpace(expr[1:end])
+			expr = expr[end+1:]
+
+			if subscript == "*" {
+				path = append(path, pathStep{all: true})
+				continue
+			}
+
+			if name, err := strconv.Unquote(strings.ReplaceAll(subscript, "'", `"`)); err == nil {
+				path = append(path, pathStep{field: name})
+				continue
+			}
+
+			index, err := strconv.Atoi(subscript)
+
+			if err != nil {
+				return nil, fmt.Errorf("expected an index, * or a quoted field name in [], got %q", subscript)
+			}
+
+			path = append(path, pathStep{index: index, isIndex: true})
+		default:
+			return nil, fmt.Errorf("expected . or [ at %q", expr)
+		}
+	}
+
+	return path, nil
+}
+
+// Execute prints the template for the value, which is marshalled to JSON first so that the field names are those
+// of its JSON encoding
+func (j *jsonPath) Execute(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+
+	if err != nil {
+		return "", err
+	}
+
+	var document interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+
+	for _, part := range j.parts {
+		if part.path == nil {
+			out.WriteString(part.text)
+			continue
+		}
+
+		values := []interface{}{document}
+
+		for _, step := range part.path {
+			values = step.apply(values)
+		}
+
+		printed := make([]string, 0, len(values))
+
+		for _, v := range values {
+			s, err := printValue(v)
+
+			if err != nil {
+				return "", err
+			}
+
+			printed = append(printed, s)
+		}
+
+		out.WriteString(strings.Join(printed, " "))
+	}
+
+	return out.String(), nil
+}
+
+// apply picks the step out of each of the values, dropping those it doesn't match
+func (s pathStep) apply(values []interface{}) []interface{} {
+	picked := []interface{}{}
+
+	for _, value := range values {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if s.all {
+				keys := make([]string, 0, len(v))
+
+				for key := range v {
+					keys = append(keys, key)
+				}
+
+				sort.Strings(keys)
+
+				for _, key := range keys {
+					picked = append(picked, v[key])
+				}
+			} else if field, ok := v[s.field]; ok && !s.isIndex {
+				picked = append(picked, field)
+			}
+		case []interface{}:
+			index := s.index
+
+			if index < 0 {
+				index += len(v)
+			}
+
+			if s.all {
+				picked = append(picked, v...)
+			} else if s.isIndex && index >= 0 && index < len(v) {
+				picked = append(picked, v[index])
+			}
+		}
+	}
+
+	return picked
+}
+
+// printValue prints strings and numbers as they are, nothing for null and anything else as compact JSON
+func printValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+
+	encoded, err := json.Marshal(value)
+
+	return string(encoded), err
+}
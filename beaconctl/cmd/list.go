@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var flagListStatus []string
+var flagListSort string
+var flagListLimit int64
+var flagListOffset int64
+
+var listCmd = &cobra.Command{
+	Use:       "list probe",
+	Short:     "list probes with their status, replicas and digests",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: RESOURCES,
+	RunE:      listHndlr,
+}
+
+func init() {
+	listCmd.Flags().StringSliceVar(&flagListStatus, "status", nil, "Only list probes with this status, such as exited. Can be repeated")
+	listCmd.Flags().StringVar(&flagListSort, "sort", "name", "Sort by name, status, last_checked, last_updated or restarts, prefixed with - to reverse the order")
+	listCmd.Flags().Int64Var(&flagListLimit, "limit", 0, "The most probes to list (all of them if 0)")
+	listCmd.Flags().Int64Var(&flagListOffset, "offset", 0, "The number of probes to skip")
+	addOutputFlags(listCmd)
+
+	beaconctl.AddCommand(listCmd)
+}
+
+func listHndlr(cmd *cobra.Command, args []string) error {
+	if args[0] != "probe" {
+		return fmt.Errorf("only probes can be listed, got %q", args[0])
+	}
+
+	return render(cmd, func(ctx context.Context) (view, error) {
+		params := v1.NewListProbesParamsWithContext(ctx).
+			WithStatus(flagListStatus).
+			WithSort(&flagListSort)
+
+		if flagListLimit != 0 {
+			params.SetLimit(&flagListLimit)
+		}
+
+		if flagListOffset != 0 {
+			params.SetOffset(&flagListOffset)
+		}
+
+		response, err := beacondClient().V1.ListProbes(params)
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		page := response.GetPayload()
+
+		return view{response: page, table: func(out io.Writer, wide bool) error {
+			if err := printProbeTable(out, page.Probes, wide); err != nil {
+				return err
+			}
+
+			if int64(len(page.Probes)) < page.Total {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%d of %d probes listed, from offset %d\n", len(page.Probes), page.Total, page.Offset)
+			}
+
+			return nil
+		}}, nil
+	})
+}
+
+// printProbeTable prints a row for each probe. Wide output adds when they were last checked and their last error,
+// and shows digests in full
+func printProbeTable(out io.Writer, probes []*models.ServerProbeSummaryResponse, wide bool) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	if wide {
+		fmt.Fprintln(w, "PROBE\tSTATUS\tREPLICAS\tCURRENT\tLATEST\tRESTARTS\tUPDATED\tCHECKED\tLAST ERROR")
+	} else {
+		fmt.Fprintln(w, "PROBE\tSTATUS\tREPLICAS\tCURRENT\tLATEST\tRESTARTS\tUPDATED")
+	}
+
+	for _, probe := range probes {
+		fmt.Fprintf(w, "%s\t%s\t%d/%d\t%s\t%s\t%d\t%s", probe.Probe, probe.Status, probe.Running, probe.Replicas, formatDigest(probe.CurrentDigest, wide), formatDigest(probe.LatestDigest, wide), probe.Restarts, probe.LastUpdated)
+
+		if wide {
+			lastError := ""
+
+			if probe.LastError != nil {
+				lastError = probe.LastError.Reason + ": " + probe.LastError.Message
+			}
+
+			fmt.Fprintf(w, "\t%s\t%s", probe.LastChecked, lastError)
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	return w.Flush()
+}
+
+// formatDigest shows a digest in full in wide output, and shortened otherwise
+func formatDigest(digest string, wide bool) string {
+	if wide {
+		return digest
+	}
+
+	return shortDigest(digest)
+}
+
+// shortDigest shortens a digest to the first 12 characters of its hash, as docker does for image IDs
+func shortDigest(digest string) string {
+	_, hash, ok := strings.Cut(digest, ":")
+
+	if !ok {
+		hash = digest
+	}
+
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+
+	return hash
+}
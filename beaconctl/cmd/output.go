@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// The formats read commands can print what they get from beacond in
+const (
+	outputTable    = "table"
+	outputWide     = "wide"
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputJSONPath = "jsonpath"
+)
+
+var flagOutput string
+var flagWatch bool
+var flagWatchInterval time.Duration
+
+// view is what a read command got from beacond. JSON, YAML and JSONPath output print the response as beacond sent
+// it, while table prints it for people to read, with more columns when wide
+type view struct {
+	response interface{}
+	table    func(out io.Writer, wide bool) error
+}
+
+// printer prints views in one of the output formats
+type printer struct {
+	print func(out io.Writer, v view) error
+	// Printed between one view and the next when watching
+	separator string
+}
+
+// addOutputFlags gives read commands --output and --watch
+func addOutputFlags(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		cmd.Flags().StringVarP(&flagOutput, "output", "o", outputTable, "Print as table, wide, json, yaml or jsonpath=<template>")
+		cmd.Flags().BoolVarP(&flagWatch, "watch", "w", false, "Keep watching, printing again whenever something changes")
+		cmd.Flags().DurationVar(&flagWatchInterval, "watch-interval", 2*time.Second, "How often beacond is checked for changes when watching")
+	}
+}
+
+// render prints what fetch gets from beacond in the format given by --output, and keeps doing so as it changes
+// with --watch
+func render(cmd *cobra.Command, fetch func(context.Context) (view, error)) error {
+	p, err := newPrinter(flagOutput, cmd.OutOrStdout())
+
+	if err != nil {
+		return err
+	}
+
+	if flagWatch && flagWatchInterval <= 0 {
+		return fmt.Errorf("--watch-interval must be positive, got %s", flagWatchInterval)
+	}
+
+	v, err := fetch(cmd.Context())
+
+	if err != nil {
+		return err
+	}
+
+	if !flagWatch {
+		return p.print(cmd.OutOrStdout(), v)
+	}
+
+	return watch(cmd, fetch, p, v)
+}
+
+// watch prints the view whenever beacond's response to it changes, until beaconctl is interrupted. Failing to reach
+// beacond is reported without giving up, so that watching outlasts a restart of beacond
+func watch(cmd *cobra.Command, fetch func(context.Context) (view, error), p printer, v view) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(flagWatchInterval)
+	defer ticker.Stop()
+
+	out := cmd.OutOrStdout()
+	var last []byte
+
+	for {
+		current, err := json.Marshal(v.response)
+
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(current, last) {
+			if last != nil {
+				fmt.Fprint(out, p.separator)
+			}
+
+			if err := p.print(out, v); err != nil {
+				return err
+			}
+
+			last = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := fetch(ctx)
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s\n", err)
+			continue
+		}
+
+		v = next
+	}
+}
+
+// newPrinter creates the printer for an --output format
+func newPrinter(format string, out io.Writer) (printer, error) {
+	name, template, hasTemplate := strings.Cut(format, "=")
+
+	switch {
+	case format == outputTable || format == outputWide:
+		wide := format == outputWide
+		separator := "\n"
+
+		// Tables are redrawn in place in a terminal, and follow each other otherwise
+		if isTerminal(out) {
+			separator = "\033[H\033[2J"
+		}
+
+		return printer{
+			print:     func(out io.Writer, v view) error { return v.table(out, wide) },
+			separator: separator,
+		}, nil
+	case format == outputJSON:
+		return printer{print: printJSON}, nil
+	case format == outputYAML:
+		return printer{print: printYAML, separator: "---\n"}, nil
+	case name == outputJSONPath && hasTemplate && template != "":
+		j, err := parseJSONPath(template)
+
+		if err != nil {
+			return printer{}, err
+		}
+
+		return printer{print: func(out io.Writer, v view) error {
+			printed, err := j.Execute(v.response)
+
+			if err != nil {
+				return err
+			}
+
+			if !strings.HasSuffix(printed, "\n") {
+				printed += "\n"
+			}
+
+			_, err = fmt.Fprint(out, printed)
+
+			return err
+		}}, nil
+	case name == outputJSONPath:
+		return printer{}, fmt.Errorf("jsonpath output needs a template, such as -o jsonpath='{.probes[*].probe}'")
+	}
+
+	return printer{}, fmt.Errorf("output must be one of table, wide, json, yaml or jsonpath=<template>, got %q", format)
+}
+
+// printJSON prints the response indented, in the order of its fields
+func printJSON(out io.Writer, v view) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(v.response)
+}
+
+// printYAML prints the response as YAML with the same fields as its JSON, in the same order. The JSON is decoded
+// into a YAML node, which keeps the order of its keys, rather than a map, which wouldn't
+func printYAML(out io.Writer, v view) error {
+	encoded, err := json.Marshal(v.response)
+
+	if err != nil {
+		return err
+	}
+
+	var document yaml.Node
+
+	if err := yaml.Unmarshal(encoded, &document); err != nil {
+		return err
+	}
+
+	blockStyle(&document)
+
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// blockStyle clears the flow style and quoting the node was decoded from JSON with, so that it is encoded as
+// block style YAML
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// isTerminal reports whether the output is written to a terminal
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
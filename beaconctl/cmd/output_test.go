@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutputSuite struct {
+	suite.Suite
+}
+
+func TestOutputSuite(t *testing.T) {
+	suite.Run(t, new(OutputSuite))
+}
+
+// probeList is a view of a page of probes, with a table listing their names
+func (o *OutputSuite) probeList() view {
+	page := &models.ServerProbeListResponse{
+		Probes: []*models.ServerProbeSummaryResponse{
+			{Probe: "myorg/api", Namespace: "myorg", Repo: "api", Status: "probing", Replicas: 2, Running: 2},
+			{Probe: "myorg/web", Namespace: "myorg", Repo: "web", Status: "exited", LastError: &models.ServerEventResponse{Reason: "RolloutFailed", Count: 1}},
+		},
+		Total: 2,
+	}
+
+	return view{response: page, table: func(out io.Writer, wide bool) error {
+		for _, probe := range page.Probes {
+			fmt.Fprintln(out, probe.Probe)
+		}
+
+		return nil
+	}}
+}
+
+// print prints the view in the format
+func (o *OutputSuite) print(format string, v view) (string, error) {
+	var out bytes.Buffer
+
+	p, err := newPrinter(format, &out)
+
+	if err != nil {
+		return "", err
+	}
+
+	err = p.print(&out, v)
+
+	return out.String(), err
+}
+
+func (o *OutputSuite) TestJSONPath() {
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{`{.total}`, "2\n"},
+		{`{.probes[*].probe}`, "myorg/api myorg/web\n"},
+		{`{.probes[-1].last_error.reason}`, "RolloutFailed\n"},
+		{`{$.probes[0]['status']}`, "probing\n"},
+		{`{.probes[0].last_error.reason}`, "\n"},
+		{`{.probes[5].probe}`, "\n"},
+		{`{.probes[0].replicas}/{.probes[0].running}{"\n"}`, "2/2\n"},
+		{`{.probes[1].last_error}`, `{"count":1,"first_seen":"","last_seen":"","message":"","reason":"RolloutFailed"}` + "\n"},
+		{`probes: {.probes[*].status} {"}"}`, "probes: probing exited }\n"},
+	}
+
+	for _, test := range tests {
+		printed, err := o.print("jsonpath="+test.template, o.probeList())
+
+		assert.NoError(o.T(), err, test.template)
+		assert.Equal(o.T(), test.expected, printed, test.template)
+	}
+
+	for _, template := range []string{"{.probes", "{probes}", "{.probes[first]}", `{"\n}`, "{.probes.}"} {
+		_, err := parseJSONPath(template)
+
+		assert.Error(o.T(), err, template)
+	}
+}
+
+func (o *OutputSuite) TestFormats() {
+	printed, err := o.print(outputTable, o.probeList())
+
+	assert.NoError(o.T(), err)
+	assert.Equal(o.T(), "myorg/api\nmyorg/web\n", printed)
+
+	printed, err = o.print(outputJSON, view{response: &models.ServerSecretResponse{Name: "db-password"}})
+
+	assert.NoError(o.T(), err)
+	assert.Equal(o.T(), `{
+  "name": "db-password",
+  "updated_at": "",
+  "used_by": null
+}
+`, printed)
+
+	// Fields keep the order of the JSON rather than being sorted
+	printed, err = o.print(outputYAML, view{response: &models.ServerVolumeResponse{Name: "data", Probe: "myorg/api", Size: -1, Volume: "123"}})
+
+	assert.NoError(o.T(), err)
+	assert.Equal(o.T(), `created_at: ""
+mountpoint: ""
+name: data
+probe: myorg/api
+probe_exists: false
+size: -1
+volume: "123"
+`, printed)
+
+	for _, format := range []string{"xml", "jsonpath", "jsonpath=", "jsonpath={.probes"} {
+		_, err := o.print(format, o.probeList())
+
+		assert.Error(o.T(), err, format)
+	}
+}
+
+func (o *OutputSuite) TestWatchPrintsChanges() {
+	defer func(output string, watch bool, interval time.Duration) {
+		flagOutput, flagWatch, flagWatchInterval = output, watch, interval
+	}(flagOutput, flagWatch, flagWatchInterval)
+
+	flagOutput, flagWatch, flagWatchInterval = "jsonpath={.status}", true, time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out, errOut bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+
+	statuses := []string{"starting", "starting", "probing", "", "probing", "exited"}
+	fetched := 0
+
+	err := render(cmd, func(context.Context) (view, error) {
+		status := statuses[fetched]
+		fetched++
+
+		if fetched == len(statuses) {
+			cancel()
+		}
+
+		if status == "" {
+			return view{}, fmt.Errorf("fake error")
+		}
+
+		return view{response: &models.ServerProbeResponse{Status: status}}, nil
+	})
+
+	assert.NoError(o.T(), err)
+	assert.Equal(o.T(), "starting\nprobing\n", out.String())
+	assert.Equal(o.T(), "fake error\n", errOut.String())
+}
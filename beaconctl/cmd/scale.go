@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var flagReplicas int
+
+var scaleCmd = &cobra.Command{
+	Use:       "scale probe <namespace>/<repo> --replicas N",
+	Short:     "change the number of containers run for a probe",
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: RESOURCES,
+	RunE:      scaleHndlr,
+}
+
+func init() {
+	scaleCmd.Flags().IntVar(&flagReplicas, "replicas", 0, "The number of containers to run for the probe")
+	scaleCmd.MarkFlagRequired("replicas")
+
+	beaconctl.AddCommand(scaleCmd)
+}
+
+func scaleHndlr(cmd *cobra.Command, args []string) error {
+	if args[0] != "probe" {
+		return fmt.Errorf("only probes can be scaled, got %q", args[0])
+	}
+
+	namespace, repo, err := parseProbeRef(args[1])
+
+	if err != nil {
+		return err
+	}
+
+	if flagReplicas < 1 {
+		return fmt.Errorf("--replicas must be at least 1, got %d", flagReplicas)
+	}
+
+	replicas := int64(flagReplicas)
+	params := v1.NewUpdateProbeParamsWithContext(cmd.Context()).
+		WithNamespace(namespace).
+		WithRepo(repo).
+		WithUpdate(&models.ServerUpdateProbeRequest{Replicas: &replicas})
+
+	response, err := beacondClient().V1.UpdateProbe(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
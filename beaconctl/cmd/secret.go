@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var flagSecretFromFile string
+
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "manage the secrets beacond can inject into probes' containers",
+}
+
+var secretSetCmd = &cobra.Command{
+	Use:   "set <name>",
+	Short: "create or replace a secret, reading its value from stdin or --from-file",
+	Args:  cobra.ExactArgs(1),
+	RunE:  secretSetHndlr,
+}
+
+var secretGetCmd = &cobra.Command{
+	Use:   "get <name>",
+	Short: "describe a secret. Its value is never shown",
+	Args:  cobra.ExactArgs(1),
+	RunE:  secretGetHndlr,
+}
+
+var secretListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the names of all secrets",
+	Args:  cobra.NoArgs,
+	RunE:  secretListHndlr,
+}
+
+var secretDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "delete a secret that no probe references",
+	Args:  cobra.ExactArgs(1),
+	RunE:  secretDeleteHndlr,
+}
+
+func init() {
+	secretSetCmd.Flags().StringVar(&flagSecretFromFile, "from-file", "", "Read the secret's value from this file instead of stdin")
+
+	addOutputFlags(secretGetCmd, secretListCmd)
+
+	secretCmd.AddCommand(secretSetCmd, secretGetCmd, secretListCmd, secretDeleteCmd)
+	beaconctl.AddCommand(secretCmd)
+}
+
+func secretSetHndlr(cmd *cobra.Command, args []string) error {
+	var value []byte
+	var err error
+
+	if flagSecretFromFile != "" {
+		value, err = os.ReadFile(flagSecretFromFile)
+	} else {
+		value, err = io.ReadAll(cmd.InOrStdin())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Values piped in with echo end with a newline that isn't meant to be part of the secret
+	if flagSecretFromFile == "" {
+		value = []byte(strings.TrimRight(string(value), "\r\n"))
+	}
+
+	params := v1.NewSetSecretParamsWithContext(cmd.Context()).
+		WithName(args[0]).
+		WithSecret(&models.ServerSetSecretRequest{Value: stringPtr(string(value))})
+
+	replaced, created, err := beacondClient().V1.SetSecret(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	if created != nil {
+		fmt.Fprintln(cmd.OutOrStdout(), created.GetPayload().Message)
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), replaced.GetPayload().Message)
+	}
+
+	return nil
+}
+
+func secretGetHndlr(cmd *cobra.Command, args []string) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		params := v1.NewGetSecretParamsWithContext(ctx).WithName(args[0])
+
+		response, err := beacondClient().V1.GetSecret(params)
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		secret := response.GetPayload()
+
+		return view{response: secret, table: func(out io.Writer, wide bool) error {
+			fmt.Fprintf(out, "Name:       %s\n", secret.Name)
+			fmt.Fprintf(out, "Updated at: %s\n", secret.UpdatedAt)
+			fmt.Fprintf(out, "Used by:    %s\n", strings.Join(secret.UsedBy, ", "))
+
+			return nil
+		}}, nil
+	})
+}
+
+func secretListHndlr(cmd *cobra.Command, args []string) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		response, err := beacondClient().V1.ListSecrets(v1.NewListSecretsParamsWithContext(ctx))
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		secrets := response.GetPayload()
+
+		return view{response: secrets, table: func(out io.Writer, wide bool) error {
+			fmt.Fprintln(out, "NAME")
+
+			for _, name := range secrets.Secrets {
+				fmt.Fprintln(out, name)
+			}
+
+			return nil
+		}}, nil
+	})
+}
+
+func secretDeleteHndlr(cmd *cobra.Command, args []string) error {
+	params := v1.NewDeleteSecretParamsWithContext(cmd.Context()).WithName(args[0])
+
+	response, err := beacondClient().V1.DeleteSecret(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"beacon/beacond/client/v1"
+	"beacon/beacond/models"
+
+	"github.com/spf13/cobra"
+)
+
+var volumeCmd = &cobra.Command{
+	Use:   "volume",
+	Short: "manage the volumes beacond creates for probes",
+}
+
+var volumeListCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "list the volumes created for probes, with their size and the probe that owns them",
+	Args:    cobra.NoArgs,
+	RunE:    volumeListHndlr,
+}
+
+var volumeInspectCmd = &cobra.Command{
+	Use:   "inspect <name>",
+	Short: "describe a volume",
+	Args:  cobra.ExactArgs(1),
+	RunE:  volumeInspectHndlr,
+}
+
+var volumeRemoveCmd = &cobra.Command{
+	Use:     "rm <name>",
+	Aliases: []string{"remove"},
+	Short:   "remove a volume whose probe has been deleted",
+	Args:    cobra.ExactArgs(1),
+	RunE:    volumeRemoveHndlr,
+}
+
+func init() {
+	addOutputFlags(volumeListCmd, volumeInspectCmd)
+
+	volumeCmd.AddCommand(volumeListCmd, volumeInspectCmd, volumeRemoveCmd)
+	beaconctl.AddCommand(volumeCmd)
+}
+
+func volumeListHndlr(cmd *cobra.Command, args []string) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		response, err := beacondClient().V1.ListVolumes(v1.NewListVolumesParamsWithContext(ctx))
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		volumes := response.GetPayload()
+
+		return view{response: volumes, table: func(out io.Writer, wide bool) error {
+			w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+			if wide {
+				fmt.Fprintln(w, "NAME\tPROBE\tVOLUME\tSIZE\tCREATED\tMOUNTPOINT")
+			} else {
+				fmt.Fprintln(w, "NAME\tPROBE\tVOLUME\tSIZE\tCREATED")
+			}
+
+			for _, volume := range volumes.Volumes {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s", volume.Name, volumeOwner(volume), volume.Volume, formatSize(volume.Size), volume.CreatedAt)
+
+				if wide {
+					fmt.Fprintf(w, "\t%s", volume.Mountpoint)
+				}
+
+				fmt.Fprintln(w)
+			}
+
+			return w.Flush()
+		}}, nil
+	})
+}
+
+func volumeInspectHndlr(cmd *cobra.Command, args []string) error {
+	return render(cmd, func(ctx context.Context) (view, error) {
+		params := v1.NewGetVolumeParamsWithContext(ctx).WithName(args[0])
+
+		response, err := beacondClient().V1.GetVolume(params)
+
+		if err != nil {
+			return view{}, apiError(err)
+		}
+
+		volume := response.GetPayload()
+
+		return view{response: volume, table: func(out io.Writer, wide bool) error {
+			fmt.Fprintf(out, "Name:       %s\n", volume.Name)
+			fmt.Fprintf(out, "Probe:      %s\n", volumeOwner(volume))
+			fmt.Fprintf(out, "Volume:     %s\n", volume.Volume)
+			fmt.Fprintf(out, "Size:       %s\n", formatSize(volume.Size))
+			fmt.Fprintf(out, "Mountpoint: %s\n", volume.Mountpoint)
+			fmt.Fprintf(out, "Created at: %s\n", volume.CreatedAt)
+
+			return nil
+		}}, nil
+	})
+}
+
+func volumeRemoveHndlr(cmd *cobra.Command, args []string) error {
+	params := v1.NewDeleteVolumeParamsWithContext(cmd.Context()).WithName(args[0])
+
+	response, err := beacondClient().V1.DeleteVolume(params)
+
+	if err != nil {
+		return apiError(err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), response.GetPayload().Message)
+
+	return nil
+}
+
+// volumeOwner is the probe that owns the volume, marked if the probe has since been deleted
+func volumeOwner(volume *models.ServerVolumeResponse) string {
+	if !volume.ProbeExists {
+		return volume.Probe + " (deleted)"
+	}
+
+	return volume.Probe
+}
+
+// formatSize prints a size in bytes in the largest unit it has at least one of
+func formatSize(size int64) string {
+	if size < 0 {
+		return "unknown"
+	}
+
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	value := float64(size)
+	unit := 0
+
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d%s", size, units[unit])
+	}
+
+	return fmt.Sprintf("%.1f%s", value, units[unit])
+}
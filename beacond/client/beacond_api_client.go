@@ -6,11 +6,12 @@ package client
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
-	"beacon/beacond/client/operations"
-
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+
+	"beacon/beacond/client/operations"
+	v1 "beacon/beacond/client/v1"
 )
 
 // Default beacond API HTTP client.
@@ -56,6 +57,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *BeacondAPI
 	cli := new(BeacondAPI)
 	cli.Transport = transport
 	cli.Operations = operations.New(transport, formats)
+	cli.V1 = v1.New(transport, formats)
 	return cli
 }
 
@@ -102,6 +104,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type BeacondAPI struct {
 	Operations operations.ClientService
 
+	V1 v1.ClientService
+
 	Transport runtime.ClientTransport
 }
 
@@ -109,4 +113,5 @@ type BeacondAPI struct {
 func (c *BeacondAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 	c.Operations.SetTransport(transport)
+	c.V1.SetTransport(transport)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewDeleteProbeParams creates a new DeleteProbeParams object,
@@ -67,6 +68,12 @@ type DeleteProbeParams struct {
 	*/
 	Namespace string
 
+	/* Purge.
+
+	   whether to also remove the probe's containers and volumes
+	*/
+	Purge *bool
+
 	/* Repo.
 
 	   the repo name which the probe should check for image updates
@@ -137,6 +144,17 @@ func (o *DeleteProbeParams) SetNamespace(namespace string) {
 	o.Namespace = namespace
 }
 
+// WithPurge adds the purge to the delete probe params
+func (o *DeleteProbeParams) WithPurge(purge *bool) *DeleteProbeParams {
+	o.SetPurge(purge)
+	return o
+}
+
+// SetPurge adds the purge to the delete probe params
+func (o *DeleteProbeParams) SetPurge(purge *bool) {
+	o.Purge = purge
+}
+
 // WithRepo adds the repo to the delete probe params
 func (o *DeleteProbeParams) WithRepo(repo string) *DeleteProbeParams {
 	o.SetRepo(repo)
@@ -166,6 +184,23 @@ func (o *DeleteProbeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Purge != nil {
+
+		// query param purge
+		var qrPurge bool
+
+		if o.Purge != nil {
+			qrPurge = *o.Purge
+		}
+		qPurge := swag.FormatBool(qrPurge)
+		if qPurge != "" {
+
+			if err := r.SetQueryParam("purge", qPurge); err != nil {
+				return err
+			}
+		}
+	}
+
 	// query param repo
 	qrRepo := o.Repo
 	qRepo := qrRepo
@@ -23,8 +23,8 @@ type DeleteProbeReader struct {
 // ReadResponse reads a server response into the received o.
 func (o *DeleteProbeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
-	case 201:
-		result := NewDeleteProbeCreated()
+	case 200:
+		result := NewDeleteProbeOK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -52,63 +52,63 @@ func (o *DeleteProbeReader) ReadResponse(response runtime.ClientResponse, consum
 	}
 }
 
-// NewDeleteProbeCreated creates a DeleteProbeCreated with default headers values
-func NewDeleteProbeCreated() *DeleteProbeCreated {
-	return &DeleteProbeCreated{}
+// NewDeleteProbeOK creates a DeleteProbeOK with default headers values
+func NewDeleteProbeOK() *DeleteProbeOK {
+	return &DeleteProbeOK{}
 }
 
 /*
-DeleteProbeCreated describes a response with status code 201, with default header values.
+DeleteProbeOK describes a response with status code 200, with default header values.
 
-Created
+OK
 */
-type DeleteProbeCreated struct {
+type DeleteProbeOK struct {
 	Payload *models.ServerBaseResponse
 }
 
-// IsSuccess returns true when this delete probe created response has a 2xx status code
-func (o *DeleteProbeCreated) IsSuccess() bool {
+// IsSuccess returns true when this delete probe o k response has a 2xx status code
+func (o *DeleteProbeOK) IsSuccess() bool {
 	return true
 }
 
-// IsRedirect returns true when this delete probe created response has a 3xx status code
-func (o *DeleteProbeCreated) IsRedirect() bool {
+// IsRedirect returns true when this delete probe o k response has a 3xx status code
+func (o *DeleteProbeOK) IsRedirect() bool {
 	return false
 }
 
-// IsClientError returns true when this delete probe created response has a 4xx status code
-func (o *DeleteProbeCreated) IsClientError() bool {
+// IsClientError returns true when this delete probe o k response has a 4xx status code
+func (o *DeleteProbeOK) IsClientError() bool {
 	return false
 }
 
-// IsServerError returns true when this delete probe created response has a 5xx status code
-func (o *DeleteProbeCreated) IsServerError() bool {
+// IsServerError returns true when this delete probe o k response has a 5xx status code
+func (o *DeleteProbeOK) IsServerError() bool {
 	return false
 }
 
-// IsCode returns true when this delete probe created response a status code equal to that given
-func (o *DeleteProbeCreated) IsCode(code int) bool {
-	return code == 201
+// IsCode returns true when this delete probe o k response a status code equal to that given
+func (o *DeleteProbeOK) IsCode(code int) bool {
+	return code == 200
 }
 
-// Code gets the status code for the delete probe created response
-func (o *DeleteProbeCreated) Code() int {
-	return 201
+// Code gets the status code for the delete probe o k response
+func (o *DeleteProbeOK) Code() int {
+	return 200
 }
 
-func (o *DeleteProbeCreated) Error() string {
-	return fmt.Sprintf("[DELETE /probe][%d] deleteProbeCreated  %+v", 201, o.Payload)
+func (o *DeleteProbeOK) Error() string {
+	return fmt.Sprintf("[DELETE /probe][%d] deleteProbeOK  %+v", 200, o.Payload)
 }
 
-func (o *DeleteProbeCreated) String() string {
-	return fmt.Sprintf("[DELETE /probe][%d] deleteProbeCreated  %+v", 201, o.Payload)
+func (o *DeleteProbeOK) String() string {
+	return fmt.Sprintf("[DELETE /probe][%d] deleteProbeOK  %+v", 200, o.Payload)
 }
 
-func (o *DeleteProbeCreated) GetPayload() *models.ServerBaseResponse {
+func (o *DeleteProbeOK) GetPayload() *models.ServerBaseResponse {
 	return o.Payload
 }
 
-func (o *DeleteProbeCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *DeleteProbeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.ServerBaseResponse)
 
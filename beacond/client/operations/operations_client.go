@@ -30,16 +30,42 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*DeleteProbeCreated, error)
+	DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*DeleteProbeOK, error)
+
+	DeleteSecret(params *DeleteSecretParams, opts ...ClientOption) (*DeleteSecretOK, error)
+
+	DeleteVolume(params *DeleteVolumeParams, opts ...ClientOption) (*DeleteVolumeOK, error)
+
+	GetApprovals(params *GetApprovalsParams, opts ...ClientOption) (*GetApprovalsOK, error)
 
 	GetBeacon(params *GetBeaconParams, opts ...ClientOption) (*GetBeaconOK, error)
 
 	GetHealth(params *GetHealthParams, opts ...ClientOption) (*GetHealthOK, error)
 
+	GetHealthLive(params *GetHealthLiveParams, opts ...ClientOption) (*GetHealthLiveOK, error)
+
+	GetHealthReady(params *GetHealthReadyParams, opts ...ClientOption) (*GetHealthReadyOK, error)
+
 	GetProbes(params *GetProbesParams, opts ...ClientOption) (*GetProbesOK, error)
 
+	GetSecret(params *GetSecretParams, opts ...ClientOption) (*GetSecretOK, error)
+
+	GetSecrets(params *GetSecretsParams, opts ...ClientOption) (*GetSecretsOK, error)
+
+	GetVolume(params *GetVolumeParams, opts ...ClientOption) (*GetVolumeOK, error)
+
+	GetVolumes(params *GetVolumesParams, opts ...ClientOption) (*GetVolumesOK, error)
+
+	PatchProbe(params *PatchProbeParams, opts ...ClientOption) (*PatchProbeOK, error)
+
 	PostProbe(params *PostProbeParams, opts ...ClientOption) (*PostProbeCreated, error)
 
+	PostProbeApprove(params *PostProbeApproveParams, opts ...ClientOption) (*PostProbeApproveOK, error)
+
+	PostProbeReject(params *PostProbeRejectParams, opts ...ClientOption) (*PostProbeRejectOK, error)
+
+	PutSecret(params *PutSecretParams, opts ...ClientOption) (*PutSecretCreated, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -48,7 +74,7 @@ DeleteProbe deletes a probe
 
 deletes the probe for the namespace and repo provided in the URL query parameters
 */
-func (a *Client) DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*DeleteProbeCreated, error) {
+func (a *Client) DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*DeleteProbeOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
 		params = NewDeleteProbeParams()
@@ -73,7 +99,7 @@ func (a *Client) DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeleteProbeCreated)
+	success, ok := result.(*DeleteProbeOK)
 	if ok {
 		return success, nil
 	}
@@ -83,6 +109,126 @@ func (a *Client) DeleteProbe(params *DeleteProbeParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+DeleteSecret deletes a secret
+
+deletes the secret named in the URL query parameters, unless a probe still references it
+*/
+func (a *Client) DeleteSecret(params *DeleteSecretParams, opts ...ClientOption) (*DeleteSecretOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteSecretParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteSecret",
+		Method:             "DELETE",
+		PathPattern:        "/secret",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteSecretReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteSecretOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteSecret: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteVolume deletes a volume
+
+deletes the volume named in the URL query parameters, unless the probe that owns it still exists
+*/
+func (a *Client) DeleteVolume(params *DeleteVolumeParams, opts ...ClientOption) (*DeleteVolumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteVolumeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteVolume",
+		Method:             "DELETE",
+		PathPattern:        "/volume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteVolumeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteVolumeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteVolume: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetApprovals lists digests waiting to be approved
+
+lists the probes waiting for a new digest to be approved, with the digest, tag and when it was pushed
+*/
+func (a *Client) GetApprovals(params *GetApprovalsParams, opts ...ClientOption) (*GetApprovalsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetApprovalsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetApprovals",
+		Method:             "GET",
+		PathPattern:        "/approvals",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetApprovalsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetApprovalsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetApprovals: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetBeacon gets beacon details
 
@@ -124,9 +270,9 @@ func (a *Client) GetBeacon(params *GetBeaconParams, opts ...ClientOption) (*GetB
 }
 
 /*
-GetHealth healths check
+GetHealth livenesses check
 
-reports the health of the beacond server
+reports that the beacond server is running and answering requests, without checking what it depends on
 */
 func (a *Client) GetHealth(params *GetHealthParams, opts ...ClientOption) (*GetHealthOK, error) {
 	// TODO: Validate the params before sending
@@ -163,6 +309,86 @@ func (a *Client) GetHealth(params *GetHealthParams, opts ...ClientOption) (*GetH
 	panic(msg)
 }
 
+/*
+GetHealthLive livenesses check
+
+reports that the beacond server is running and answering requests, without checking what it depends on
+*/
+func (a *Client) GetHealthLive(params *GetHealthLiveParams, opts ...ClientOption) (*GetHealthLiveOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetHealthLiveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetHealthLive",
+		Method:             "GET",
+		PathPattern:        "/health/live",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetHealthLiveReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetHealthLiveOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetHealthLive: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetHealthReady readinesses check
+
+checks the container runtime, the registry and its rate limit, the reconcile loop and the state file, reporting the status and latency of each. The status is ok, degraded or failing, and beacond is only unready while a component is failing
+*/
+func (a *Client) GetHealthReady(params *GetHealthReadyParams, opts ...ClientOption) (*GetHealthReadyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetHealthReadyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetHealthReady",
+		Method:             "GET",
+		PathPattern:        "/health/ready",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetHealthReadyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetHealthReadyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetHealthReady: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetProbes lists all probes
 
@@ -203,6 +429,206 @@ func (a *Client) GetProbes(params *GetProbesParams, opts ...ClientOption) (*GetP
 	panic(msg)
 }
 
+/*
+GetSecret describes a secret
+
+describes the secret named in the URL query parameters. Its value is never returned
+*/
+func (a *Client) GetSecret(params *GetSecretParams, opts ...ClientOption) (*GetSecretOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSecretParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetSecret",
+		Method:             "GET",
+		PathPattern:        "/secret",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSecretReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSecretOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetSecret: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetSecrets lists all secrets
+
+lists the names of the secrets in the beacon's secret store
+*/
+func (a *Client) GetSecrets(params *GetSecretsParams, opts ...ClientOption) (*GetSecretsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSecretsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetSecrets",
+		Method:             "GET",
+		PathPattern:        "/secrets",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSecretsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetSecretsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetSecrets: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetVolume describes a volume
+
+describes the volume named in the URL query parameters, with its size and the probe that owns it
+*/
+func (a *Client) GetVolume(params *GetVolumeParams, opts ...ClientOption) (*GetVolumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetVolumeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetVolume",
+		Method:             "GET",
+		PathPattern:        "/volume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetVolumeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetVolumeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetVolume: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetVolumes lists all volumes
+
+lists the volumes created for the beacon's probes, including those of probes that have been deleted
+*/
+func (a *Client) GetVolumes(params *GetVolumesParams, opts ...ClientOption) (*GetVolumesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetVolumesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetVolumes",
+		Method:             "GET",
+		PathPattern:        "/volumes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetVolumesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetVolumesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetVolumes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PatchProbe scales a probe
+
+changes the number of containers run for the probe, without redeploying the ones that are kept
+*/
+func (a *Client) PatchProbe(params *PatchProbeParams, opts ...ClientOption) (*PatchProbeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchProbeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PatchProbe",
+		Method:             "PATCH",
+		PathPattern:        "/probe",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchProbeReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchProbeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PatchProbe: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 PostProbe creates a probe
 
@@ -243,6 +669,126 @@ func (a *Client) PostProbe(params *PostProbeParams, opts ...ClientOption) (*Post
 	panic(msg)
 }
 
+/*
+PostProbeApprove approves a digest
+
+deploys the digest waiting to be approved for the probe
+*/
+func (a *Client) PostProbeApprove(params *PostProbeApproveParams, opts ...ClientOption) (*PostProbeApproveOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProbeApproveParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostProbeApprove",
+		Method:             "POST",
+		PathPattern:        "/probe/approve",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostProbeApproveReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostProbeApproveOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostProbeApprove: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PostProbeReject rejects a digest
+
+skips the digest waiting to be approved for the probe, which keeps running its current digest
+*/
+func (a *Client) PostProbeReject(params *PostProbeRejectParams, opts ...ClientOption) (*PostProbeRejectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostProbeRejectParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostProbeReject",
+		Method:             "POST",
+		PathPattern:        "/probe/reject",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostProbeRejectReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostProbeRejectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PostProbeReject: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PutSecret sets a secret
+
+creates or replaces the secret named in the URL query parameters. The value is sent in the body, so that it doesn't end up in access logs
+*/
+func (a *Client) PutSecret(params *PutSecretParams, opts ...ClientOption) (*PutSecretCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutSecretParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutSecret",
+		Method:             "PUT",
+		PathPattern:        "/secret",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutSecretReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutSecretCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PutSecret: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
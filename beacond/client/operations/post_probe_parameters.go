@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewPostProbeParams creates a new PostProbeParams object,
@@ -61,18 +62,144 @@ PostProbeParams contains all the parameters to send to the API endpoint
 */
 type PostProbeParams struct {
 
+	/* CPUShares.
+
+	   the relative CPU weight of the probe's containers (1024 by default)
+	*/
+	CPUShares *int64
+
+	/* Cpus.
+
+	   the number of CPUs the probe's containers can use, enforced as a CPU quota
+	*/
+	Cpus *float64
+
+	/* DependsOn.
+
+	   a probe, as namespace/repo, that has to be running before the probe's containers are started. Can be repeated
+	*/
+	DependsOn []string
+
+	/* Env.
+
+	   an environment variable to set in the probe's containers, as VARIABLE=value. Can be repeated
+	*/
+	Env []string
+
+	/* HealthPath.
+
+	   a path that has to return a 2xx or 3xx response before a new container is routed to
+	*/
+	HealthPath *string
+
+	/* Hook.
+
+	   a hook run from each new digest, as JSON such as {"name":"migrate","phase":"pre_deploy","command":["./migrate"],"env":{},"volumes":["data:/data"],"timeout":"10m"}. Can be repeated
+	*/
+	Hook []string
+
+	/* Host.
+
+	   the hostname the proxy routes to the probe's containers (any hostname if left out)
+	*/
+	Host *string
+
+	/* Memory.
+
+	   the memory limit of the probe's containers, in bytes or with a k, m or g suffix
+	*/
+	Memory *string
+
 	/* Namespace.
 
 	   the repo namespace the probe should check for image updates
 	*/
 	Namespace string
 
+	/* Network.
+
+	   an existing network the probe's containers join on top of beacon's network. Can be repeated
+	*/
+	Network []string
+
+	/* NoNetwork.
+
+	   whether the probe's containers stay off the network beacon creates for managed containers
+	*/
+	NoNetwork *bool
+
+	/* Notify.
+
+	   a notification sink to tell about the probe, on top of those told about every probe. Can be repeated
+	*/
+	Notify []string
+
+	/* PathPrefix.
+
+	   the path prefix the proxy routes to the probe's containers (any path if left out)
+	*/
+	PathPrefix *string
+
+	/* PidsLimit.
+
+	   the maximum number of processes in each of the probe's containers
+	*/
+	PidsLimit *int64
+
+	/* Port.
+
+	   the container port the proxy sends requests to, which gives the probe a route
+	*/
+	Port *int64
+
+	/* Replicas.
+
+	   the number of containers to run for the probe (1 by default)
+	*/
+	Replicas *int64
+
 	/* Repo.
 
 	   the repo name which the probe should check for image updates
 	*/
 	Repo string
 
+	/* RequireApproval.
+
+	   whether new digests wait to be approved before they are deployed
+	*/
+	RequireApproval *bool
+
+	/* RestartWithDependencies.
+
+	   whether the probe's containers are restarted when one of its dependencies is redeployed
+	*/
+	RestartWithDependencies *bool
+
+	/* SecretEnv.
+
+	   a secret to set as an environment variable, as VARIABLE=secret-name. Can be repeated
+	*/
+	SecretEnv []string
+
+	/* SecretFile.
+
+	   a secret to mount as a read only file, as /path/in/container=secret-name. Can be repeated
+	*/
+	SecretFile []string
+
+	/* Soak.
+
+	   how long a new digest has to stay the latest before it is deployed, such as 10m
+	*/
+	Soak *string
+
+	/* Volume.
+
+	   a named volume owned by the probe, as name:/path/in/container. Can be repeated
+	*/
+	Volume []string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -126,6 +253,94 @@ func (o *PostProbeParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithCPUShares adds the cPUShares to the post probe params
+func (o *PostProbeParams) WithCPUShares(cPUShares *int64) *PostProbeParams {
+	o.SetCPUShares(cPUShares)
+	return o
+}
+
+// SetCPUShares adds the cpuShares to the post probe params
+func (o *PostProbeParams) SetCPUShares(cPUShares *int64) {
+	o.CPUShares = cPUShares
+}
+
+// WithCpus adds the cpus to the post probe params
+func (o *PostProbeParams) WithCpus(cpus *float64) *PostProbeParams {
+	o.SetCpus(cpus)
+	return o
+}
+
+// SetCpus adds the cpus to the post probe params
+func (o *PostProbeParams) SetCpus(cpus *float64) {
+	o.Cpus = cpus
+}
+
+// WithDependsOn adds the dependsOn to the post probe params
+func (o *PostProbeParams) WithDependsOn(dependsOn []string) *PostProbeParams {
+	o.SetDependsOn(dependsOn)
+	return o
+}
+
+// SetDependsOn adds the dependsOn to the post probe params
+func (o *PostProbeParams) SetDependsOn(dependsOn []string) {
+	o.DependsOn = dependsOn
+}
+
+// WithEnv adds the env to the post probe params
+func (o *PostProbeParams) WithEnv(env []string) *PostProbeParams {
+	o.SetEnv(env)
+	return o
+}
+
+// SetEnv adds the env to the post probe params
+func (o *PostProbeParams) SetEnv(env []string) {
+	o.Env = env
+}
+
+// WithHealthPath adds the healthPath to the post probe params
+func (o *PostProbeParams) WithHealthPath(healthPath *string) *PostProbeParams {
+	o.SetHealthPath(healthPath)
+	return o
+}
+
+// SetHealthPath adds the healthPath to the post probe params
+func (o *PostProbeParams) SetHealthPath(healthPath *string) {
+	o.HealthPath = healthPath
+}
+
+// WithHook adds the hook to the post probe params
+func (o *PostProbeParams) WithHook(hook []string) *PostProbeParams {
+	o.SetHook(hook)
+	return o
+}
+
+// SetHook adds the hook to the post probe params
+func (o *PostProbeParams) SetHook(hook []string) {
+	o.Hook = hook
+}
+
+// WithHost adds the host to the post probe params
+func (o *PostProbeParams) WithHost(host *string) *PostProbeParams {
+	o.SetHost(host)
+	return o
+}
+
+// SetHost adds the host to the post probe params
+func (o *PostProbeParams) SetHost(host *string) {
+	o.Host = host
+}
+
+// WithMemory adds the memory to the post probe params
+func (o *PostProbeParams) WithMemory(memory *string) *PostProbeParams {
+	o.SetMemory(memory)
+	return o
+}
+
+// SetMemory adds the memory to the post probe params
+func (o *PostProbeParams) SetMemory(memory *string) {
+	o.Memory = memory
+}
+
 // WithNamespace adds the namespace to the post probe params
 func (o *PostProbeParams) WithNamespace(namespace string) *PostProbeParams {
 	o.SetNamespace(namespace)
@@ -137,6 +352,83 @@ func (o *PostProbeParams) SetNamespace(namespace string) {
 	o.Namespace = namespace
 }
 
+// WithNetwork adds the network to the post probe params
+func (o *PostProbeParams) WithNetwork(network []string) *PostProbeParams {
+	o.SetNetwork(network)
+	return o
+}
+
+// SetNetwork adds the network to the post probe params
+func (o *PostProbeParams) SetNetwork(network []string) {
+	o.Network = network
+}
+
+// WithNoNetwork adds the noNetwork to the post probe params
+func (o *PostProbeParams) WithNoNetwork(noNetwork *bool) *PostProbeParams {
+	o.SetNoNetwork(noNetwork)
+	return o
+}
+
+// SetNoNetwork adds the noNetwork to the post probe params
+func (o *PostProbeParams) SetNoNetwork(noNetwork *bool) {
+	o.NoNetwork = noNetwork
+}
+
+// WithNotify adds the notify to the post probe params
+func (o *PostProbeParams) WithNotify(notify []string) *PostProbeParams {
+	o.SetNotify(notify)
+	return o
+}
+
+// SetNotify adds the notify to the post probe params
+func (o *PostProbeParams) SetNotify(notify []string) {
+	o.Notify = notify
+}
+
+// WithPathPrefix adds the pathPrefix to the post probe params
+func (o *PostProbeParams) WithPathPrefix(pathPrefix *string) *PostProbeParams {
+	o.SetPathPrefix(pathPrefix)
+	return o
+}
+
+// SetPathPrefix adds the pathPrefix to the post probe params
+func (o *PostProbeParams) SetPathPrefix(pathPrefix *string) {
+	o.PathPrefix = pathPrefix
+}
+
+// WithPidsLimit adds the pidsLimit to the post probe params
+func (o *PostProbeParams) WithPidsLimit(pidsLimit *int64) *PostProbeParams {
+	o.SetPidsLimit(pidsLimit)
+	return o
+}
+
+// SetPidsLimit adds the pidsLimit to the post probe params
+func (o *PostProbeParams) SetPidsLimit(pidsLimit *int64) {
+	o.PidsLimit = pidsLimit
+}
+
+// WithPort adds the port to the post probe params
+func (o *PostProbeParams) WithPort(port *int64) *PostProbeParams {
+	o.SetPort(port)
+	return o
+}
+
+// SetPort adds the port to the post probe params
+func (o *PostProbeParams) SetPort(port *int64) {
+	o.Port = port
+}
+
+// WithReplicas adds the replicas to the post probe params
+func (o *PostProbeParams) WithReplicas(replicas *int64) *PostProbeParams {
+	o.SetReplicas(replicas)
+	return o
+}
+
+// SetReplicas adds the replicas to the post probe params
+func (o *PostProbeParams) SetReplicas(replicas *int64) {
+	o.Replicas = replicas
+}
+
 // WithRepo adds the repo to the post probe params
 func (o *PostProbeParams) WithRepo(repo string) *PostProbeParams {
 	o.SetRepo(repo)
@@ -148,6 +440,72 @@ func (o *PostProbeParams) SetRepo(repo string) {
 	o.Repo = repo
 }
 
+// WithRequireApproval adds the requireApproval to the post probe params
+func (o *PostProbeParams) WithRequireApproval(requireApproval *bool) *PostProbeParams {
+	o.SetRequireApproval(requireApproval)
+	return o
+}
+
+// SetRequireApproval adds the requireApproval to the post probe params
+func (o *PostProbeParams) SetRequireApproval(requireApproval *bool) {
+	o.RequireApproval = requireApproval
+}
+
+// WithRestartWithDependencies adds the restartWithDependencies to the post probe params
+func (o *PostProbeParams) WithRestartWithDependencies(restartWithDependencies *bool) *PostProbeParams {
+	o.SetRestartWithDependencies(restartWithDependencies)
+	return o
+}
+
+// SetRestartWithDependencies adds the restartWithDependencies to the post probe params
+func (o *PostProbeParams) SetRestartWithDependencies(restartWithDependencies *bool) {
+	o.RestartWithDependencies = restartWithDependencies
+}
+
+// WithSecretEnv adds the secretEnv to the post probe params
+func (o *PostProbeParams) WithSecretEnv(secretEnv []string) *PostProbeParams {
+	o.SetSecretEnv(secretEnv)
+	return o
+}
+
+// SetSecretEnv adds the secretEnv to the post probe params
+func (o *PostProbeParams) SetSecretEnv(secretEnv []string) {
+	o.SecretEnv = secretEnv
+}
+
+// WithSecretFile adds the secretFile to the post probe params
+func (o *PostProbeParams) WithSecretFile(secretFile []string) *PostProbeParams {
+	o.SetSecretFile(secretFile)
+	return o
+}
+
+// SetSecretFile adds the secretFile to the post probe params
+func (o *PostProbeParams) SetSecretFile(secretFile []string) {
+	o.SecretFile = secretFile
+}
+
+// WithSoak adds the soak to the post probe params
+func (o *PostProbeParams) WithSoak(soak *string) *PostProbeParams {
+	o.SetSoak(soak)
+	return o
+}
+
+// SetSoak adds the soak to the post probe params
+func (o *PostProbeParams) SetSoak(soak *string) {
+	o.Soak = soak
+}
+
+// WithVolume adds the volume to the post probe params
+func (o *PostProbeParams) WithVolume(volume []string) *PostProbeParams {
+	o.SetVolume(volume)
+	return o
+}
+
+// SetVolume adds the volume to the post probe params
+func (o *PostProbeParams) SetVolume(volume []string) {
+	o.Volume = volume
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *PostProbeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -156,6 +514,124 @@ func (o *PostProbeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 	}
 	var res []error
 
+	if o.CPUShares != nil {
+
+		// query param cpu_shares
+		var qrCPUShares int64
+
+		if o.CPUShares != nil {
+			qrCPUShares = *o.CPUShares
+		}
+		qCPUShares := swag.FormatInt64(qrCPUShares)
+		if qCPUShares != "" {
+
+			if err := r.SetQueryParam("cpu_shares", qCPUShares); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cpus != nil {
+
+		// query param cpus
+		var qrCpus float64
+
+		if o.Cpus != nil {
+			qrCpus = *o.Cpus
+		}
+		qCpus := swag.FormatFloat64(qrCpus)
+		if qCpus != "" {
+
+			if err := r.SetQueryParam("cpus", qCpus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.DependsOn != nil {
+
+		// binding items for depends_on
+		joinedDependsOn := o.bindParamDependsOn(reg)
+
+		// query array param depends_on
+		if err := r.SetQueryParam("depends_on", joinedDependsOn...); err != nil {
+			return err
+		}
+	}
+
+	if o.Env != nil {
+
+		// binding items for env
+		joinedEnv := o.bindParamEnv(reg)
+
+		// query array param env
+		if err := r.SetQueryParam("env", joinedEnv...); err != nil {
+			return err
+		}
+	}
+
+	if o.HealthPath != nil {
+
+		// query param health_path
+		var qrHealthPath string
+
+		if o.HealthPath != nil {
+			qrHealthPath = *o.HealthPath
+		}
+		qHealthPath := qrHealthPath
+		if qHealthPath != "" {
+
+			if err := r.SetQueryParam("health_path", qHealthPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Hook != nil {
+
+		// binding items for hook
+		joinedHook := o.bindParamHook(reg)
+
+		// query array param hook
+		if err := r.SetQueryParam("hook", joinedHook...); err != nil {
+			return err
+		}
+	}
+
+	if o.Host != nil {
+
+		// query param host
+		var qrHost string
+
+		if o.Host != nil {
+			qrHost = *o.Host
+		}
+		qHost := qrHost
+		if qHost != "" {
+
+			if err := r.SetQueryParam("host", qHost); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Memory != nil {
+
+		// query param memory
+		var qrMemory string
+
+		if o.Memory != nil {
+			qrMemory = *o.Memory
+		}
+		qMemory := qrMemory
+		if qMemory != "" {
+
+			if err := r.SetQueryParam("memory", qMemory); err != nil {
+				return err
+			}
+		}
+	}
+
 	// query param namespace
 	qrNamespace := o.Namespace
 	qNamespace := qrNamespace
@@ -166,6 +642,113 @@ func (o *PostProbeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.Network != nil {
+
+		// binding items for network
+		joinedNetwork := o.bindParamNetwork(reg)
+
+		// query array param network
+		if err := r.SetQueryParam("network", joinedNetwork...); err != nil {
+			return err
+		}
+	}
+
+	if o.NoNetwork != nil {
+
+		// query param no_network
+		var qrNoNetwork bool
+
+		if o.NoNetwork != nil {
+			qrNoNetwork = *o.NoNetwork
+		}
+		qNoNetwork := swag.FormatBool(qrNoNetwork)
+		if qNoNetwork != "" {
+
+			if err := r.SetQueryParam("no_network", qNoNetwork); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Notify != nil {
+
+		// binding items for notify
+		joinedNotify := o.bindParamNotify(reg)
+
+		// query array param notify
+		if err := r.SetQueryParam("notify", joinedNotify...); err != nil {
+			return err
+		}
+	}
+
+	if o.PathPrefix != nil {
+
+		// query param path_prefix
+		var qrPathPrefix string
+
+		if o.PathPrefix != nil {
+			qrPathPrefix = *o.PathPrefix
+		}
+		qPathPrefix := qrPathPrefix
+		if qPathPrefix != "" {
+
+			if err := r.SetQueryParam("path_prefix", qPathPrefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PidsLimit != nil {
+
+		// query param pids_limit
+		var qrPidsLimit int64
+
+		if o.PidsLimit != nil {
+			qrPidsLimit = *o.PidsLimit
+		}
+		qPidsLimit := swag.FormatInt64(qrPidsLimit)
+		if qPidsLimit != "" {
+
+			if err := r.SetQueryParam("pids_limit", qPidsLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Port != nil {
+
+		// query param port
+		var qrPort int64
+
+		if o.Port != nil {
+			qrPort = *o.Port
+		}
+		qPort := swag.FormatInt64(qrPort)
+		if qPort != "" {
+
+			if err := r.SetQueryParam("port", qPort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Replicas != nil {
+
+		// query param replicas
+		var qrReplicas int64
+
+		if o.Replicas != nil {
+			qrReplicas = *o.Replicas
+		}
+		qReplicas := swag.FormatInt64(qrReplicas)
+		if qReplicas != "" {
+
+			if err := r.SetQueryParam("replicas", qReplicas); err != nil {
+				return err
+			}
+		}
+	}
+
 	// query param repo
 	qrRepo := o.Repo
 	qRepo := qrRepo
@@ -176,8 +759,228 @@ func (o *PostProbeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.RequireApproval != nil {
+
+		// query param require_approval
+		var qrRequireApproval bool
+
+		if o.RequireApproval != nil {
+			qrRequireApproval = *o.RequireApproval
+		}
+		qRequireApproval := swag.FormatBool(qrRequireApproval)
+		if qRequireApproval != "" {
+
+			if err := r.SetQueryParam("require_approval", qRequireApproval); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.RestartWithDependencies != nil {
+
+		// query param restart_with_dependencies
+		var qrRestartWithDependencies bool
+
+		if o.RestartWithDependencies != nil {
+			qrRestartWithDependencies = *o.RestartWithDependencies
+		}
+		qRestartWithDependencies := swag.FormatBool(qrRestartWithDependencies)
+		if qRestartWithDependencies != "" {
+
+			if err := r.SetQueryParam("restart_with_dependencies", qRestartWithDependencies); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SecretEnv != nil {
+
+		// binding items for secret_env
+		joinedSecretEnv := o.bindParamSecretEnv(reg)
+
+		// query array param secret_env
+		if err := r.SetQueryParam("secret_env", joinedSecretEnv...); err != nil {
+			return err
+		}
+	}
+
+	if o.SecretFile != nil {
+
+		// binding items for secret_file
+		joinedSecretFile := o.bindParamSecretFile(reg)
+
+		// query array param secret_file
+		if err := r.SetQueryParam("secret_file", joinedSecretFile...); err != nil {
+			return err
+		}
+	}
+
+	if o.Soak != nil {
+
+		// query param soak
+		var qrSoak string
+
+		if o.Soak != nil {
+			qrSoak = *o.Soak
+		}
+		qSoak := qrSoak
+		if qSoak != "" {
+
+			if err := r.SetQueryParam("soak", qSoak); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Volume != nil {
+
+		// binding items for volume
+		joinedVolume := o.bindParamVolume(reg)
+
+		// query array param volume
+		if err := r.SetQueryParam("volume", joinedVolume...); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindParamPostProbe binds the parameter depends_on
+func (o *PostProbeParams) bindParamDependsOn(formats strfmt.Registry) []string {
+	dependsOnIR := o.DependsOn
+
+	var dependsOnIC []string
+	for _, dependsOnIIR := range dependsOnIR { // explode []string
+
+		dependsOnIIV := dependsOnIIR // string as string
+		dependsOnIC = append(dependsOnIC, dependsOnIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	dependsOnIS := swag.JoinByFormat(dependsOnIC, "multi")
+
+	return dependsOnIS
+}
+
+// bindParamPostProbe binds the parameter env
+func (o *PostProbeParams) bindParamEnv(formats strfmt.Registry) []string {
+	envIR := o.Env
+
+	var envIC []string
+	for _, envIIR := range envIR { // explode []string
+
+		envIIV := envIIR // string as string
+		envIC = append(envIC, envIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	envIS := swag.JoinByFormat(envIC, "multi")
+
+	return envIS
+}
+
+// bindParamPostProbe binds the parameter hook
+func (o *PostProbeParams) bindParamHook(formats strfmt.Registry) []string {
+	hookIR := o.Hook
+
+	var hookIC []string
+	for _, hookIIR := range hookIR { // explode []string
+
+		hookIIV := hookIIR // string as string
+		hookIC = append(hookIC, hookIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	hookIS := swag.JoinByFormat(hookIC, "multi")
+
+	return hookIS
+}
+
+// bindParamPostProbe binds the parameter network
+func (o *PostProbeParams) bindParamNetwork(formats strfmt.Registry) []string {
+	networkIR := o.Network
+
+	var networkIC []string
+	for _, networkIIR := range networkIR { // explode []string
+
+		networkIIV := networkIIR // string as string
+		networkIC = append(networkIC, networkIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	networkIS := swag.JoinByFormat(networkIC, "multi")
+
+	return networkIS
+}
+
+// bindParamPostProbe binds the parameter notify
+func (o *PostProbeParams) bindParamNotify(formats strfmt.Registry) []string {
+	notifyIR := o.Notify
+
+	var notifyIC []string
+	for _, notifyIIR := range notifyIR { // explode []string
+
+		notifyIIV := notifyIIR // string as string
+		notifyIC = append(notifyIC, notifyIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	notifyIS := swag.JoinByFormat(notifyIC, "multi")
+
+	return notifyIS
+}
+
+// bindParamPostProbe binds the parameter secret_env
+func (o *PostProbeParams) bindParamSecretEnv(formats strfmt.Registry) []string {
+	secretEnvIR := o.SecretEnv
+
+	var secretEnvIC []string
+	for _, secretEnvIIR := range secretEnvIR { // explode []string
+
+		secretEnvIIV := secretEnvIIR // string as string
+		secretEnvIC = append(secretEnvIC, secretEnvIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	secretEnvIS := swag.JoinByFormat(secretEnvIC, "multi")
+
+	return secretEnvIS
+}
+
+// bindParamPostProbe binds the parameter secret_file
+func (o *PostProbeParams) bindParamSecretFile(formats strfmt.Registry) []string {
+	secretFileIR := o.SecretFile
+
+	var secretFileIC []string
+	for _, secretFileIIR := range secretFileIR { // explode []string
+
+		secretFileIIV := secretFileIIR // string as string
+		secretFileIC = append(secretFileIC, secretFileIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	secretFileIS := swag.JoinByFormat(secretFileIC, "multi")
+
+	return secretFileIS
+}
+
+// bindParamPostProbe binds the parameter volume
+func (o *PostProbeParams) bindParamVolume(formats strfmt.Registry) []string {
+	volumeIR := o.Volume
+
+	var volumeIC []string
+	for _, volumeIIR := range volumeIR { // explode []string
+
+		volumeIIV := volumeIIR // string as string
+		volumeIC = append(volumeIC, volumeIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	volumeIS := swag.JoinByFormat(volumeIC, "multi")
+
+	return volumeIS
+}
@@ -47,12 +47,30 @@ func (o *PostProbeReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return nil, result
+	case 422:
+		result := NewPostProbeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewPostProbeInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 502:
+		result := NewPostProbeBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewPostProbeServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("[POST /probe] PostProbe", response, response.Code())
 	}
@@ -330,6 +348,74 @@ func (o *PostProbeConflict) readResponse(response runtime.ClientResponse, consum
 	return nil
 }
 
+// NewPostProbeUnprocessableEntity creates a PostProbeUnprocessableEntity with default headers values
+func NewPostProbeUnprocessableEntity() *PostProbeUnprocessableEntity {
+	return &PostProbeUnprocessableEntity{}
+}
+
+/*
+PostProbeUnprocessableEntity describes a response with status code 422, with default header values.
+
+Unprocessable Entity
+*/
+type PostProbeUnprocessableEntity struct {
+	Payload *models.ServerBaseResponse
+}
+
+// IsSuccess returns true when this post probe unprocessable entity response has a 2xx status code
+func (o *PostProbeUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post probe unprocessable entity response has a 3xx status code
+func (o *PostProbeUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post probe unprocessable entity response has a 4xx status code
+func (o *PostProbeUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this post probe unprocessable entity response has a 5xx status code
+func (o *PostProbeUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this post probe unprocessable entity response a status code equal to that given
+func (o *PostProbeUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the post probe unprocessable entity response
+func (o *PostProbeUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *PostProbeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *PostProbeUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *PostProbeUnprocessableEntity) GetPayload() *models.ServerBaseResponse {
+	return o.Payload
+}
+
+func (o *PostProbeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServerBaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostProbeInternalServerError creates a PostProbeInternalServerError with default headers values
 func NewPostProbeInternalServerError() *PostProbeInternalServerError {
 	return &PostProbeInternalServerError{}
@@ -397,3 +483,139 @@ func (o *PostProbeInternalServerError) readResponse(response runtime.ClientRespo
 
 	return nil
 }
+
+// NewPostProbeBadGateway creates a PostProbeBadGateway with default headers values
+func NewPostProbeBadGateway() *PostProbeBadGateway {
+	return &PostProbeBadGateway{}
+}
+
+/*
+PostProbeBadGateway describes a response with status code 502, with default header values.
+
+Bad Gateway
+*/
+type PostProbeBadGateway struct {
+	Payload *models.ServerBaseResponse
+}
+
+// IsSuccess returns true when this post probe bad gateway response has a 2xx status code
+func (o *PostProbeBadGateway) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post probe bad gateway response has a 3xx status code
+func (o *PostProbeBadGateway) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post probe bad gateway response has a 4xx status code
+func (o *PostProbeBadGateway) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post probe bad gateway response has a 5xx status code
+func (o *PostProbeBadGateway) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this post probe bad gateway response a status code equal to that given
+func (o *PostProbeBadGateway) IsCode(code int) bool {
+	return code == 502
+}
+
+// Code gets the status code for the post probe bad gateway response
+func (o *PostProbeBadGateway) Code() int {
+	return 502
+}
+
+func (o *PostProbeBadGateway) Error() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *PostProbeBadGateway) String() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *PostProbeBadGateway) GetPayload() *models.ServerBaseResponse {
+	return o.Payload
+}
+
+func (o *PostProbeBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServerBaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostProbeServiceUnavailable creates a PostProbeServiceUnavailable with default headers values
+func NewPostProbeServiceUnavailable() *PostProbeServiceUnavailable {
+	return &PostProbeServiceUnavailable{}
+}
+
+/*
+PostProbeServiceUnavailable describes a response with status code 503, with default header values.
+
+Service Unavailable
+*/
+type PostProbeServiceUnavailable struct {
+	Payload *models.ServerBaseResponse
+}
+
+// IsSuccess returns true when this post probe service unavailable response has a 2xx status code
+func (o *PostProbeServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this post probe service unavailable response has a 3xx status code
+func (o *PostProbeServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this post probe service unavailable response has a 4xx status code
+func (o *PostProbeServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this post probe service unavailable response has a 5xx status code
+func (o *PostProbeServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this post probe service unavailable response a status code equal to that given
+func (o *PostProbeServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the post probe service unavailable response
+func (o *PostProbeServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *PostProbeServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *PostProbeServiceUnavailable) String() string {
+	return fmt.Sprintf("[POST /probe][%d] postProbeServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *PostProbeServiceUnavailable) GetPayload() *models.ServerBaseResponse {
+	return o.Payload
+}
+
+func (o *PostProbeServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServerBaseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
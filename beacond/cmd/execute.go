@@ -1,12 +1,23 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"beacon/beacond/notify"
 	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
 	"beacon/beacond/registry"
+	"beacon/beacond/secrets"
 	"beacon/beacond/server"
+	"beacon/beacond/signature"
 
+	"github.com/labstack/gommon/log"
 	"github.com/spf13/cobra"
 )
 
@@ -16,7 +27,7 @@ var flagBeacondMode enumerable = enumerable{
 }
 
 var flagOCIRuntime enumerable = enumerable{
-	allowedValues: []string{"podman", "docker"},
+	allowedValues: []string{"podman", "podman-api", "containerd", "docker"},
 	currValue:     "podman",
 }
 
@@ -27,6 +38,25 @@ var flagRegistry enumerable = enumerable{
 
 var flagBeacondPort int
 var flagBeacondCleanOnExit bool
+var flagAllowOvercommit bool
+var flagVerifyKeys []string
+var flagVerifyRegistry string
+var flagAuthFiles []string
+var flagStateFile string
+var flagGracePeriod time.Duration
+var flagProxyAddr string
+var flagProxyTLSAddr string
+var flagProxyTLSCert string
+var flagProxyTLSKey string
+var flagSecretsFile string
+var flagSecretsKeyFile string
+var flagSecretsDir string
+var flagConfigFile string
+var flagNetwork string
+
+// The environment variable the secrets passphrase is read from if --secrets-key-file isn't given. It is not a flag,
+// so that it doesn't show up in the process list
+const secretsPassphraseEnv = "BEACON_SECRETS_PASSPHRASE"
 
 var beacond = &cobra.Command{
 	Use:   "beacond",
@@ -63,7 +93,22 @@ func init() {
 	beacond.PersistentFlags().VarP(&flagOCIRuntime, "runtime", "r", "The OCI runtime to use")
 	beacond.PersistentFlags().VarP(&flagRegistry, "registry", "c", "The container registry to use")
 	beacond.PersistentFlags().IntVarP(&flagBeacondPort, "port", "p", 1323, "The port to listen on for commands")
-	beacond.PersistentFlags().BoolVar(&flagBeacondCleanOnExit, "clean-up", false, "When beacond exits, whether to also stop containers managed by it")
+	beacond.PersistentFlags().BoolVar(&flagBeacondCleanOnExit, "clean-up", false, "When beacond exits, whether to also stop and remove containers managed by it, and the network they joined")
+	beacond.PersistentFlags().BoolVar(&flagAllowOvercommit, "allow-overcommit", false, "Allow probes to be created with resource limits that exceed what is left unallocated on the host")
+	beacond.PersistentFlags().StringSliceVar(&flagVerifyKeys, "verify-key", []string{}, "Path to a PEM encoded public key that images must be signed with (using cosign) before they are deployed. Can be repeated to trust several keys")
+	beacond.PersistentFlags().StringVar(&flagVerifyRegistry, "verify-registry", "https://registry-1.docker.io", "The registry API to fetch image signatures from")
+	beacond.PersistentFlags().StringSliceVar(&flagAuthFiles, "auth-file", []string{}, "Path to an auth.json or docker config.json file with credentials for private registries. Can be repeated. Defaults to the files podman and docker read")
+	beacond.PersistentFlags().StringVar(&flagStateFile, "state-file", server.DefaultStateFile(), "Where to save probes so that they are restored when beacond restarts. Set to an empty string to not save them")
+	beacond.PersistentFlags().StringVar(&flagProxyAddr, "proxy-addr", "", "The address the reverse proxy for probes with a route listens on for HTTP. If neither this nor --proxy-tls-cert is given, the proxy is only started once a probe has a route, on "+proxy.DefaultAddr)
+	beacond.PersistentFlags().StringVar(&flagProxyTLSAddr, "proxy-tls-addr", ":8443", "The address the reverse proxy listens on for HTTPS, if --proxy-tls-cert and --proxy-tls-key are given")
+	beacond.PersistentFlags().StringVar(&flagProxyTLSCert, "proxy-tls-cert", "", "Path to a PEM encoded certificate for the reverse proxy to serve HTTPS with")
+	beacond.PersistentFlags().StringVar(&flagProxyTLSKey, "proxy-tls-key", "", "Path to the PEM encoded private key of --proxy-tls-cert")
+	beacond.PersistentFlags().StringVar(&flagSecretsFile, "secrets-file", server.DefaultSecretsFile(), "Where to keep the secrets that can be injected into probes' containers, encrypted")
+	beacond.PersistentFlags().StringVar(&flagSecretsKeyFile, "secrets-key-file", "", "Path to a file holding the passphrase the secrets are encrypted with. If neither this nor "+secretsPassphraseEnv+" is set, secrets are disabled")
+	beacond.PersistentFlags().StringVar(&flagSecretsDir, "secrets-dir", server.DefaultSecretsDir(), "Where to write secrets that are mounted into containers as files. This should be a tmpfs")
+	beacond.PersistentFlags().StringVar(&flagConfigFile, "config", server.DefaultConfigFile(), "Path to beacond's config file (YAML, JSON or TOML), which configures notifications")
+	beacond.PersistentFlags().StringVar(&flagNetwork, "network", "beacon", "The network to create for managed containers, on which they can reach each other as <repo>.<namespace>. Set to an empty string to leave them on the runtime's default network")
+	beacond.PersistentFlags().DurationVar(&flagGracePeriod, "grace-period", 30*time.Second, "How long to wait for probes and, with --clean-up, managed containers to stop when beacond is shut down")
 }
 
 func beacondHndlr(cmd *cobra.Command, args []string) {
@@ -73,13 +118,93 @@ func beacondHndlr(cmd *cobra.Command, args []string) {
 		panic(err)
 	}
 
-	registryClient, err := registry.NewRegistry(registry.RegistryType(flagRegistry.currValue))
+	keychain := registry.NewKeychain(flagAuthFiles...)
+	registryClient, err := registry.NewRegistry(registry.RegistryType(flagRegistry.currValue), keychain)
 
 	if err != nil {
 		panic(err)
 	}
 
-	server.Run(ociClient, registryClient, flagBeacondPort, flagBeacondCleanOnExit)
+	var verifier signature.Verifier
+
+	if len(flagVerifyKeys) > 0 {
+		verifier, err = signature.NewVerifier(signature.Cosign, flagVerifyRegistry, flagVerifyKeys, keychain)
+
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	secretStore, err := openSecrets()
+
+	if err != nil {
+		panic(err)
+	}
+
+	notifyConfig, err := notify.LoadConfig(flagConfigFile)
+
+	if err != nil {
+		panic(err)
+	}
+
+	notifier, err := notify.New(notifyConfig)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// systemd sends SIGTERM to stop beacond, while SIGINT is sent by Ctrl+C when it is run from a terminal
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err = server.Run(ctx, ociClient, registryClient, verifier, server.Config{
+		Port:            flagBeacondPort,
+		CleanOnExit:     flagBeacondCleanOnExit,
+		AllowOvercommit: flagAllowOvercommit,
+		StateFile:       flagStateFile,
+		GracePeriod:     flagGracePeriod,
+		Secrets:         secretStore,
+		SecretsDir:      flagSecretsDir,
+		Notifier:        notifier,
+		Network:         flagNetwork,
+		Proxy: proxy.Listen{
+			Addr:    flagProxyAddr,
+			TLSAddr: flagProxyTLSAddr,
+			TLSCert: flagProxyTLSCert,
+			TLSKey:  flagProxyTLSKey,
+		},
+	})
+
+	// Notifications still queued are delivered before exiting
+	notifier.Close()
+
+	if err != nil {
+		log.Error(err)
+		os.Exit(1)
+	}
+}
+
+// openSecrets opens the secret store with the passphrase from --secrets-key-file or the environment. Without a
+// passphrase, no store is opened and secrets are disabled
+func openSecrets() (*secrets.Store, error) {
+	passphrase := []byte(os.Getenv(secretsPassphraseEnv))
+
+	if flagSecretsKeyFile != "" {
+		contents, err := os.ReadFile(flagSecretsKeyFile)
+
+		if err != nil {
+			return nil, err
+		}
+
+		passphrase = bytes.TrimRight(contents, "\r\n")
+	}
+
+	if len(passphrase) == 0 {
+		log.Info("no secrets passphrase was given, secrets are disabled")
+		return nil, nil
+	}
+
+	return secrets.Open(flagSecretsFile, passphrase)
 }
 
 func Execute() error {
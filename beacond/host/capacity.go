@@ -0,0 +1,28 @@
+package host
+
+import (
+	"runtime"
+)
+
+// Capacity is the amount of CPU and memory that the host has available for running containers. A zero value for
+// either means it is unknown, and isn't checked against
+type Capacity struct {
+	CPUs   float64
+	Memory int64
+}
+
+// HostCapacity reports the number of CPUs and the total memory (in bytes) of the host. The total memory is only
+// known on Linux, where it is read from /proc/meminfo. Elsewhere (or if it can't be read) an error is returned
+// alongside the number of CPUs, so that CPU limits can still be checked while memory limits aren't
+func HostCapacity() (Capacity, error) {
+	capacity := Capacity{CPUs: float64(runtime.NumCPU())}
+	memory, err := totalMemory()
+
+	if err != nil {
+		return capacity, err
+	}
+
+	capacity.Memory = memory
+
+	return capacity, nil
+}
@@ -0,0 +1,46 @@
+package host
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CapacitySuite struct {
+	suite.Suite
+}
+
+func TestCapacitySuite(t *testing.T) {
+	suite.Run(t, new(CapacitySuite))
+}
+
+func (c *CapacitySuite) TestParseMemInfo() {
+	memory, err := parseMemInfo(strings.NewReader(`MemTotal:        8039744 kB
+MemFree:          512000 kB
+MemAvailable:    4096000 kB
+`))
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), int64(8039744*1024), memory)
+}
+
+func (c *CapacitySuite) TestParseMemInfoErrors() {
+	for _, meminfo := range []string{
+		"",
+		"MemFree:          512000 kB\n",
+		"MemTotal:        lots kB\n",
+		"MemTotal:\n",
+	} {
+		_, err := parseMemInfo(strings.NewReader(meminfo))
+
+		assert.Error(c.T(), err, meminfo)
+	}
+}
+
+func (c *CapacitySuite) TestHostCapacityKnowsCPUs() {
+	capacity, _ := HostCapacity()
+
+	assert.Greater(c.T(), capacity.CPUs, float64(0))
+}
@@ -0,0 +1,37 @@
+package host
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// parseMemInfo reads the total memory (in bytes) from the contents of /proc/meminfo
+func parseMemInfo(meminfo io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(meminfo)
+
+	// EG: MemTotal:        8039744 kB
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+
+		kilobytes, err := strconv.ParseInt(fields[1], 10, 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("error parsing host memory %q: %s", scanner.Text(), err)
+		}
+
+		return kilobytes * 1024, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("error reading host memory: %s", err)
+	}
+
+	return 0, fmt.Errorf("error reading host memory: MemTotal not found in /proc/meminfo")
+}
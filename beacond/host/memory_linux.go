@@ -0,0 +1,20 @@
+//go:build linux
+
+package host
+
+import (
+	"fmt"
+	"os"
+)
+
+func totalMemory() (int64, error) {
+	meminfo, err := os.Open("/proc/meminfo")
+
+	if err != nil {
+		return 0, fmt.Errorf("error reading host memory: %s", err)
+	}
+
+	defer meminfo.Close()
+
+	return parseMemInfo(meminfo)
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package host
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func totalMemory() (int64, error) {
+	return 0, fmt.Errorf("reading host memory is not supported on %s", runtime.GOOS)
+}
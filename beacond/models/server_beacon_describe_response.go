@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -17,23 +18,135 @@ import (
 // swagger:model server.BeaconDescribeResponse
 type ServerBeaconDescribeResponse struct {
 
+	// allocated
+	Allocated *ServerHostResources `json:"allocated,omitempty"`
+
+	// capacity
+	Capacity *ServerHostResources `json:"capacity,omitempty"`
+
 	// probes
 	Probes []string `json:"probes"`
 
 	// registry
-	Registry string `json:"registry,omitempty"`
+	Registry string `json:"registry"`
 
 	// runtime
-	Runtime string `json:"runtime,omitempty"`
+	Runtime string `json:"runtime"`
 }
 
 // Validate validates this server beacon describe response
 func (m *ServerBeaconDescribeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAllocated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCapacity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ServerBeaconDescribeResponse) validateAllocated(formats strfmt.Registry) error {
+	if swag.IsZero(m.Allocated) { // not required
+		return nil
+	}
+
+	if m.Allocated != nil {
+		if err := m.Allocated.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("allocated")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("allocated")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServerBeaconDescribeResponse) validateCapacity(formats strfmt.Registry) error {
+	if swag.IsZero(m.Capacity) { // not required
+		return nil
+	}
+
+	if m.Capacity != nil {
+		if err := m.Capacity.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("capacity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("capacity")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this server beacon describe response based on context it is used
+// ContextValidate validate this server beacon describe response based on the context it is used
 func (m *ServerBeaconDescribeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAllocated(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCapacity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ServerBeaconDescribeResponse) contextValidateAllocated(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Allocated != nil {
+
+		if swag.IsZero(m.Allocated) { // not required
+			return nil
+		}
+
+		if err := m.Allocated.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("allocated")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("allocated")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServerBeaconDescribeResponse) contextValidateCapacity(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Capacity != nil {
+
+		if swag.IsZero(m.Capacity) { // not required
+			return nil
+		}
+
+		if err := m.Capacity.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("capacity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("capacity")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
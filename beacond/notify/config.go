@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config is the notifications section of beacond's config file, for example:
+//
+//	notifications:
+//	  sinks:
+//	    ops:
+//	      type: discord
+//	      url: https://discord.com/api/webhooks/...
+//	      events: [deploy_failed, rollback, probe_exited]
+//	  notify: [ops]
+type Config struct {
+	// The sinks that can be notified, by name. Names are case insensitive
+	Sinks map[string]SinkConfig `mapstructure:"sinks"`
+	// The sinks notified about every probe. Other sinks are only notified about the probes that name them
+	Notify []string `mapstructure:"notify"`
+}
+
+// SinkConfig configures a sink. Which fields are needed depends on its type
+type SinkConfig struct {
+	Type SinkType `mapstructure:"type"`
+	// The URL webhook, discord and telegram sinks post to
+	URL string `mapstructure:"url"`
+	// The key webhook bodies are signed with
+	Secret string `mapstructure:"secret"`
+	// The bot token and chat telegram messages are sent with and to
+	Token  string `mapstructure:"token"`
+	ChatID string `mapstructure:"chat_id"`
+	// The SMTP server (as host:port) and addresses emails are sent through, from and to
+	Addr     string   `mapstructure:"addr"`
+	From     string   `mapstructure:"from"`
+	To       []string `mapstructure:"to"`
+	Username string   `mapstructure:"username"`
+	Password string   `mapstructure:"password"`
+	// The kinds of notification the sink is sent. All of them if left out
+	Events []string `mapstructure:"events"`
+}
+
+// LoadConfig reads the notifications section of the config file at path, which can be YAML, JSON or TOML. A missing
+// file configures no sinks
+func LoadConfig(path string) (Config, error) {
+	var config Config
+
+	if path == "" {
+		return config, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return config, nil
+		}
+
+		return config, fmt.Errorf("error reading config file %s: %s", path, err)
+	}
+
+	if err := v.UnmarshalKey("notifications", &config); err != nil {
+		return config, fmt.Errorf("error reading notifications from config file %s: %s", path, err)
+	}
+
+	for name, sink := range config.Sinks {
+		sink.Type = SinkType(strings.ToLower(string(sink.Type)))
+		config.Sinks[name] = sink
+	}
+
+	return config, nil
+}
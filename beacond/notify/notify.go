@@ -0,0 +1,251 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	DigestDetected  Kind = "digest_detected"
+	DeploySucceeded Kind = "deploy_succeeded"
+	DeployFailed    Kind = "deploy_failed"
+	Rollback        Kind = "rollback"
+	ProbeExited     Kind = "probe_exited"
+)
+
+// Kind is what happened to a probe that a notification is sent for
+type Kind string
+
+// Kinds lists every kind of notification, for validating the ones a sink is limited to
+var Kinds = []Kind{DigestDetected, DeploySucceeded, DeployFailed, Rollback, ProbeExited}
+
+// How long a sink has to deliver a notification before it is abandoned
+const sendTimeout = 10 * time.Second
+
+// How many notifications can be waiting for a slow sink before new ones are dropped
+const queueSize = 100
+
+// Notification is sent to sinks when something happens to a probe that its owner will want to know about
+type Notification struct {
+	Kind Kind `json:"kind"`
+	// The ref (namespace/repo) of the probe
+	Probe   string `json:"probe"`
+	Digest  string `json:"digest,omitempty"`
+	Message string `json:"message"`
+	// The hostname of the machine the beacon runs on, to tell beacons apart in a shared chat
+	Host string    `json:"host"`
+	Time time.Time `json:"time"`
+}
+
+// Title is a one line summary of the notification, used as the subject of emails
+func (n Notification) Title() string {
+	return fmt.Sprintf("[beacon %s] %s: %s", n.Host, n.Probe, strings.ReplaceAll(string(n.Kind), "_", " "))
+}
+
+// Text is the notification as a message for chats
+func (n Notification) Text() string {
+	return fmt.Sprintf("%s\n%s", n.Title(), n.Message)
+}
+
+// Sink delivers notifications somewhere, such as a webhook or an email inbox
+type Sink interface {
+	Send(context.Context, Notification) error
+}
+
+// Notifier sends notifications to the sinks configured for the beacon and those its probes ask for. Each sink has its
+// own queue, so a slow or failing sink doesn't hold up the others. A nil Notifier sends nothing
+type Notifier struct {
+	host   string
+	sinks  map[string]*queuedSink
+	global []string
+	wg     sync.WaitGroup
+	// mu guards closed, so that nothing is queued once the queues are closed
+	mu     sync.RWMutex
+	closed bool
+}
+
+type queuedSink struct {
+	name  string
+	sink  Sink
+	kinds map[Kind]bool
+	queue chan Notification
+}
+
+// New creates a notifier for the sinks in the config, starting a goroutine for each of them
+func New(config Config) (*Notifier, error) {
+	sinks := make(map[string]*queuedSink)
+
+	for name, sinkConfig := range config.Sinks {
+		name = strings.ToLower(name)
+		sink, err := newSink(sinkConfig)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring notification sink %s: %s", name, err)
+		}
+
+		kinds, err := kindSet(sinkConfig.Events)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring notification sink %s: %s", name, err)
+		}
+
+		sinks[name] = &queuedSink{name: name, sink: sink, kinds: kinds, queue: make(chan Notification, queueSize)}
+	}
+
+	host, _ := os.Hostname()
+
+	n := &Notifier{host: host, sinks: sinks}
+
+	for _, name := range config.Notify {
+		name = strings.ToLower(name)
+
+		if _, ok := sinks[name]; !ok {
+			return nil, fmt.Errorf("notification sink %s is not configured", name)
+		}
+
+		n.global = append(n.global, name)
+	}
+
+	for _, s := range sinks {
+		n.wg.Add(1)
+		go n.deliver(s)
+	}
+
+	return n, nil
+}
+
+// Check returns an error if any of the named sinks isn't configured
+func (n *Notifier) Check(names []string) error {
+	var unknown []string
+
+	for _, name := range names {
+		if n == nil || n.sinks[strings.ToLower(name)] == nil {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("notification sinks are not configured: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// Sinks lists the names of the configured sinks
+func (n *Notifier) Sinks() []string {
+	if n == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(n.sinks))
+
+	for name := range n.sinks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Notify queues the notification for the beacon's sinks and the probe's own sinks, leaving out any sink that isn't
+// interested in its kind. It never blocks: if a sink has fallen too far behind, the notification is dropped for it
+func (n *Notifier) Notify(probeSinks []string, notification Notification) {
+	if n == nil {
+		return
+	}
+
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	if n.closed {
+		return
+	}
+
+	notification.Host = n.host
+
+	if notification.Time.IsZero() {
+		notification.Time = time.Now()
+	}
+
+	seen := make(map[string]bool)
+
+	for _, name := range append(append([]string{}, n.global...), probeSinks...) {
+		name = strings.ToLower(name)
+		s, ok := n.sinks[name]
+
+		if !ok || seen[name] || (len(s.kinds) > 0 && !s.kinds[notification.Kind]) {
+			continue
+		}
+
+		seen[name] = true
+
+		select {
+		case s.queue <- notification:
+		default:
+			log.Errorf("dropping %s notification for probe %s: sink %s is too far behind", notification.Kind, notification.Probe, name)
+		}
+	}
+}
+
+// Close stops accepting notifications and waits for the ones already queued to be delivered
+func (n *Notifier) Close() {
+	if n == nil {
+		return
+	}
+
+	n.mu.Lock()
+
+	if !n.closed {
+		n.closed = true
+
+		for _, s := range n.sinks {
+			close(s.queue)
+		}
+	}
+
+	n.mu.Unlock()
+	n.wg.Wait()
+}
+
+func (n *Notifier) deliver(s *queuedSink) {
+	defer n.wg.Done()
+
+	for notification := range s.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+		err := s.sink.Send(ctx, notification)
+		cancel()
+
+		if err != nil {
+			log.Errorf("error sending %s notification for probe %s to sink %s: %s", notification.Kind, notification.Probe, s.name, err)
+		}
+	}
+}
+
+func kindSet(events []string) (map[Kind]bool, error) {
+	kinds := make(map[Kind]bool)
+
+	for _, event := range events {
+		kind := Kind(strings.ToLower(event))
+		known := false
+
+		for _, k := range Kinds {
+			known = known || k == kind
+		}
+
+		if !known {
+			return nil, fmt.Errorf("unknown event %q, expected one of %v", event, Kinds)
+		}
+
+		kinds[kind] = true
+	}
+
+	return kinds, nil
+}
@@ -0,0 +1,331 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeWebhook records the requests posted to it
+type fakeWebhook struct {
+	mu      sync.Mutex
+	bodies  [][]byte
+	headers []http.Header
+	status  int
+}
+
+func newFakeWebhook() *fakeWebhook {
+	return &fakeWebhook{status: http.StatusOK}
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	f.bodies = append(f.bodies, body)
+	f.headers = append(f.headers, r.Header)
+	f.mu.Unlock()
+
+	w.WriteHeader(f.status)
+}
+
+// fakeSMTP is a minimal SMTP server that accepts every email it is sent
+type fakeSMTP struct {
+	listener net.Listener
+	mu       sync.Mutex
+	emails   []string
+	rcpts    []string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fakeSMTP{listener: listener}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			go f.serve(conn)
+		}
+	}()
+
+	return f
+}
+
+func (f *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	fmt.Fprintf(conn, "220 localhost fake\r\n")
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			fmt.Fprintf(conn, "250-localhost\r\n250 AUTH PLAIN\r\n")
+		case strings.HasPrefix(command, "AUTH"):
+			fmt.Fprintf(conn, "235 ok\r\n")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			f.mu.Lock()
+			f.rcpts = append(f.rcpts, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			f.mu.Unlock()
+
+			fmt.Fprintf(conn, "250 ok\r\n")
+		case command == "DATA":
+			fmt.Fprintf(conn, "354 go ahead\r\n")
+
+			var email strings.Builder
+
+			for {
+				line, err := reader.ReadString('\n')
+
+				if err != nil || line == ".\r\n" {
+					break
+				}
+
+				email.WriteString(line)
+			}
+
+			f.mu.Lock()
+			f.emails = append(f.emails, email.String())
+			f.mu.Unlock()
+
+			fmt.Fprintf(conn, "250 ok\r\n")
+		case command == "QUIT":
+			fmt.Fprintf(conn, "221 bye\r\n")
+			return
+		default:
+			fmt.Fprintf(conn, "250 ok\r\n")
+		}
+	}
+}
+
+type NotifySuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestNotifySuite(t *testing.T) {
+	suite.Run(t, new(NotifySuite))
+}
+
+func (n *NotifySuite) SetupTest() {
+	n.LogBuff = new(bytes.Buffer)
+	log.SetOutput(n.LogBuff)
+}
+
+func (n *NotifySuite) notification(kind Kind) Notification {
+	return Notification{Kind: kind, Probe: "fakeNamespace/fakeRepo", Digest: "fakeDigest", Message: "fake message", Time: time.Unix(0, 0).UTC()}
+}
+
+func (n *NotifySuite) TestWebhookIsSigned() {
+	webhook := newFakeWebhook()
+	server := httptest.NewServer(webhook)
+	defer server.Close()
+
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{"hook": {Type: WebhookSink, URL: server.URL, Secret: "fakeSecret"}}, Notify: []string{"hook"}})
+
+	assert.NoError(n.T(), err)
+
+	notifier.Notify(nil, n.notification(DeploySucceeded))
+	notifier.Close()
+
+	assert.Len(n.T(), webhook.bodies, 1)
+
+	var received Notification
+
+	assert.NoError(n.T(), json.Unmarshal(webhook.bodies[0], &received))
+	assert.Equal(n.T(), DeploySucceeded, received.Kind)
+	assert.Equal(n.T(), "fakeDigest", received.Digest)
+	assert.Equal(n.T(), Sign("fakeSecret", webhook.bodies[0]), webhook.headers[0].Get(SignatureHeader))
+}
+
+func (n *NotifySuite) TestChatPayloads() {
+	discord := newFakeWebhook()
+	discordServer := httptest.NewServer(discord)
+	defer discordServer.Close()
+
+	telegram := newFakeWebhook()
+	telegramServer := httptest.NewServer(telegram)
+	defer telegramServer.Close()
+
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{
+		"discord":  {Type: DiscordSink, URL: discordServer.URL},
+		"telegram": {Type: TelegramSink, URL: telegramServer.URL, ChatID: "1234"},
+	}, Notify: []string{"discord", "telegram"}})
+
+	assert.NoError(n.T(), err)
+
+	notification := n.notification(DeployFailed)
+
+	notifier.Notify(nil, notification)
+	notifier.Close()
+
+	notification.Host = notifier.host
+
+	assert.JSONEq(n.T(), fmt.Sprintf(`{"username": "beacon", "content": %q}`, notification.Text()), string(discord.bodies[0]))
+	assert.JSONEq(n.T(), fmt.Sprintf(`{"chat_id": "1234", "text": %q}`, notification.Text()), string(telegram.bodies[0]))
+	assert.Contains(n.T(), notification.Text(), "fakeNamespace/fakeRepo: deploy failed")
+}
+
+func (n *NotifySuite) TestSMTP() {
+	server := newFakeSMTP(n.T())
+	defer server.listener.Close()
+
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{
+		"email": {Type: SMTPSink, Addr: server.listener.Addr().String(), From: "beacon@example.com", To: []string{"ops@example.com"}, Username: "fakeUser", Password: "fakePassword"},
+	}})
+
+	assert.NoError(n.T(), err)
+
+	notifier.Notify([]string{"email"}, n.notification(ProbeExited))
+	notifier.Close()
+
+	assert.Equal(n.T(), []string{"ops@example.com"}, server.rcpts)
+	assert.Len(n.T(), server.emails, 1)
+	assert.Contains(n.T(), server.emails[0], "Subject: [beacon "+notifier.host+"] fakeNamespace/fakeRepo: probe exited\r\n")
+	assert.Contains(n.T(), server.emails[0], "\r\n\r\nfake message\r\n")
+}
+
+func (n *NotifySuite) TestSinksAreChosenByProbeAndKind() {
+	global := newFakeWebhook()
+	globalServer := httptest.NewServer(global)
+	defer globalServer.Close()
+
+	probe := newFakeWebhook()
+	probeServer := httptest.NewServer(probe)
+	defer probeServer.Close()
+
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{
+		"Global": {Type: WebhookSink, URL: globalServer.URL, Events: []string{"deploy_failed"}},
+		"probe":  {Type: WebhookSink, URL: probeServer.URL},
+	}, Notify: []string{"global"}})
+
+	assert.NoError(n.T(), err)
+
+	// The global sink only wants failures, while the probe sink is only notified about the probe that names it
+	notifier.Notify(nil, n.notification(DeploySucceeded))
+	notifier.Notify(nil, n.notification(DeployFailed))
+	notifier.Notify([]string{"Probe", "global"}, n.notification(DigestDetected))
+	notifier.Notify([]string{"probe", "global"}, n.notification(DeployFailed))
+	notifier.Close()
+
+	assert.Len(n.T(), global.bodies, 2)
+	assert.Len(n.T(), probe.bodies, 2)
+}
+
+func (n *NotifySuite) TestFailingSinkIsLogged() {
+	webhook := newFakeWebhook()
+	webhook.status = http.StatusInternalServerError
+	server := httptest.NewServer(webhook)
+	defer server.Close()
+
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{"hook": {Type: WebhookSink, URL: server.URL + "/secretToken"}}, Notify: []string{"hook"}})
+
+	assert.NoError(n.T(), err)
+
+	notifier.Notify(nil, n.notification(Rollback))
+	notifier.Close()
+
+	assert.Contains(n.T(), n.LogBuff.String(), "error sending rollback notification for probe fakeNamespace/fakeRepo to sink hook: error posting notification: status 500")
+	assert.NotContains(n.T(), n.LogBuff.String(), "secretToken")
+}
+
+func (n *NotifySuite) TestInvalidConfig() {
+	for _, config := range []Config{
+		{Sinks: map[string]SinkConfig{"hook": {Type: "carrier-pigeon"}}},
+		{Sinks: map[string]SinkConfig{"hook": {Type: WebhookSink}}},
+		{Sinks: map[string]SinkConfig{"hook": {Type: TelegramSink, Token: "fakeToken"}}},
+		{Sinks: map[string]SinkConfig{"hook": {Type: WebhookSink, URL: "http://localhost", Events: []string{"deployed"}}}},
+		{Notify: []string{"missing"}},
+	} {
+		_, err := New(config)
+
+		assert.Error(n.T(), err, "%+v", config)
+	}
+}
+
+func (n *NotifySuite) TestCheck() {
+	notifier, err := New(Config{Sinks: map[string]SinkConfig{"hook": {Type: WebhookSink, URL: "http://localhost"}}})
+
+	assert.NoError(n.T(), err)
+	assert.NoError(n.T(), notifier.Check([]string{"HOOK"}))
+	assert.ErrorContains(n.T(), notifier.Check([]string{"hook", "missing"}), "not configured: missing")
+
+	notifier.Close()
+
+	var disabled *Notifier
+
+	assert.Error(n.T(), disabled.Check([]string{"hook"}))
+	disabled.Notify([]string{"hook"}, n.notification(DeployFailed))
+}
+
+func (n *NotifySuite) TestLoadConfig() {
+	path := filepath.Join(n.T().TempDir(), "beacond.yaml")
+	content := `
+notifications:
+  sinks:
+    Ops:
+      type: Discord
+      url: https://discord.example.com/webhook
+      events: [deploy_failed, rollback]
+    bot:
+      type: telegram
+      token: fakeToken
+      chat_id: "-1234"
+  notify: [ops]
+`
+
+	assert.NoError(n.T(), os.WriteFile(path, []byte(content), 0600))
+
+	config, err := LoadConfig(path)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), Config{
+		Sinks: map[string]SinkConfig{
+			"ops": {Type: DiscordSink, URL: "https://discord.example.com/webhook", Events: []string{"deploy_failed", "rollback"}},
+			"bot": {Type: TelegramSink, Token: "fakeToken", ChatID: "-1234"},
+		},
+		Notify: []string{"ops"},
+	}, config)
+
+	notifier, err := New(config)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), []string{"bot", "ops"}, notifier.Sinks())
+	notifier.Close()
+
+	config, err = LoadConfig(filepath.Join(n.T().TempDir(), "missing.yaml"))
+
+	assert.NoError(n.T(), err)
+	assert.Empty(n.T(), config.Sinks)
+}
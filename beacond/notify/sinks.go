@@ -0,0 +1,220 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	WebhookSink  SinkType = "webhook"
+	DiscordSink  SinkType = "discord"
+	TelegramSink SinkType = "telegram"
+	SMTPSink     SinkType = "smtp"
+)
+
+type SinkType string
+
+// The header webhooks are signed in, as sha256=<hex encoded HMAC of the body>
+const SignatureHeader = "X-Beacon-Signature"
+
+// The Telegram bot API method that sends a message to a chat
+const telegramAPI = "https://api.telegram.org/bot%s/sendMessage"
+
+var httpClient = &http.Client{Timeout: sendTimeout}
+
+func newSink(config SinkConfig) (Sink, error) {
+	switch config.Type {
+	case WebhookSink:
+		if config.URL == "" {
+			return nil, fmt.Errorf("a url is needed for webhooks")
+		}
+
+		return Webhook{URL: config.URL, Secret: config.Secret}, nil
+	case DiscordSink:
+		if config.URL == "" {
+			return nil, fmt.Errorf("a url is needed for discord webhooks")
+		}
+
+		return Discord{URL: config.URL}, nil
+	case TelegramSink:
+		endpoint := config.URL
+
+		if endpoint == "" && config.Token != "" {
+			endpoint = fmt.Sprintf(telegramAPI, config.Token)
+		}
+
+		if endpoint == "" || config.ChatID == "" {
+			return nil, fmt.Errorf("a token (or url) and chat_id are needed for telegram")
+		}
+
+		return Telegram{URL: endpoint, ChatID: config.ChatID}, nil
+	case SMTPSink:
+		if config.Addr == "" || config.From == "" || len(config.To) == 0 {
+			return nil, fmt.Errorf("an addr, from and to are needed for smtp")
+		}
+
+		return SMTP{Addr: config.Addr, From: config.From, To: config.To, Username: config.Username, Password: config.Password}, nil
+	default:
+		return nil, fmt.Errorf("sink type not supported: %q", config.Type)
+	}
+}
+
+// Webhook posts notifications as JSON. If it has a secret, the body is signed with it so that the receiver can check
+// the notification came from beacon
+type Webhook struct {
+	URL    string
+	Secret string
+}
+
+func (w Webhook) Send(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+
+	if w.Secret != "" {
+		header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	return post(ctx, w.URL, body, header)
+}
+
+// Sign is the signature of a webhook body, as sent in SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Discord posts notifications as messages to a Discord webhook
+type Discord struct {
+	URL string
+}
+
+func (d Discord) Send(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(map[string]string{"username": "beacon", "content": notification.Text()})
+
+	if err != nil {
+		return err
+	}
+
+	return post(ctx, d.URL, body, nil)
+}
+
+// Telegram sends notifications as messages to a chat through a Telegram bot
+type Telegram struct {
+	URL    string
+	ChatID string
+}
+
+func (t Telegram) Send(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(map[string]string{"chat_id": t.ChatID, "text": notification.Text()})
+
+	if err != nil {
+		return err
+	}
+
+	return post(ctx, t.URL, body, nil)
+}
+
+// SMTP emails notifications. Credentials are only sent over TLS, unless the server is on the same host
+type SMTP struct {
+	// The host:port of the SMTP server
+	Addr     string
+	From     string
+	To       []string
+	Username string
+	Password string
+}
+
+func (s SMTP) Send(ctx context.Context, notification Notification) error {
+	var auth smtp.Auth
+
+	if s.Username != "" {
+		host, _, err := net.SplitHostPort(s.Addr)
+
+		if err != nil {
+			return fmt.Errorf("invalid smtp addr %s: %s", s.Addr, err)
+		}
+
+		auth = smtp.PlainAuth("", s.Username, s.Password, host)
+	}
+
+	var message bytes.Buffer
+
+	fmt.Fprintf(&message, "From: %s\r\n", s.From)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", notification.Title())
+	fmt.Fprintf(&message, "Date: %s\r\n", notification.Time.Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&message, "%s\r\n", strings.ReplaceAll(notification.Message, "\n", "\r\n"))
+
+	// smtp.SendMail can't be cancelled, so it is abandoned (rather than stopped) when ctx is done
+	done := make(chan error, 1)
+
+	go func() {
+		done <- smtp.SendMail(s.Addr, auth, s.From, s.To, message.Bytes())
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("error sending email through %s: %s", s.Addr, err)
+		}
+
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("error sending email through %s: %s", s.Addr, ctx.Err())
+	}
+}
+
+// post sends the body to the URL. Webhook URLs can hold tokens (as Telegram's do), so they are left out of errors
+func post(ctx context.Context, endpoint string, body []byte, header http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+
+	if err != nil {
+		return err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	if err != nil {
+		return fmt.Errorf("error posting notification: %s", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		content, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+
+		return fmt.Errorf("error posting notification: status %d: %s", resp.StatusCode, strings.TrimSpace(string(content)))
+	}
+
+	return nil
+}
@@ -0,0 +1,593 @@
+package oci
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/labstack/gommon/log"
+)
+
+// The containerd namespace that beacon's images and containers live in, which keeps them apart from those managed
+// by k3s (in the k8s.io namespace) or started by hand (in the default namespace)
+const NerdctlNamespace = "beacon"
+
+// NerdctlClient manages containers in containerd through the nerdctl CLI
+type NerdctlClient struct {
+	runner Runner
+}
+
+func NewNerdctl() (OCIRuntime, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return NerdctlClient{runner: PosixRunner{}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported OS: %s", runtime.GOOS)
+	}
+}
+
+func (n NerdctlClient) Type() OCIRuntimeType {
+	return Containerd
+}
+
+// dockerConfigDir writes the credentials to a temporary docker config directory, which the caller removes
+func dockerConfigDir(auth *RegistryAuth) (string, error) {
+	content, err := authConfig(auth)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding credentials for %s: %s", auth.Server, err)
+	}
+
+	dir, err := os.MkdirTemp(tmpfsDir(), "beacon-docker-config-")
+
+	if err != nil {
+		return "", fmt.Errorf("error creating docker config directory: %s", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "config.json"), content, 0600); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("error writing docker config: %s", err)
+	}
+
+	return dir, nil
+}
+
+// run runs a nerdctl command in beacon's namespace
+func (n NerdctlClient) run(ctx context.Context, args ...string) ([]byte, error) {
+	return n.runner.run(ctx, append([]string{"nerdctl", "--namespace", NerdctlNamespace}, args...)...)
+}
+
+func (n NerdctlClient) CheckExists(ctx context.Context) (bool, error) {
+	output, err := n.runner.run(ctx, "nerdctl", "--version")
+
+	if err != nil {
+		return false, fmt.Errorf("error checking nerdctl exists. Output was: %s; Error was: %s", output, err)
+	}
+
+	if strings.Contains(string(output), "version") {
+		return true, nil
+	}
+
+	return false, fmt.Errorf("could not check if nerdctl is installed. The output was not recognised. Output was: %s", string(output))
+}
+
+// RunImage starts a detached container for the image and returns its ID
+func (n NerdctlClient) RunImage(ctx context.Context, imageRef string, options RunOptions) (string, error) {
+	aliasArgs, err := hostnameArgs(options.NetworkAliases)
+
+	if err != nil {
+		return "", err
+	}
+
+	envArgs, cleanUp, err := envFileArgs(options.Env)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer cleanUp()
+
+	args := []string{"run", "--detach"}
+	args = append(args, resourceArgs(options.Resources)...)
+	args = append(args, labelArgs(options.Labels)...)
+	args = append(args, portArgs(options.Ports)...)
+	args = append(args, envArgs...)
+	args = append(args, mountArgs(options.Mounts)...)
+	args = append(args, volumeArgs(options.Volumes)...)
+	args = append(args, networkArgs(options.Networks)...)
+	args = append(args, aliasArgs...)
+	args = append(args, imageRef)
+	args = append(args, options.Command...)
+
+	output, err := n.run(ctx, args...)
+
+	if err != nil {
+		return "", fmt.Errorf("error running nerdctl image %s. Output was: %s; Error was: %s", imageRef, output, err)
+	}
+
+	lines := strings.Fields(strings.TrimSpace(string(output)))
+
+	if len(lines) == 0 {
+		return "", fmt.Errorf("error running nerdctl image %s. No container ID was returned", imageRef)
+	}
+
+	return lines[len(lines)-1], nil
+}
+
+// PullImage pulls the image. nerdctl has no flag for an auth file, so credentials are passed in a docker config
+// directory pointed to by DOCKER_CONFIG
+func (n NerdctlClient) PullImage(ctx context.Context, imageRef string, auth *RegistryAuth) error {
+	var output []byte
+	var err error
+
+	if auth == nil {
+		output, err = n.run(ctx, "pull", imageRef)
+	} else {
+		var configDir string
+
+		if configDir, err = dockerConfigDir(auth); err != nil {
+			return err
+		}
+
+		defer os.RemoveAll(configDir)
+
+		output, err = n.runner.run(ctx, "env", "DOCKER_CONFIG="+configDir, "nerdctl", "--namespace", NerdctlNamespace, "pull", imageRef)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error pulling nerdctl image %s. Output was: %s; Error was: %s", imageRef, output, err)
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) RemoveImages(ctx context.Context, refPrefix string, olderThanRef string) error {
+	images, err := n.GetImages(ctx, refPrefix, olderThanRef, true)
+
+	if err != nil {
+		return err
+	}
+
+	if len(images) == 0 {
+		return nil
+	}
+
+	output, err := n.run(ctx, append([]string{"rmi"}, images...)...)
+
+	if err != nil {
+		return fmt.Errorf("error removing nerdctl images. Output was: %s; Error was: %s", output, err)
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) GetImages(ctx context.Context, refPrefix string, olderThanImageRef string, dangling bool) ([]string, error) {
+	output, err := n.run(ctx, "images", "--format", "json",
+		fmt.Sprintf("--filter=reference=%s", refPrefix),
+		fmt.Sprintf("--filter=before=%s", olderThanImageRef),
+		fmt.Sprintf("--filter=dangling=%t", dangling),
+	)
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error getting images associated with prefix %s. Output was: %s; Error was: %s", refPrefix, output, err)
+	}
+
+	var imageIDs []string
+
+	err = decodeJSONLines(output, func(decode func(interface{}) error) error {
+		var image struct {
+			ID string `json:"ID"`
+		}
+
+		if err := decode(&image); err != nil {
+			return err
+		}
+
+		if image.ID != "" {
+			imageIDs = append(imageIDs, image.ID)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error parsing images output for ref prefix %s. Output was: %s; Error was: %s", refPrefix, output, err)
+	}
+
+	return imageIDs, nil
+}
+
+func (n NerdctlClient) StopContainersByImage(ctx context.Context, imageRef string) error {
+	containers, err := n.ContainersUsingImage(ctx, imageRef, []string{"running"})
+
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		err = n.StopContainer(ctx, container)
+
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) StopContainer(ctx context.Context, containerID string) error {
+	output, err := n.run(ctx, "stop", containerID)
+
+	if err != nil {
+		return fmt.Errorf("error stopping container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) StartContainer(ctx context.Context, containerID string) error {
+	output, err := n.run(ctx, "start", containerID)
+
+	if err != nil {
+		return fmt.Errorf("error starting container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) RemoveContainer(ctx context.Context, containerID string) error {
+	output, err := n.run(ctx, "rm", "--force", containerID)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "no such container") {
+			return fmt.Errorf("error removing container %s: %w", containerID, ErrNoSuchContainer)
+		}
+
+		return fmt.Errorf("error removing container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return nil
+}
+
+// WaitContainer waits for the container to exit and returns its exit code
+func (n NerdctlClient) WaitContainer(ctx context.Context, containerID string) (int, error) {
+	output, err := n.run(ctx, "wait", containerID)
+
+	if err != nil {
+		return 0, fmt.Errorf("error waiting for container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return parseExitCode(containerID, output)
+}
+
+// ContainerLogs returns what the container has printed to stdout and stderr, interleaved
+func (n NerdctlClient) ContainerLogs(ctx context.Context, containerID string) ([]byte, error) {
+	output, err := n.run(ctx, "logs", containerID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return output, nil
+}
+
+// hostnameArgs stands in for network aliases, which nerdctl doesn't have. Containers on its networks reach each
+// other by container name or hostname instead, and unlike container names, a hostname can be shared by several
+// containers. A container only has the one hostname though, so it can't be given more than one alias
+func hostnameArgs(aliases []string) ([]string, error) {
+	switch len(aliases) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []string{"--hostname", aliases[0]}, nil
+	}
+
+	return nil, fmt.Errorf("nerdctl can only give a container one name on its networks, as its hostname, got %s", strings.Join(aliases, ", "))
+}
+
+// CreateNetwork creates a bridge network with the labels unless a network with the name already exists
+func (n NerdctlClient) CreateNetwork(ctx context.Context, name string, labels map[string]string) error {
+	if _, err := n.run(ctx, "network", "inspect", name); err == nil {
+		return nil
+	}
+
+	args := []string{"network", "create"}
+	args = append(args, labelArgs(labels)...)
+	args = append(args, name)
+
+	output, err := n.run(ctx, args...)
+
+	if err != nil {
+		return fmt.Errorf("error creating network %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+func (n NerdctlClient) RemoveNetwork(ctx context.Context, name string) error {
+	output, err := n.run(ctx, "network", "rm", name)
+
+	if err != nil {
+		return fmt.Errorf("error removing network %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// CreateVolume creates a named volume with the labels unless a volume with the name already exists
+func (n NerdctlClient) CreateVolume(ctx context.Context, name string, labels map[string]string) error {
+	if _, err := n.run(ctx, "volume", "inspect", name); err == nil {
+		return nil
+	}
+
+	args := []string{"volume", "create"}
+	args = append(args, labelArgs(labels)...)
+	args = append(args, name)
+
+	output, err := n.run(ctx, args...)
+
+	if err != nil {
+		return fmt.Errorf("error creating volume %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// ListVolumes lists the volumes that have every one of the labels. Older releases of nerdctl can't filter volumes,
+// so they are filtered here. nerdctl doesn't record when volumes were created, so CreatedAt is left as zero
+func (n NerdctlClient) ListVolumes(ctx context.Context, labels map[string]string) ([]Volume, error) {
+	output, err := n.run(ctx, "volume", "ls", "--format", "json")
+
+	if err != nil {
+		return []Volume{}, fmt.Errorf("error listing volumes. Output was: %s; Error was: %s", output, err)
+	}
+
+	volumes := []Volume{}
+
+	err = decodeJSONLines(output, func(decode func(interface{}) error) error {
+		// EG: {"Driver":"local","Labels":"com.lytbeacon.probe=myorg/bot,...","Mountpoint":"/var/lib/nerdctl/...","Name":"beacon_myorg_bot_data"}
+		var volume struct {
+			Name       string `json:"Name"`
+			Labels     string `json:"Labels"`
+			Mountpoint string `json:"Mountpoint"`
+		}
+
+		if err := decode(&volume); err != nil {
+			return err
+		}
+
+		volumeLabels := parseLabels(volume.Labels)
+
+		if volume.Name == "" || !labelsMatch(volumeLabels, labels) {
+			return nil
+		}
+
+		volumes = append(volumes, Volume{
+			Name:       volume.Name,
+			Labels:     volumeLabels,
+			Mountpoint: volume.Mountpoint,
+			Size:       volumeSize(volume.Mountpoint),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return []Volume{}, fmt.Errorf("error parsing volumes output. Output was: %s; Error was: %s", output, err)
+	}
+
+	return volumes, nil
+}
+
+func (n NerdctlClient) RemoveVolume(ctx context.Context, name string) error {
+	output, err := n.run(ctx, "volume", "rm", name)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "not found") {
+			return fmt.Errorf("error removing volume %s: %w", name, ErrNoSuchVolume)
+		}
+
+		return fmt.Errorf("error removing volume %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// InspectContainer reads the container's state from nerdctl's Docker compatible inspect output
+func (n NerdctlClient) InspectContainer(ctx context.Context, containerID string) (ContainerState, error) {
+	output, err := n.run(ctx, "container", "inspect", containerID)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "no such container") {
+			return ContainerState{}, fmt.Errorf("error inspecting container %s: %w", containerID, ErrNoSuchContainer)
+		}
+
+		return ContainerState{}, fmt.Errorf("error inspecting container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	var containers []containerInspect
+
+	err = json.Unmarshal(output, &containers)
+
+	if err != nil {
+		return ContainerState{}, fmt.Errorf("error parsing inspect output for container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	if len(containers) == 0 {
+		return ContainerState{}, fmt.Errorf("error inspecting container %s: %w", containerID, ErrNoSuchContainer)
+	}
+
+	return containers[0].state(), nil
+}
+
+// ContainersUsingImage lists the containers created from the image. nerdctl can't filter containers by image or
+// status, so every container in beacon's namespace is listed and filtered here instead
+func (n NerdctlClient) ContainersUsingImage(ctx context.Context, imageRef string, statuses []string) ([]string, error) {
+	output, err := n.run(ctx, "ps", "--all", "--no-trunc", "--format", "json")
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error getting containers associated with image %s. Output was: %s; Error was: %s", imageRef, output, err)
+	}
+
+	var containerIDs []string
+
+	err = decodeJSONLines(output, func(decode func(interface{}) error) error {
+		// EG: {"ID":"3a8b...","Image":"docker.io/library/httpd@sha256:e449...","Status":"Up","Names":"httpd-3a8b1"}
+		var container struct {
+			ID     string `json:"ID"`
+			Image  string `json:"Image"`
+			Status string `json:"Status"`
+		}
+
+		if err := decode(&container); err != nil {
+			return err
+		}
+
+		if container.ID != "" && imageMatches(container.Image, imageRef) && statusMatches(container.Status, statuses) {
+			containerIDs = append(containerIDs, container.ID)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error parsing containers output for image %s. Output was: %s; Error was: %s", imageRef, output, err)
+	}
+
+	return containerIDs, nil
+}
+
+// ListContainers lists the containers that have every one of the labels and, if any statuses are given, are in one
+// of them. Like ContainersUsingImage, the filtering is done here rather than by nerdctl
+func (n NerdctlClient) ListContainers(ctx context.Context, labels map[string]string, statuses []string) ([]Container, error) {
+	output, err := n.run(ctx, "ps", "--all", "--no-trunc", "--format", "json")
+
+	if err != nil {
+		return []Container{}, fmt.Errorf("error listing containers. Output was: %s; Error was: %s", output, err)
+	}
+
+	containers := []Container{}
+
+	err = decodeJSONLines(output, func(decode func(interface{}) error) error {
+		// EG: {"ID":"3a8b...","Image":"docker.io/library/httpd@sha256:e449...","Status":"Up","Labels":"com.lytbeacon.probe=library/httpd,..."}
+		var container struct {
+			ID     string `json:"ID"`
+			Image  string `json:"Image"`
+			Status string `json:"Status"`
+			Labels string `json:"Labels"`
+		}
+
+		if err := decode(&container); err != nil {
+			return err
+		}
+
+		containerLabels := parseLabels(container.Labels)
+
+		if container.ID == "" || !labelsMatch(containerLabels, labels) || !statusMatches(container.Status, statuses) {
+			return nil
+		}
+
+		containers = append(containers, Container{
+			ID:     container.ID,
+			Image:  container.Image,
+			Status: nerdctlState(container.Status),
+			Labels: containerLabels,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return []Container{}, fmt.Errorf("error parsing containers output. Output was: %s; Error was: %s", output, err)
+	}
+
+	return containers, nil
+}
+
+// parseLabels reads the labels from `nerdctl ps`, which prints them as a comma separated list of key=value pairs
+func parseLabels(labels string) map[string]string {
+	parsed := map[string]string{}
+
+	for _, label := range strings.Split(labels, ",") {
+		key, value, _ := strings.Cut(label, "=")
+
+		if key != "" {
+			parsed[key] = value
+		}
+	}
+
+	return parsed
+}
+
+func labelsMatch(containerLabels map[string]string, labels map[string]string) bool {
+	for key, value := range labels {
+		if containerLabels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// imageMatches reports whether the image of a container is the image ref, which nerdctl normalises to its fully
+// qualified form (EG: sansaid/beacon@sha256:... becomes docker.io/sansaid/beacon@sha256:...)
+func imageMatches(image string, imageRef string) bool {
+	return image == imageRef || strings.HasSuffix(image, "/"+imageRef)
+}
+
+// statusMatches reports whether the human readable status from `nerdctl ps` (EG: "Up", "Exited (0) 2 minutes ago")
+// is one of the statuses, which are given in the same terms as `podman ps --filter status=`
+func statusMatches(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+
+	state := nerdctlState(status)
+
+	for _, s := range statuses {
+		if s == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nerdctlState reads the state of a container from its human readable status
+func nerdctlState(status string) string {
+	state := strings.ToLower(strings.Fields(status + " unknown")[0])
+
+	if state == "up" {
+		return "running"
+	}
+
+	return state
+}
+
+// decodeJSONLines calls handle for every line of output, as nerdctl prints one JSON document per line
+func decodeJSONLines(output []byte, handle func(decode func(interface{}) error) error) error {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+
+		if len(line) == 0 {
+			continue
+		}
+
+		err := handle(func(v interface{}) error { return json.Unmarshal(line, v) })
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
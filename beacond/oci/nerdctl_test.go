@@ -0,0 +1,439 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/gommon/log"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type NerdctlSuite struct {
+	suite.Suite
+	NerdctlClient NerdctlClient
+	LogBuff       *bytes.Buffer
+}
+
+func (n *NerdctlSuite) SetupTest() {
+	n.NerdctlClient = NerdctlClient{runner: PosixRunner{}}
+
+	n.LogBuff = new(bytes.Buffer)
+	log.SetOutput(n.LogBuff)
+}
+
+func TestNerdctlSuite(t *testing.T) {
+	suite.Run(t, new(NerdctlSuite))
+}
+
+func (n *NerdctlSuite) TestType() {
+	assert.Equal(n.T(), Containerd, n.NerdctlClient.Type())
+}
+
+func (n *NerdctlSuite) TestCheckExistsPasses() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--version").Return([]byte("nerdctl version 1.7.2"), nil)
+
+	exists, err := n.NerdctlClient.CheckExists(context.Background())
+
+	assert.NoError(n.T(), err)
+	assert.True(n.T(), exists)
+}
+
+func (n *NerdctlSuite) TestCheckExistsErrors() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--version").Return([]byte(""), fmt.Errorf("fake error"))
+
+	exists, err := n.NerdctlClient.CheckExists(context.Background())
+
+	assert.Error(n.T(), err)
+	assert.False(n.T(), exists)
+}
+
+func (n *NerdctlSuite) TestRunImageOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"nerdctl", "--namespace", "beacon", "run", "--detach", "--memory", "1024b", "fakeImageRef"}
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeContainerId\n"), nil)
+
+	containerID, err := n.NerdctlClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Resources: Resources{Memory: 1024}})
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "fakeContainerId", containerID)
+}
+
+func (n *NerdctlSuite) TestRunImageErrors() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"nerdctl", "--namespace", "beacon", "run", "--detach", "fakeImageRef"}
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(""), fmt.Errorf("fake error"))
+
+	_, err := n.NerdctlClient.RunImage(context.Background(), "fakeImageRef", RunOptions{})
+
+	assert.ErrorContains(n.T(), err, "error running nerdctl image fakeImageRef")
+}
+
+func (n *NerdctlSuite) TestPullImageOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "pull", "fakeImageRef").Return([]byte("fake output"), nil)
+
+	err := n.NerdctlClient.PullImage(context.Background(), "fakeImageRef", nil)
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestPullImageWithAuth() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	var configDir string
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "env", gomock.Any(), "nerdctl", "--namespace", "beacon", "pull", "fakeImageRef").DoAndReturn(func(ctx context.Context, args ...string) ([]byte, error) {
+		configDir = strings.TrimPrefix(args[1], "DOCKER_CONFIG=")
+		content, err := os.ReadFile(filepath.Join(configDir, "config.json"))
+
+		assert.NoError(n.T(), err)
+		assert.JSONEq(n.T(), `{"auths": {"ghcr.io": {"identitytoken": "fakeToken"}}}`, string(content))
+
+		return []byte("fake output"), nil
+	})
+
+	err := n.NerdctlClient.PullImage(context.Background(), "fakeImageRef", &RegistryAuth{Server: "ghcr.io", IdentityToken: "fakeToken"})
+
+	assert.NoError(n.T(), err)
+	assert.NoDirExists(n.T(), configDir)
+}
+
+func (n *NerdctlSuite) TestPullImageErrors() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "pull", "fakeImageRef").Return([]byte(""), fmt.Errorf("fake error"))
+
+	err := n.NerdctlClient.PullImage(context.Background(), "fakeImageRef", nil)
+
+	assert.ErrorContains(n.T(), err, "error pulling nerdctl image fakeImageRef")
+}
+
+func (n *NerdctlSuite) TestGetImagesOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"nerdctl", "--namespace", "beacon", "images", "--format", "json",
+		"--filter=reference=fakeImagePrefix",
+		"--filter=before=oldImageRef",
+		"--filter=dangling=true",
+	}
+	output := "{\"ID\": \"imageIdA\"}\n{\"ID\": \"imageIdB\"}\n"
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(output), nil)
+
+	images, err := n.NerdctlClient.GetImages(context.Background(), "fakeImagePrefix", "oldImageRef", true)
+
+	assert.NoError(n.T(), err)
+	assert.ElementsMatch(n.T(), []string{"imageIdA", "imageIdB"}, images)
+}
+
+func (n *NerdctlSuite) TestRemoveImagesOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	getImagesArgs := []interface{}{"nerdctl", "--namespace", "beacon", "images", "--format", "json",
+		"--filter=reference=fakeImagePrefix",
+		"--filter=before=oldImageRef",
+		"--filter=dangling=true",
+	}
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), getImagesArgs...).Return([]byte("{\"ID\": \"imageIdA\"}\n{\"ID\": \"imageIdB\"}"), nil)
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "rmi", "imageIdA", "imageIdB").Return([]byte(""), nil)
+
+	err := n.NerdctlClient.RemoveImages(context.Background(), "fakeImagePrefix", "oldImageRef")
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestContainersUsingImageOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `{"ID": "containerIdA", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up"}
+{"ID": "containerIdB", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Exited (1) 2 minutes ago"}
+{"ID": "containerIdC", "Image": "docker.io/namespace/other@sha256:abc", "Status": "Up"}
+{"ID": "containerIdD", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up 5 minutes"}
+`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "ps", "--all", "--no-trunc", "--format", "json").Return([]byte(output), nil)
+
+	containers, err := n.NerdctlClient.ContainersUsingImage(context.Background(), "namespace/repo@sha256:abc", []string{"running"})
+
+	assert.NoError(n.T(), err)
+	assert.ElementsMatch(n.T(), []string{"containerIdA", "containerIdD"}, containers)
+}
+
+func (n *NerdctlSuite) TestContainersUsingImageAnyStatusOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `{"ID": "containerIdA", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up"}
+{"ID": "containerIdB", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Exited (1) 2 minutes ago"}
+`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "ps", "--all", "--no-trunc", "--format", "json").Return([]byte(output), nil)
+
+	containers, err := n.NerdctlClient.ContainersUsingImage(context.Background(), "namespace/repo@sha256:abc", []string{})
+
+	assert.NoError(n.T(), err)
+	assert.ElementsMatch(n.T(), []string{"containerIdA", "containerIdB"}, containers)
+}
+
+func (n *NerdctlSuite) TestContainersUsingImageInvalidJSON() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "ps", "--all", "--no-trunc", "--format", "json").Return([]byte("not json"), nil)
+
+	_, err := n.NerdctlClient.ContainersUsingImage(context.Background(), "namespace/repo@sha256:abc", []string{"running"})
+
+	assert.ErrorContains(n.T(), err, "error parsing containers output for image namespace/repo@sha256:abc")
+}
+
+func (n *NerdctlSuite) TestStopContainersByImageStopErrors() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `{"ID": "containerIdA", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up"}
+{"ID": "containerIdB", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up"}
+`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "ps", "--all", "--no-trunc", "--format", "json").Return([]byte(output), nil)
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "stop", "containerIdA").Return([]byte(""), fmt.Errorf("fake error"))
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "stop", "containerIdB").Return([]byte(""), nil)
+
+	err := n.NerdctlClient.StopContainersByImage(context.Background(), "namespace/repo@sha256:abc")
+
+	assert.Contains(n.T(), n.LogBuff.String(), "error stopping container containerIdA")
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestInspectContainerOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `[{"Id": "fakeContainerId", "State": {"Status": "exited", "ExitCode": 2, "StartedAt": "2023-01-02T15:04:05Z"}}]`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "container", "inspect", "fakeContainerId").Return([]byte(output), nil)
+
+	state, err := n.NerdctlClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "exited", state.Status)
+	assert.Equal(n.T(), 2, state.ExitCode)
+	assert.Equal(n.T(), "", state.Health)
+}
+
+func (n *NerdctlSuite) TestInspectContainerMissing() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := "time=\"2023-01-02T15:04:05Z\" level=fatal msg=\"1 errors:\\nno such container: fakeContainerId\""
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "container", "inspect", "fakeContainerId").Return([]byte(output), fmt.Errorf("exit status 1"))
+
+	_, err := n.NerdctlClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(n.T(), err, ErrNoSuchContainer)
+}
+
+func (n *NerdctlSuite) TestStartContainerOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "start", "fakeContainerId").Return([]byte("fakeContainerId"), nil)
+
+	err := n.NerdctlClient.StartContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestRemoveContainerOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "rm", "--force", "fakeContainerId").Return([]byte("fakeContainerId"), nil)
+
+	err := n.NerdctlClient.RemoveContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestListContainersOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `{"ID": "containerIdA", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up", "Labels": "com.lytbeacon.beacon-id=fakeBeaconId,com.lytbeacon.probe=namespace/repo"}
+{"ID": "containerIdB", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Exited (1) 2 minutes ago", "Labels": "com.lytbeacon.beacon-id=fakeBeaconId"}
+{"ID": "containerIdC", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up", "Labels": "com.lytbeacon.beacon-id=otherBeaconId"}
+{"ID": "containerIdD", "Image": "docker.io/namespace/repo@sha256:abc", "Status": "Up", "Labels": ""}
+`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "ps", "--all", "--no-trunc", "--format", "json").Return([]byte(output), nil)
+
+	containers, err := n.NerdctlClient.ListContainers(context.Background(), map[string]string{LabelBeaconID: "fakeBeaconId"}, []string{"running"})
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), []Container{{
+		ID:     "containerIdA",
+		Image:  "docker.io/namespace/repo@sha256:abc",
+		Status: "running",
+		Labels: map[string]string{LabelBeaconID: "fakeBeaconId", LabelProbe: "namespace/repo"},
+	}}, containers)
+}
+
+func (n *NerdctlSuite) TestRunImageWithNetworksOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	// The alias is given as the container's hostname, which nerdctl resolves for the other containers on the network
+	args := []interface{}{"nerdctl", "--namespace", "beacon", "run", "--detach", "--network", "beacon", "--hostname", "fakerepo.fakenamespace", "fakeImageRef"}
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeContainerId\n"), nil)
+
+	options := RunOptions{Networks: []string{"beacon"}, NetworkAliases: []string{"fakerepo.fakenamespace"}}
+	containerID, err := n.NerdctlClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), "fakeContainerId", containerID)
+}
+
+func (n *NerdctlSuite) TestRunImageWithSeveralNetworkAliasesErrors() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	options := RunOptions{Networks: []string{"beacon"}, NetworkAliases: []string{"fakerepo.fakenamespace", "fakerepo"}}
+	_, err := n.NerdctlClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.EqualError(n.T(), err, "nerdctl can only give a container one name on its networks, as its hostname, got fakerepo.fakenamespace, fakerepo")
+}
+
+func (n *NerdctlSuite) TestCreateNetworkOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	gomock.InOrder(
+		n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "network", "inspect", "beacon").Return([]byte("no such network"), fmt.Errorf("exit status 1")),
+		n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "network", "create", "--label", "com.lytbeacon.beacon-id=fakeBeaconId", "beacon").Return([]byte("beacon"), nil),
+	)
+
+	err := n.NerdctlClient.CreateNetwork(context.Background(), "beacon", map[string]string{LabelBeaconID: "fakeBeaconId"})
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestCreateNetworkExists() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "network", "inspect", "beacon").Return([]byte("[]"), nil)
+
+	err := n.NerdctlClient.CreateNetwork(context.Background(), "beacon", nil)
+
+	assert.NoError(n.T(), err)
+}
+
+func (n *NerdctlSuite) TestListVolumesOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := `{"Driver": "local", "Labels": "com.lytbeacon.beacon-id=fakeBeaconId,com.lytbeacon.volume=data", "Mountpoint": "", "Name": "volumeA"}
+{"Driver": "local", "Labels": "com.lytbeacon.beacon-id=otherBeaconId", "Mountpoint": "", "Name": "volumeB"}
+{"Driver": "local", "Labels": "", "Mountpoint": "", "Name": "volumeC"}
+`
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "volume", "ls", "--format", "json").Return([]byte(output), nil)
+
+	volumes, err := n.NerdctlClient.ListVolumes(context.Background(), map[string]string{LabelBeaconID: "fakeBeaconId"})
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), []Volume{{
+		Name:   "volumeA",
+		Labels: map[string]string{LabelBeaconID: "fakeBeaconId", LabelVolume: "data"},
+		Size:   -1,
+	}}, volumes)
+}
+
+func (n *NerdctlSuite) TestRemoveVolumeMissing() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+
+	output := "time=\"2023-01-02T15:04:05Z\" level=fatal msg=\"volume \\\"fakeVolume\\\" not found\""
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "volume", "rm", "fakeVolume").Return([]byte(output), fmt.Errorf("exit status 1"))
+
+	err := n.NerdctlClient.RemoveVolume(context.Background(), "fakeVolume")
+
+	assert.ErrorIs(n.T(), err, ErrNoSuchVolume)
+}
+
+func (n *NerdctlSuite) TestWaitContainerOK() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	n.NerdctlClient.runner = NewMockRunner(mockController)
+	n.NerdctlClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "nerdctl", "--namespace", "beacon", "wait", "fakeContainerId").Return([]byte("0\n"), nil)
+
+	exitCode, err := n.NerdctlClient.WaitContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(n.T(), err)
+	assert.Equal(n.T(), 0, exitCode)
+}
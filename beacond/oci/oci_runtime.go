@@ -1,30 +1,209 @@
+//go:generate mockgen -source $GOFILE -package oci -destination ./oci_runtime_mock.go OCIRuntime
 package oci
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
 )
 
 const (
-	Docker OCIRuntimeType = "docker"
-	Podman OCIRuntimeType = "podman"
+	Docker     OCIRuntimeType = "docker"
+	Podman     OCIRuntimeType = "podman"
+	PodmanAPI  OCIRuntimeType = "podman-api"
+	Containerd OCIRuntimeType = "containerd"
 )
 
 type OCIRuntimeType string
 
+// ErrNoSuchContainer is wrapped by errors returned for containers that the runtime does not know about
+var ErrNoSuchContainer = errors.New("no such container")
+
+// ErrNoSuchVolume is wrapped by errors returned for volumes that the runtime does not know about
+var ErrNoSuchVolume = errors.New("no such volume")
+
+// The CFS scheduler period that Resources.CPUQuota is relative to, in microseconds
+const CPUPeriod = 100000
+
+// Resources limits what a container can use on the host. Zero values leave the resource unlimited
+type Resources struct {
+	// The relative weight of the container when competing for CPU with other containers (1024 being the default)
+	CPUShares int64 `json:"cpu_shares,omitempty"`
+	// The CPU time the container can use per CPUPeriod, in microseconds
+	CPUQuota int64 `json:"cpu_quota,omitempty"`
+	// The memory limit of the container, in bytes
+	Memory int64 `json:"memory,omitempty"`
+	// The maximum number of processes that can run in the container
+	PidsLimit int64 `json:"pids_limit,omitempty"`
+}
+
+// CPUs is the number of CPUs the container's quota amounts to
+func (r Resources) CPUs() float64 {
+	return float64(r.CPUQuota) / CPUPeriod
+}
+
+// Times is what n containers with these limits use between them
+func (r Resources) Times(n int) Resources {
+	return Resources{
+		CPUShares: r.CPUShares * int64(n),
+		CPUQuota:  r.CPUQuota * int64(n),
+		Memory:    r.Memory * int64(n),
+		PidsLimit: r.PidsLimit * int64(n),
+	}
+}
+
+// Labels set on the containers beacon creates, so that it can tell them apart from containers it doesn't manage
+const (
+	// The ID of the beacon that created the container
+	LabelBeaconID = "com.lytbeacon.beacon-id"
+	// The ref (namespace/repo) of the probe the container was created for
+	LabelProbe = "com.lytbeacon.probe"
+	// The digest of the image the container was created from
+	LabelDigest = "com.lytbeacon.digest"
+	// Which of the probe's replicas the container is, counting from 0
+	LabelReplica = "com.lytbeacon.replica"
+	// The name a probe gave one of its volumes, which is set on the volume rather than the container
+	LabelVolume = "com.lytbeacon.volume"
+	// The hook a one-shot container was run for. Hook containers are not replicas
+	LabelHook = "com.lytbeacon.hook"
+)
+
+// RunOptions configures the container created by RunImage
+type RunOptions struct {
+	Resources Resources
+	Labels    map[string]string
+	// Replaces the image's command. The image's command is run if it is empty
+	Command []string
+	// Container ports to publish on the host's loopback interface, at ports chosen by the runtime. Where they end up
+	// is reported by InspectContainer
+	Ports []int
+	// Environment variables to set in the container. They may hold secrets, so they are never passed to the runtime
+	// as command line arguments
+	Env map[string]string
+	// Host files or directories mounted read only into the container
+	Mounts []Mount
+	// Named volumes mounted read write into the container. They have to have been created with CreateVolume
+	Volumes []VolumeMount
+	// Networks the container joins in place of the runtime's default network
+	Networks []string
+	// Names other containers on the same networks can reach the container by
+	NetworkAliases []string
+}
+
+// Mount is a host path mounted read only into a container
+type Mount struct {
+	Source string
+	Target string
+}
+
+// VolumeMount is a named volume mounted read write into a container
+type VolumeMount struct {
+	Name   string
+	Target string
+}
+
+// Volume is a named volume listed by ListVolumes
+type Volume struct {
+	Name       string
+	Labels     map[string]string
+	Mountpoint string
+	CreatedAt  time.Time
+	// The bytes taken up by the files in the volume, or -1 if they couldn't be read
+	Size int64
+}
+
+// volumeSize adds up the size of the files under a volume's mountpoint. Volumes of rootless containers can hold
+// files owned by other users in the user namespace, so this may not be possible
+func volumeSize(mountpoint string) int64 {
+	var size int64
+
+	err := filepath.WalkDir(mountpoint, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+
+			if err != nil {
+				return err
+			}
+
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	if err != nil || mountpoint == "" {
+		return -1
+	}
+
+	return size
+}
+
+// RegistryAuth is what PullImage logs in to the image's registry with. Pulls with a nil RegistryAuth are anonymous
+type RegistryAuth struct {
+	// The registry host, such as docker.io
+	Server   string
+	Username string
+	Password string
+	// A token that some registries accept in place of a password
+	IdentityToken string
+}
+
+// Container is a container listed by ListContainers
+type Container struct {
+	ID     string
+	Image  string
+	Status string
+	Labels map[string]string
+}
+
+// ContainerState is the subset of a container's state that beacon needs to keep it running
+type ContainerState struct {
+	ID        string
+	Status    string
+	Health    string
+	ExitCode  int
+	StartedAt time.Time
+	// The address (host:port) each published container port can be reached at from the host
+	Ports map[int]string
+}
+
 type OCIRuntime interface {
 	Type() OCIRuntimeType
-	CheckExists() (bool, error)
-	PullImage(string) error
-	RemoveImages(string, string) error
-	RunImage(string) error
-	ContainersUsingImage(string, []string) ([]string, error)
-	StopContainersByImage(string) error
+	CheckExists(context.Context) (bool, error)
+	PullImage(context.Context, string, *RegistryAuth) error
+	RemoveImages(context.Context, string, string) error
+	RunImage(context.Context, string, RunOptions) (string, error)
+	ContainersUsingImage(context.Context, string, []string) ([]string, error)
+	ListContainers(context.Context, map[string]string, []string) ([]Container, error)
+	StopContainersByImage(context.Context, string) error
+	InspectContainer(context.Context, string) (ContainerState, error)
+	StartContainer(context.Context, string) error
+	StopContainer(context.Context, string) error
+	RemoveContainer(context.Context, string) error
+	WaitContainer(context.Context, string) (int, error)
+	ContainerLogs(context.Context, string) ([]byte, error)
+	CreateNetwork(context.Context, string, map[string]string) error
+	RemoveNetwork(context.Context, string) error
+	CreateVolume(context.Context, string, map[string]string) error
+	ListVolumes(context.Context, map[string]string) ([]Volume, error)
+	RemoveVolume(context.Context, string) error
 }
 
 func NewOCIClient(runtime OCIRuntimeType) (OCIRuntime, error) {
 	switch runtime {
 	case Podman:
 		return NewPodman()
+	case PodmanAPI:
+		return NewPodmanAPI(DefaultPodmanSocket())
+	case Containerd:
+		return NewNerdctl()
 	default:
 		return nil, fmt.Errorf("runtime not supported: %s", runtime)
 	}
@@ -0,0 +1,309 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oci_runtime.go
+
+// Package oci is a generated GoMock package.
+package oci
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOCIRuntime is a mock of OCIRuntime interface.
+type MockOCIRuntime struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIRuntimeMockRecorder
+}
+
+// MockOCIRuntimeMockRecorder is the mock recorder for MockOCIRuntime.
+type MockOCIRuntimeMockRecorder struct {
+	mock *MockOCIRuntime
+}
+
+// NewMockOCIRuntime creates a new mock instance.
+func NewMockOCIRuntime(ctrl *gomock.Controller) *MockOCIRuntime {
+	mock := &MockOCIRuntime{ctrl: ctrl}
+	mock.recorder = &MockOCIRuntimeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCIRuntime) EXPECT() *MockOCIRuntimeMockRecorder {
+	return m.recorder
+}
+
+// CheckExists mocks base method.
+func (m *MockOCIRuntime) CheckExists(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckExists", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckExists indicates an expected call of CheckExists.
+func (mr *MockOCIRuntimeMockRecorder) CheckExists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckExists", reflect.TypeOf((*MockOCIRuntime)(nil).CheckExists), arg0)
+}
+
+// ContainerLogs mocks base method.
+func (m *MockOCIRuntime) ContainerLogs(arg0 context.Context, arg1 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerLogs", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerLogs indicates an expected call of ContainerLogs.
+func (mr *MockOCIRuntimeMockRecorder) ContainerLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerLogs", reflect.TypeOf((*MockOCIRuntime)(nil).ContainerLogs), arg0, arg1)
+}
+
+// ContainersUsingImage mocks base method.
+func (m *MockOCIRuntime) ContainersUsingImage(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainersUsingImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainersUsingImage indicates an expected call of ContainersUsingImage.
+func (mr *MockOCIRuntimeMockRecorder) ContainersUsingImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainersUsingImage", reflect.TypeOf((*MockOCIRuntime)(nil).ContainersUsingImage), arg0, arg1, arg2)
+}
+
+// CreateNetwork mocks base method.
+func (m *MockOCIRuntime) CreateNetwork(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockOCIRuntimeMockRecorder) CreateNetwork(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockOCIRuntime)(nil).CreateNetwork), arg0, arg1, arg2)
+}
+
+// CreateVolume mocks base method.
+func (m *MockOCIRuntime) CreateVolume(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockOCIRuntimeMockRecorder) CreateVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockOCIRuntime)(nil).CreateVolume), arg0, arg1, arg2)
+}
+
+// InspectContainer mocks base method.
+func (m *MockOCIRuntime) InspectContainer(arg0 context.Context, arg1 string) (ContainerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InspectContainer", arg0, arg1)
+	ret0, _ := ret[0].(ContainerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectContainer indicates an expected call of InspectContainer.
+func (mr *MockOCIRuntimeMockRecorder) InspectContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectContainer", reflect.TypeOf((*MockOCIRuntime)(nil).InspectContainer), arg0, arg1)
+}
+
+// ListContainers mocks base method.
+func (m *MockOCIRuntime) ListContainers(arg0 context.Context, arg1 map[string]string, arg2 []string) ([]Container, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContainers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]Container)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContainers indicates an expected call of ListContainers.
+func (mr *MockOCIRuntimeMockRecorder) ListContainers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContainers", reflect.TypeOf((*MockOCIRuntime)(nil).ListContainers), arg0, arg1, arg2)
+}
+
+// ListVolumes mocks base method.
+func (m *MockOCIRuntime) ListVolumes(arg0 context.Context, arg1 map[string]string) ([]Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", arg0, arg1)
+	ret0, _ := ret[0].([]Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockOCIRuntimeMockRecorder) ListVolumes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockOCIRuntime)(nil).ListVolumes), arg0, arg1)
+}
+
+// PullImage mocks base method.
+func (m *MockOCIRuntime) PullImage(arg0 context.Context, arg1 string, arg2 *RegistryAuth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PullImage indicates an expected call of PullImage.
+func (mr *MockOCIRuntimeMockRecorder) PullImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullImage", reflect.TypeOf((*MockOCIRuntime)(nil).PullImage), arg0, arg1, arg2)
+}
+
+// RemoveContainer mocks base method.
+func (m *MockOCIRuntime) RemoveContainer(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContainer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContainer indicates an expected call of RemoveContainer.
+func (mr *MockOCIRuntimeMockRecorder) RemoveContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContainer", reflect.TypeOf((*MockOCIRuntime)(nil).RemoveContainer), arg0, arg1)
+}
+
+// RemoveImages mocks base method.
+func (m *MockOCIRuntime) RemoveImages(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImages", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImages indicates an expected call of RemoveImages.
+func (mr *MockOCIRuntimeMockRecorder) RemoveImages(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImages", reflect.TypeOf((*MockOCIRuntime)(nil).RemoveImages), arg0, arg1, arg2)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MockOCIRuntime) RemoveNetwork(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MockOCIRuntimeMockRecorder) RemoveNetwork(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MockOCIRuntime)(nil).RemoveNetwork), arg0, arg1)
+}
+
+// RemoveVolume mocks base method.
+func (m *MockOCIRuntime) RemoveVolume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVolume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVolume indicates an expected call of RemoveVolume.
+func (mr *MockOCIRuntimeMockRecorder) RemoveVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockOCIRuntime)(nil).RemoveVolume), arg0, arg1)
+}
+
+// RunImage mocks base method.
+func (m *MockOCIRuntime) RunImage(arg0 context.Context, arg1 string, arg2 RunOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunImage indicates an expected call of RunImage.
+func (mr *MockOCIRuntimeMockRecorder) RunImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunImage", reflect.TypeOf((*MockOCIRuntime)(nil).RunImage), arg0, arg1, arg2)
+}
+
+// StartContainer mocks base method.
+func (m *MockOCIRuntime) StartContainer(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartContainer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartContainer indicates an expected call of StartContainer.
+func (mr *MockOCIRuntimeMockRecorder) StartContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartContainer", reflect.TypeOf((*MockOCIRuntime)(nil).StartContainer), arg0, arg1)
+}
+
+// StopContainer mocks base method.
+func (m *MockOCIRuntime) StopContainer(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopContainer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopContainer indicates an expected call of StopContainer.
+func (mr *MockOCIRuntimeMockRecorder) StopContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopContainer", reflect.TypeOf((*MockOCIRuntime)(nil).StopContainer), arg0, arg1)
+}
+
+// StopContainersByImage mocks base method.
+func (m *MockOCIRuntime) StopContainersByImage(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopContainersByImage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopContainersByImage indicates an expected call of StopContainersByImage.
+func (mr *MockOCIRuntimeMockRecorder) StopContainersByImage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopContainersByImage", reflect.TypeOf((*MockOCIRuntime)(nil).StopContainersByImage), arg0, arg1)
+}
+
+// Type mocks base method.
+func (m *MockOCIRuntime) Type() OCIRuntimeType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Type")
+	ret0, _ := ret[0].(OCIRuntimeType)
+	return ret0
+}
+
+// Type indicates an expected call of Type.
+func (mr *MockOCIRuntimeMockRecorder) Type() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Type", reflect.TypeOf((*MockOCIRuntime)(nil).Type))
+}
+
+// WaitContainer mocks base method.
+func (m *MockOCIRuntime) WaitContainer(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitContainer", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitContainer indicates an expected call of WaitContainer.
+func (mr *MockOCIRuntimeMockRecorder) WaitContainer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitContainer", reflect.TypeOf((*MockOCIRuntime)(nil).WaitContainer), arg0, arg1)
+}
@@ -1,6 +1,8 @@
 package oci
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +14,25 @@ func TestNewOCIClient(t *testing.T) {
 	_, err = NewOCIClient(Podman)
 	assert.NoError(t, err)
 
+	t.Setenv("CONTAINER_HOST", "unix:///run/user/1000/podman/podman.sock")
+	_, err = NewOCIClient(PodmanAPI)
+	assert.NoError(t, err)
+
+	_, err = NewOCIClient(Containerd)
+	assert.NoError(t, err)
+
 	_, err = NewOCIClient(Docker)
 	assert.Errorf(t, err, "runtime not supported: %s", string(Docker))
 }
+
+func TestVolumeSize(t *testing.T) {
+	mountpoint := t.TempDir()
+
+	assert.NoError(t, os.WriteFile(filepath.Join(mountpoint, "a"), make([]byte, 10), 0600))
+	assert.NoError(t, os.Mkdir(filepath.Join(mountpoint, "b"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(mountpoint, "b", "c"), make([]byte, 5), 0600))
+
+	assert.Equal(t, int64(15), volumeSize(mountpoint))
+	assert.Equal(t, int64(-1), volumeSize(filepath.Join(mountpoint, "missing")))
+	assert.Equal(t, int64(-1), volumeSize(""))
+}
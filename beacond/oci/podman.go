@@ -2,25 +2,30 @@ package oci
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net"
+	"os"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/gommon/log"
 )
 
 type PodmanClient struct {
-	ctx    context.Context
 	runner Runner
 }
 
 func NewPodman() (OCIRuntime, error) {
 	switch runtime.GOOS {
 	case "windows":
-		return PodmanClient{ctx: context.Background(), runner: PowershellRunner{}}, nil
+		return PodmanClient{runner: PowershellRunner{}}, nil
 	case "linux":
-		return PodmanClient{ctx: context.Background(), runner: PosixRunner{}}, nil
+		return PodmanClient{runner: PosixRunner{}}, nil
 	default:
 		return nil, fmt.Errorf("unsupported OS: %s", runtime.GOOS)
 	}
@@ -30,8 +35,8 @@ func (p PodmanClient) Type() OCIRuntimeType {
 	return Podman
 }
 
-func (p PodmanClient) CheckExists() (bool, error) {
-	output, err := p.runner.run("podman", "--version")
+func (p PodmanClient) CheckExists(ctx context.Context) (bool, error) {
+	output, err := p.runner.run(ctx, "podman", "--version")
 
 	if err != nil {
 		return false, fmt.Errorf("error checking podman exists. Output was: %s; Error was: %s", output, err)
@@ -44,18 +49,253 @@ func (p PodmanClient) CheckExists() (bool, error) {
 	return false, fmt.Errorf("could not check if podman is running. The output was not recognised. Output was: %s", string(output))
 }
 
-func (p PodmanClient) RunImage(imageRef string) error {
-	output, err := p.runner.run("podman", "run", imageRef)
+// RunImage starts a detached container for the image and returns its ID
+func (p PodmanClient) RunImage(ctx context.Context, imageRef string, options RunOptions) (string, error) {
+	envArgs, cleanUp, err := envFileArgs(options.Env)
 
 	if err != nil {
-		return fmt.Errorf("error running podman image %s. Output was: %s; Error was: %s", imageRef, output, err)
+		return "", err
 	}
 
-	return nil
+	defer cleanUp()
+
+	args := []string{"podman", "run", "--detach"}
+	args = append(args, resourceArgs(options.Resources)...)
+	args = append(args, labelArgs(options.Labels)...)
+	args = append(args, portArgs(options.Ports)...)
+	args = append(args, envArgs...)
+	args = append(args, mountArgs(options.Mounts)...)
+	args = append(args, volumeArgs(options.Volumes)...)
+	args = append(args, networkArgs(options.Networks)...)
+
+	for _, alias := range options.NetworkAliases {
+		args = append(args, "--network-alias", alias)
+	}
+
+	args = append(args, imageRef)
+	args = append(args, options.Command...)
+
+	output, err := p.runner.run(ctx, args...)
+
+	if err != nil {
+		return "", fmt.Errorf("error running podman image %s. Output was: %s; Error was: %s", imageRef, output, err)
+	}
+
+	// The container ID is printed last, after any warnings podman may have printed while pulling
+	lines := strings.Fields(strings.TrimSpace(string(output)))
+
+	if len(lines) == 0 {
+		return "", fmt.Errorf("error running podman image %s. No container ID was returned", imageRef)
+	}
+
+	return lines[len(lines)-1], nil
+}
+
+// resourceArgs translates resource limits to the flags shared by the podman, docker and nerdctl CLIs
+func resourceArgs(resources Resources) []string {
+	var args []string
+
+	if resources.CPUShares > 0 {
+		args = append(args, "--cpu-shares", strconv.FormatInt(resources.CPUShares, 10))
+	}
+
+	if resources.CPUQuota > 0 {
+		args = append(args, "--cpu-period", strconv.Itoa(CPUPeriod), "--cpu-quota", strconv.FormatInt(resources.CPUQuota, 10))
+	}
+
+	if resources.Memory > 0 {
+		args = append(args, "--memory", fmt.Sprintf("%db", resources.Memory))
+	}
+
+	if resources.PidsLimit > 0 {
+		args = append(args, "--pids-limit", strconv.FormatInt(resources.PidsLimit, 10))
+	}
+
+	return args
+}
+
+// labelArgs translates labels to the flags shared by the podman, docker and nerdctl CLIs, in a stable order
+func labelArgs(labels map[string]string) []string {
+	var args []string
+
+	for _, key := range sortedKeys(labels) {
+		args = append(args, "--label", fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+
+	return args
+}
+
+// portArgs translates published ports to the flags shared by the podman, docker and nerdctl CLIs. Leaving out the
+// host port lets the runtime pick a free one, so replicas of the same image don't clash
+func portArgs(ports []int) []string {
+	var args []string
+
+	for _, port := range ports {
+		args = append(args, "--publish", fmt.Sprintf("127.0.0.1::%d", port))
+	}
+
+	return args
+}
+
+// mountArgs translates read only mounts to the flags shared by the podman, docker and nerdctl CLIs
+func mountArgs(mounts []Mount) []string {
+	var args []string
+
+	for _, mount := range mounts {
+		args = append(args, "--volume", fmt.Sprintf("%s:%s:ro", mount.Source, mount.Target))
+	}
+
+	return args
+}
+
+// volumeArgs translates named volumes to the flags shared by the podman, docker and nerdctl CLIs
+func volumeArgs(volumes []VolumeMount) []string {
+	var args []string
+
+	for _, volume := range volumes {
+		args = append(args, "--volume", fmt.Sprintf("%s:%s", volume.Name, volume.Target))
+	}
+
+	return args
+}
+
+// networkArgs translates the networks to join to the flags shared by the podman, docker and nerdctl CLIs
+func networkArgs(networks []string) []string {
+	var args []string
+
+	for _, network := range networks {
+		args = append(args, "--network", network)
+	}
+
+	return args
+}
+
+// envFileArgs writes the environment variables to a file only readable by beacond, returning the flag that passes
+// it to the podman, docker or nerdctl CLI. This keeps their values out of the process list, where command line
+// arguments can be read by any user. The file is removed by cleanUp once the container has been created
+func envFileArgs(env map[string]string) (args []string, cleanUp func(), err error) {
+	if len(env) == 0 {
+		return nil, func() {}, nil
+	}
+
+	var content strings.Builder
+
+	for _, key := range sortedKeys(env) {
+		// Each variable is a line of the file, so a value spanning lines would set other variables
+		if strings.ContainsAny(env[key], "\r\n") {
+			return nil, nil, fmt.Errorf("environment variable %s can't span more than one line", key)
+		}
+
+		fmt.Fprintf(&content, "%s=%s\n", key, env[key])
+	}
+
+	file, err := os.CreateTemp(tmpfsDir(), "beacon-env-")
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating environment file: %s", err)
+	}
+
+	cleanUp = func() { os.Remove(file.Name()) }
+
+	_, err = file.WriteString(content.String())
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		cleanUp()
+		return nil, nil, fmt.Errorf("error writing environment file: %s", err)
+	}
+
+	return []string{"--env-file", file.Name()}, cleanUp, nil
 }
 
-func (p PodmanClient) PullImage(imageRef string) error {
-	output, err := p.runner.run("podman", "pull", imageRef)
+// authConfig is the content of an auth.json file (the format docker's config.json uses too) holding only the
+// credentials for the registry being pulled from
+func authConfig(auth *RegistryAuth) ([]byte, error) {
+	type authEntry struct {
+		Auth          string `json:"auth,omitempty"`
+		IdentityToken string `json:"identitytoken,omitempty"`
+	}
+
+	entry := authEntry{IdentityToken: auth.IdentityToken}
+
+	if auth.Username != "" || auth.Password != "" {
+		entry.Auth = base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + auth.Password))
+	}
+
+	return json.Marshal(map[string]map[string]authEntry{"auths": {auth.Server: entry}})
+}
+
+// authFileArgs writes the credentials to a temporary auth file so that they aren't passed on the command line, and
+// returns the arguments pointing podman at it. cleanUp removes the file once podman is done with it
+func authFileArgs(auth *RegistryAuth) (args []string, cleanUp func(), err error) {
+	if auth == nil {
+		return nil, func() {}, nil
+	}
+
+	content, err := authConfig(auth)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error encoding credentials for %s: %s", auth.Server, err)
+	}
+
+	file, err := os.CreateTemp(tmpfsDir(), "beacon-auth-")
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating auth file: %s", err)
+	}
+
+	cleanUp = func() { os.Remove(file.Name()) }
+
+	_, err = file.Write(content)
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		cleanUp()
+		return nil, nil, fmt.Errorf("error writing auth file: %s", err)
+	}
+
+	return []string{"--authfile", file.Name()}, cleanUp, nil
+}
+
+// tmpfsDir is where files holding secrets are written, so that they are kept in memory rather than written to disk
+// where possible
+func tmpfsDir() string {
+	if info, err := os.Stat("/dev/shm"); err == nil && info.IsDir() {
+		return "/dev/shm"
+	}
+
+	return os.TempDir()
+}
+
+func sortedKeys(labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (p PodmanClient) PullImage(ctx context.Context, imageRef string, auth *RegistryAuth) error {
+	authArgs, cleanUp, err := authFileArgs(auth)
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanUp()
+
+	args := append([]string{"podman", "pull"}, authArgs...)
+	output, err := p.runner.run(ctx, append(args, imageRef)...)
 
 	if err != nil {
 		return fmt.Errorf("error pulling podman image %s. Output was: %s; Error was: %s", imageRef, output, err)
@@ -64,9 +304,9 @@ func (p PodmanClient) PullImage(imageRef string) error {
 	return nil
 }
 
-func (p PodmanClient) RemoveImages(refPrefix string, olderThanRef string) error {
+func (p PodmanClient) RemoveImages(ctx context.Context, refPrefix string, olderThanRef string) error {
 	args := []string{"podman", "rm"}
-	images, err := p.GetImages(refPrefix, olderThanRef, true)
+	images, err := p.GetImages(ctx, refPrefix, olderThanRef, true)
 
 	if err != nil {
 		return err
@@ -76,7 +316,7 @@ func (p PodmanClient) RemoveImages(refPrefix string, olderThanRef string) error
 
 	// TODO: might be better to run the rm for each image individually so that we don't let the
 	// failure of one image cause all the other image removals to fail
-	output, err := p.runner.run(args...)
+	output, err := p.runner.run(ctx, args...)
 
 	if err != nil {
 		return fmt.Errorf("error removing podman images. Output was: %s; Error was: %s", output, err)
@@ -85,15 +325,15 @@ func (p PodmanClient) RemoveImages(refPrefix string, olderThanRef string) error
 	return nil
 }
 
-func (p PodmanClient) StopContainersByImage(imageRef string) error {
-	containers, err := p.ContainersUsingImage(imageRef, []string{"running"})
+func (p PodmanClient) StopContainersByImage(ctx context.Context, imageRef string) error {
+	containers, err := p.ContainersUsingImage(ctx, imageRef, []string{"running"})
 
 	if err != nil {
 		return err
 	}
 
 	for _, container := range containers {
-		err = p.StopContainer(container)
+		err = p.StopContainer(ctx, container)
 
 		if err != nil {
 			log.Error(err.Error())
@@ -104,8 +344,8 @@ func (p PodmanClient) StopContainersByImage(imageRef string) error {
 	return nil
 }
 
-func (p PodmanClient) StopContainer(containerID string) error {
-	output, err := p.runner.run("podman", "stop", containerID)
+func (p PodmanClient) StopContainer(ctx context.Context, containerID string) error {
+	output, err := p.runner.run(ctx, "podman", "stop", containerID)
 
 	if err != nil {
 		return fmt.Errorf("error stopping container %s. Output was: %s; Error was: %s", containerID, output, err)
@@ -114,8 +354,255 @@ func (p PodmanClient) StopContainer(containerID string) error {
 	return nil
 }
 
+func (p PodmanClient) StartContainer(ctx context.Context, containerID string) error {
+	output, err := p.runner.run(ctx, "podman", "start", containerID)
+
+	if err != nil {
+		return fmt.Errorf("error starting container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return nil
+}
+
+func (p PodmanClient) RemoveContainer(ctx context.Context, containerID string) error {
+	output, err := p.runner.run(ctx, "podman", "rm", "--force", containerID)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "no such container") {
+			return fmt.Errorf("error removing container %s: %w", containerID, ErrNoSuchContainer)
+		}
+
+		return fmt.Errorf("error removing container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return nil
+}
+
+// WaitContainer waits for the container to exit and returns its exit code
+func (p PodmanClient) WaitContainer(ctx context.Context, containerID string) (int, error) {
+	output, err := p.runner.run(ctx, "podman", "wait", containerID)
+
+	if err != nil {
+		return 0, fmt.Errorf("error waiting for container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return parseExitCode(containerID, output)
+}
+
+// ContainerLogs returns what the container has printed to stdout and stderr, interleaved
+func (p PodmanClient) ContainerLogs(ctx context.Context, containerID string) ([]byte, error) {
+	output, err := p.runner.run(ctx, "podman", "logs", containerID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	return output, nil
+}
+
+// parseExitCode reads the exit code printed by `podman wait` or `nerdctl wait`, which comes last
+func parseExitCode(containerID string, output []byte) (int, error) {
+	lines := strings.Fields(strings.TrimSpace(string(output)))
+
+	if len(lines) > 0 {
+		if exitCode, err := strconv.Atoi(lines[len(lines)-1]); err == nil {
+			return exitCode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("error waiting for container %s. No exit code was returned. Output was: %s", containerID, output)
+}
+
+// CreateNetwork creates a bridge network with the labels, doing nothing if a network with the name already exists
+func (p PodmanClient) CreateNetwork(ctx context.Context, name string, labels map[string]string) error {
+	args := []string{"podman", "network", "create", "--ignore"}
+	args = append(args, labelArgs(labels)...)
+	args = append(args, name)
+
+	output, err := p.runner.run(ctx, args...)
+
+	if err != nil {
+		return fmt.Errorf("error creating network %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+func (p PodmanClient) RemoveNetwork(ctx context.Context, name string) error {
+	output, err := p.runner.run(ctx, "podman", "network", "rm", name)
+
+	if err != nil {
+		return fmt.Errorf("error removing network %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// CreateVolume creates a named volume with the labels, doing nothing if a volume with the name already exists
+func (p PodmanClient) CreateVolume(ctx context.Context, name string, labels map[string]string) error {
+	args := []string{"podman", "volume", "create", "--ignore"}
+	args = append(args, labelArgs(labels)...)
+	args = append(args, name)
+
+	output, err := p.runner.run(ctx, args...)
+
+	if err != nil {
+		return fmt.Errorf("error creating volume %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// ListVolumes lists the volumes that have every one of the labels
+func (p PodmanClient) ListVolumes(ctx context.Context, labels map[string]string) ([]Volume, error) {
+	// EG: podman volume ls --format json --filter=label=com.lytbeacon.beacon-id=3f2a...
+	args := []string{"podman", "volume", "ls", "--format", "json"}
+
+	for _, key := range sortedKeys(labels) {
+		args = append(args, fmt.Sprintf("--filter=label=%s=%s", key, labels[key]))
+	}
+
+	output, err := p.runner.run(ctx, args...)
+
+	if err != nil {
+		return []Volume{}, fmt.Errorf("error listing volumes. Output was: %s; Error was: %s", output, err)
+	}
+
+	var listed []volumeListing
+
+	err = json.Unmarshal(output, &listed)
+
+	if err != nil {
+		return []Volume{}, fmt.Errorf("error parsing volumes output. Output was: %s; Error was: %s", output, err)
+	}
+
+	return volumesFromListing(listed), nil
+}
+
+func (p PodmanClient) RemoveVolume(ctx context.Context, name string) error {
+	output, err := p.runner.run(ctx, "podman", "volume", "rm", name)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "no such volume") {
+			return fmt.Errorf("error removing volume %s: %w", name, ErrNoSuchVolume)
+		}
+
+		return fmt.Errorf("error removing volume %s. Output was: %s; Error was: %s", name, output, err)
+	}
+
+	return nil
+}
+
+// volumeListing is the part of `podman volume ls` (and its libpod API equivalent) that beacon uses
+type volumeListing struct {
+	Name       string            `json:"Name"`
+	Labels     map[string]string `json:"Labels"`
+	Mountpoint string            `json:"Mountpoint"`
+	CreatedAt  time.Time         `json:"CreatedAt"`
+}
+
+func volumesFromListing(listed []volumeListing) []Volume {
+	volumes := []Volume{}
+
+	for _, volume := range listed {
+		volumes = append(volumes, Volume{
+			Name:       volume.Name,
+			Labels:     volume.Labels,
+			Mountpoint: volume.Mountpoint,
+			CreatedAt:  volume.CreatedAt,
+			Size:       volumeSize(volume.Mountpoint),
+		})
+	}
+
+	return volumes
+}
+
+func (p PodmanClient) InspectContainer(ctx context.Context, containerID string) (ContainerState, error) {
+	output, err := p.runner.run(ctx, "podman", "container", "inspect", containerID)
+
+	if err != nil {
+		if strings.Contains(strings.ToLower(string(output)), "no such container") {
+			return ContainerState{}, fmt.Errorf("error inspecting container %s: %w", containerID, ErrNoSuchContainer)
+		}
+
+		return ContainerState{}, fmt.Errorf("error inspecting container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	var containers []containerInspect
+
+	err = json.Unmarshal(output, &containers)
+
+	if err != nil {
+		return ContainerState{}, fmt.Errorf("error parsing inspect output for container %s. Output was: %s; Error was: %s", containerID, output, err)
+	}
+
+	if len(containers) == 0 {
+		return ContainerState{}, fmt.Errorf("error inspecting container %s: %w", containerID, ErrNoSuchContainer)
+	}
+
+	return containers[0].state(), nil
+}
+
+// containerInspect is the part of `podman container inspect` (and its libpod API and Docker compatible equivalents)
+// that beacon uses
+type containerInspect struct {
+	ID    string `json:"Id"`
+	State struct {
+		Status    string    `json:"Status"`
+		ExitCode  int       `json:"ExitCode"`
+		StartedAt time.Time `json:"StartedAt"`
+		// Older versions of podman report the health check under Healthcheck rather than Health
+		Health      *struct{ Status string } `json:"Health"`
+		Healthcheck *struct{ Status string } `json:"Healthcheck"`
+	} `json:"State"`
+	NetworkSettings struct {
+		// Keyed by port and protocol, e.g. 8080/tcp
+		Ports map[string][]struct {
+			HostIP   string `json:"HostIp"`
+			HostPort string `json:"HostPort"`
+		} `json:"Ports"`
+	} `json:"NetworkSettings"`
+}
+
+func (c containerInspect) state() ContainerState {
+	state := ContainerState{
+		ID:        c.ID,
+		Status:    c.State.Status,
+		ExitCode:  c.State.ExitCode,
+		StartedAt: c.State.StartedAt,
+	}
+
+	if c.State.Health != nil {
+		state.Health = c.State.Health.Status
+	} else if c.State.Healthcheck != nil {
+		state.Health = c.State.Healthcheck.Status
+	}
+
+	for key, bindings := range c.NetworkSettings.Ports {
+		port, err := strconv.Atoi(strings.TrimSuffix(key, "/tcp"))
+
+		if err != nil || len(bindings) == 0 || bindings[0].HostPort == "" {
+			continue
+		}
+
+		hostIP := bindings[0].HostIP
+
+		if hostIP == "" || hostIP == "0.0.0.0" {
+			hostIP = "127.0.0.1"
+		}
+
+		if state.Ports == nil {
+			state.Ports = map[int]string{}
+		}
+
+		state.Ports[port] = net.JoinHostPort(hostIP, bindings[0].HostPort)
+	}
+
+	return state
+}
+
 // See applicable containers: https://docs.docker.com/engine/reference/commandline/ps/#filter
-func (p PodmanClient) ContainersUsingImage(imageRef string, statuses []string) ([]string, error) {
+func (p PodmanClient) ContainersUsingImage(ctx context.Context, imageRef string, statuses []string) ([]string, error) {
 	//  podman ps --filter=ancestor='docker.io/library/httpd@sha256:e4498843f8684e957e3068546ed930b30d43180e2e8c2579d39d637bd2fe79de' --format json
 	args := []string{"podman", "ps", "--format", "json"}
 	args = append(args, fmt.Sprintf("--filter=ancestor='%s'", imageRef))
@@ -124,7 +611,7 @@ func (p PodmanClient) ContainersUsingImage(imageRef string, statuses []string) (
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	output, err := p.runner.run(args...)
+	output, err := p.runner.run(ctx, args...)
 
 	if err != nil {
 		return []string{}, fmt.Errorf("error getting containers associated with image %s. Output was: %s; Error was: %s", imageRef, output, err)
@@ -151,7 +638,65 @@ func (p PodmanClient) ContainersUsingImage(imageRef string, statuses []string) (
 	return containerIDs, nil
 }
 
-func (p PodmanClient) GetImages(refPrefix string, olderThanImageRef string, dangling bool) ([]string, error) {
+// ListContainers lists the containers that have every one of the labels and, if any statuses are given, are in one
+// of them
+func (p PodmanClient) ListContainers(ctx context.Context, labels map[string]string, statuses []string) ([]Container, error) {
+	// EG: podman ps --all --format json --filter=label=com.lytbeacon.beacon-id=3f2a... --filter=status=running
+	args := []string{"podman", "ps", "--all", "--format", "json"}
+
+	for _, key := range sortedKeys(labels) {
+		args = append(args, fmt.Sprintf("--filter=label=%s=%s", key, labels[key]))
+	}
+
+	for _, status := range statuses {
+		args = append(args, fmt.Sprintf("--filter=status=%s", status))
+	}
+
+	output, err := p.runner.run(ctx, args...)
+
+	if err != nil {
+		return []Container{}, fmt.Errorf("error listing containers. Output was: %s; Error was: %s", output, err)
+	}
+
+	var listed []containerListing
+
+	err = json.Unmarshal(output, &listed)
+
+	if err != nil {
+		return []Container{}, fmt.Errorf("error parsing containers output. Output was: %s; Error was: %s", output, err)
+	}
+
+	return containersFromListing(listed), nil
+}
+
+// containerListing is the part of `podman ps` (and its libpod API equivalent) that beacon uses
+type containerListing struct {
+	ID     string            `json:"Id"`
+	Image  string            `json:"Image"`
+	State  string            `json:"State"`
+	Labels map[string]string `json:"Labels"`
+}
+
+func containersFromListing(listed []containerListing) []Container {
+	containers := []Container{}
+
+	for _, container := range listed {
+		if container.ID == "" {
+			continue
+		}
+
+		containers = append(containers, Container{
+			ID:     container.ID,
+			Image:  container.Image,
+			Status: container.State,
+			Labels: container.Labels,
+		})
+	}
+
+	return containers
+}
+
+func (p PodmanClient) GetImages(ctx context.Context, refPrefix string, olderThanImageRef string, dangling bool) ([]string, error) {
 	// See https://docs.docker.com/engine/reference/commandline/images/#filter
 	// EG: podman images --filter=reference='docker.io/library/httpd' --filter 'before=docker.io/library/httpd@sha256:e4498843f8684e957e3068546ed930b30d43180e2e8c2579d39d637bd2fe79de' --format json
 	args := []string{"podman", "images", "--format", "json",
@@ -160,7 +705,7 @@ func (p PodmanClient) GetImages(refPrefix string, olderThanImageRef string, dang
 		fmt.Sprintf("--filter=dangling=%t", dangling),
 	}
 
-	output, err := p.runner.run(args...)
+	output, err := p.runner.run(ctx, args...)
 
 	if err != nil {
 		return []string{}, fmt.Errorf("error getting images associated with prefix %s. Output was: %s; Error was: %s", refPrefix, output, err)
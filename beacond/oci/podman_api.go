@@ -0,0 +1,734 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// The libpod API version requested. Podman serves older API versions from newer releases, so this is the oldest
+// version that supports everything beacon needs
+const libpodAPIVersion = "v4.0.0"
+
+// How long removing a container that was created but couldn't be started can take
+const cleanupTimeout = 30 * time.Second
+
+// PodmanAPIClient manages containers through the libpod REST API served by `podman system service`, rather than by
+// running the podman CLI for every operation. See https://docs.podman.io/en/latest/_static/api.html
+type PodmanAPIClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// libpodError is the body of error responses from the libpod API
+type libpodError struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+func (e libpodError) Error() string {
+	return fmt.Sprintf("podman service returned status %d: %s", e.Response, e.Message)
+}
+
+// notFound wraps 404 errors from container endpoints in ErrNoSuchContainer
+func notFound(err error) error {
+	if apiErr, ok := err.(libpodError); ok && apiErr.Response == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNoSuchContainer, apiErr.Message)
+	}
+
+	return err
+}
+
+// NewPodmanAPI creates a client for the podman service listening on the unix socket at socketPath
+func NewPodmanAPI(socketPath string) (OCIRuntime, error) {
+	if socketPath == "" {
+		return nil, fmt.Errorf("no podman socket found. Set CONTAINER_HOST or start the service with `podman system service`")
+	}
+
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}
+
+	return PodmanAPIClient{
+		// The host is ignored when dialling the socket, but is needed to form valid URLs
+		baseURL: fmt.Sprintf("http://podman/%s/libpod", libpodAPIVersion),
+		client:  &http.Client{Transport: transport},
+	}, nil
+}
+
+// DefaultPodmanSocket finds the socket of the podman service in the same places as the podman remote client:
+// $CONTAINER_HOST, then the rootless socket of the current user and finally the rootful socket
+func DefaultPodmanSocket() string {
+	if host, ok := os.LookupEnv("CONTAINER_HOST"); ok {
+		return strings.TrimPrefix(host, "unix://")
+	}
+
+	candidates := []string{"/run/podman/podman.sock"}
+
+	if runtimeDir, ok := os.LookupEnv("XDG_RUNTIME_DIR"); ok {
+		candidates = append([]string{filepath.Join(runtimeDir, "podman", "podman.sock")}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+
+	return ""
+}
+
+func (p PodmanAPIClient) Type() OCIRuntimeType {
+	return PodmanAPI
+}
+
+func (p PodmanAPIClient) CheckExists(ctx context.Context) (bool, error) {
+	var version struct {
+		Version string `json:"Version"`
+	}
+
+	err := p.do(ctx, http.MethodGet, "/version", nil, nil, &version)
+
+	if err != nil {
+		return false, fmt.Errorf("error checking podman service is running: %s", err)
+	}
+
+	if version.Version == "" {
+		return false, fmt.Errorf("could not check if podman service is running. The version was not recognised")
+	}
+
+	return true, nil
+}
+
+// PullImage pulls the image, reading the progress streamed by podman until the pull completes or fails
+func (p PodmanAPIClient) PullImage(ctx context.Context, imageRef string, auth *RegistryAuth) error {
+	query := url.Values{"reference": {imageRef}}
+	header := http.Header{}
+
+	if auth != nil {
+		// The credentials are sent the same way as to the docker API, in a header rather than the query string
+		encoded, err := json.Marshal(map[string]string{
+			"username":      auth.Username,
+			"password":      auth.Password,
+			"identitytoken": auth.IdentityToken,
+			"serveraddress": auth.Server,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error encoding credentials for %s: %s", auth.Server, err)
+		}
+
+		header.Set("X-Registry-Auth", base64.URLEncoding.EncodeToString(encoded))
+	}
+
+	resp, err := p.requestWithHeader(ctx, http.MethodPost, "/images/pull", query, nil, header)
+
+	if err != nil {
+		return fmt.Errorf("error pulling podman image %s: %s", imageRef, err)
+	}
+
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+
+	for {
+		var report struct {
+			Stream string   `json:"stream"`
+			Error  string   `json:"error"`
+			Images []string `json:"images"`
+			ID     string   `json:"id"`
+		}
+
+		err := decoder.Decode(&report)
+
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading pull progress for podman image %s: %s", imageRef, err)
+		}
+
+		if report.Error != "" {
+			return fmt.Errorf("error pulling podman image %s: %s", imageRef, report.Error)
+		}
+	}
+}
+
+// RemoveImages removes each image individually, so that failing to remove one image doesn't stop the others
+// from being removed
+func (p PodmanAPIClient) RemoveImages(ctx context.Context, refPrefix string, olderThanRef string) error {
+	images, err := p.GetImages(ctx, refPrefix, olderThanRef, true)
+
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+
+	for _, image := range images {
+		err := p.do(ctx, http.MethodDelete, "/images/"+url.PathEscape(image), nil, nil, nil)
+
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%s)", image, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("error removing podman images: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func (p PodmanAPIClient) GetImages(ctx context.Context, refPrefix string, olderThanImageRef string, dangling bool) ([]string, error) {
+	filters, _ := json.Marshal(map[string][]string{
+		"reference": {refPrefix},
+		"before":    {olderThanImageRef},
+		"dangling":  {fmt.Sprintf("%t", dangling)},
+	})
+
+	var images []struct {
+		ID string `json:"Id"`
+	}
+
+	err := p.do(ctx, http.MethodGet, "/images/json", url.Values{"filters": {string(filters)}}, nil, &images)
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error getting images associated with prefix %s: %s", refPrefix, err)
+	}
+
+	var imageIDs []string
+
+	for _, image := range images {
+		if image.ID != "" {
+			imageIDs = append(imageIDs, image.ID)
+		}
+	}
+
+	return imageIDs, nil
+}
+
+// libpodSpec is the subset of libpod's SpecGenerator used to create containers
+type libpodSpec struct {
+	Image          string                `json:"image"`
+	Command        []string              `json:"command,omitempty"`
+	Labels         map[string]string     `json:"labels,omitempty"`
+	ResourceLimits *libpodResourceLimits `json:"resource_limits,omitempty"`
+	PortMappings   []libpodPortMapping   `json:"portmappings,omitempty"`
+	Env            map[string]string     `json:"env,omitempty"`
+	Mounts         []libpodMount         `json:"mounts,omitempty"`
+	Volumes        []libpodNamedVolume   `json:"volumes,omitempty"`
+	// Setting networks needs a bridge network namespace, which isn't the default for rootless containers
+	NetNS    *libpodNamespace         `json:"netns,omitempty"`
+	Networks map[string]libpodNetwork `json:"Networks,omitempty"`
+}
+
+type libpodNamespace struct {
+	NSMode string `json:"nsmode"`
+}
+
+// libpodNetwork is the subset of libpod's PerNetworkOptions that beacon sets
+type libpodNetwork struct {
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// libpodNamedVolume mounts a named volume into the container
+type libpodNamedVolume struct {
+	Name string `json:"Name"`
+	Dest string `json:"Dest"`
+}
+
+func newLibpodNamedVolumes(volumes []VolumeMount) []libpodNamedVolume {
+	var named []libpodNamedVolume
+
+	for _, volume := range volumes {
+		named = append(named, libpodNamedVolume{Name: volume.Name, Dest: volume.Target})
+	}
+
+	return named
+}
+
+// libpodMount is the subset of the OCI runtime spec's Mount that beacon sets
+type libpodMount struct {
+	Destination string   `json:"destination"`
+	Source      string   `json:"source"`
+	Type        string   `json:"type"`
+	Options     []string `json:"options"`
+}
+
+func newLibpodMounts(mounts []Mount) []libpodMount {
+	var libpodMounts []libpodMount
+
+	for _, mount := range mounts {
+		libpodMounts = append(libpodMounts, libpodMount{Destination: mount.Target, Source: mount.Source, Type: "bind", Options: []string{"ro"}})
+	}
+
+	return libpodMounts
+}
+
+// libpodPortMapping publishes a container port. Leaving out the host port lets libpod pick a free one
+type libpodPortMapping struct {
+	ContainerPort int    `json:"container_port"`
+	HostIP        string `json:"host_ip"`
+}
+
+func newLibpodPortMappings(ports []int) []libpodPortMapping {
+	var mappings []libpodPortMapping
+
+	for _, port := range ports {
+		mappings = append(mappings, libpodPortMapping{ContainerPort: port, HostIP: "127.0.0.1"})
+	}
+
+	return mappings
+}
+
+// libpodResourceLimits is the subset of the OCI runtime spec's LinuxResources that beacon sets
+type libpodResourceLimits struct {
+	CPU    *libpodCPU   `json:"cpu,omitempty"`
+	Memory *libpodLimit `json:"memory,omitempty"`
+	Pids   *libpodLimit `json:"pids,omitempty"`
+}
+
+type libpodCPU struct {
+	Shares int64 `json:"shares,omitempty"`
+	Quota  int64 `json:"quota,omitempty"`
+	Period int64 `json:"period,omitempty"`
+}
+
+type libpodLimit struct {
+	Limit int64 `json:"limit"`
+}
+
+func newLibpodResourceLimits(resources Resources) *libpodResourceLimits {
+	if resources == (Resources{}) {
+		return nil
+	}
+
+	limits := &libpodResourceLimits{}
+
+	if resources.CPUShares > 0 || resources.CPUQuota > 0 {
+		limits.CPU = &libpodCPU{Shares: resources.CPUShares}
+
+		if resources.CPUQuota > 0 {
+			limits.CPU.Quota = resources.CPUQuota
+			limits.CPU.Period = CPUPeriod
+		}
+	}
+
+	if resources.Memory > 0 {
+		limits.Memory = &libpodLimit{Limit: resources.Memory}
+	}
+
+	if resources.PidsLimit > 0 {
+		limits.Pids = &libpodLimit{Limit: resources.PidsLimit}
+	}
+
+	return limits
+}
+
+// RunImage creates and starts a container for the image and returns its ID
+func (p PodmanAPIClient) RunImage(ctx context.Context, imageRef string, options RunOptions) (string, error) {
+	spec := libpodSpec{
+		Image:          imageRef,
+		Command:        options.Command,
+		Labels:         options.Labels,
+		ResourceLimits: newLibpodResourceLimits(options.Resources),
+		PortMappings:   newLibpodPortMappings(options.Ports),
+		Env:            options.Env,
+		Mounts:         newLibpodMounts(options.Mounts),
+		Volumes:        newLibpodNamedVolumes(options.Volumes),
+	}
+
+	if len(options.Networks) > 0 {
+		spec.NetNS = &libpodNamespace{NSMode: "bridge"}
+		spec.Networks = make(map[string]libpodNetwork, len(options.Networks))
+
+		for _, network := range options.Networks {
+			spec.Networks[network] = libpodNetwork{Aliases: options.NetworkAliases}
+		}
+	}
+
+	var created struct {
+		ID       string   `json:"Id"`
+		Warnings []string `json:"Warnings"`
+	}
+
+	err := p.do(ctx, http.MethodPost, "/containers/create", nil, spec, &created)
+
+	if err != nil {
+		return "", fmt.Errorf("error creating container for podman image %s: %s", imageRef, err)
+	}
+
+	err = p.StartContainer(ctx, created.ID)
+
+	if err != nil {
+		// The container carries beacon's labels, so it would otherwise be adopted or cleaned up as if it had run. It is
+		// removed even if ctx is what made starting it fail
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel()
+
+		if removeErr := p.RemoveContainer(cleanupCtx, created.ID); removeErr != nil {
+			log.Errorf("error removing container %s that failed to start: %s", created.ID, removeErr)
+		}
+
+		return "", fmt.Errorf("error running podman image %s: %s", imageRef, err)
+	}
+
+	return created.ID, nil
+}
+
+func (p PodmanAPIClient) StopContainersByImage(ctx context.Context, imageRef string) error {
+	containers, err := p.ContainersUsingImage(ctx, imageRef, []string{"running"})
+
+	if err != nil {
+		return err
+	}
+
+	for _, container := range containers {
+		err = p.StopContainer(ctx, container)
+
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (p PodmanAPIClient) StopContainer(ctx context.Context, containerID string) error {
+	err := p.do(ctx, http.MethodPost, fmt.Sprintf("/containers/%s/stop", url.PathEscape(containerID)), nil, nil, nil)
+
+	if err != nil {
+		return fmt.Errorf("error stopping container %s: %w", containerID, notFound(err))
+	}
+
+	return nil
+}
+
+// WaitContainer waits for the container to stop and returns its exit code
+func (p PodmanAPIClient) WaitContainer(ctx context.Context, containerID string) (int, error) {
+	var exitCode int
+
+	err := p.do(ctx, http.MethodPost, fmt.Sprintf("/containers/%s/wait", url.PathEscape(containerID)), nil, nil, &exitCode)
+
+	if err != nil {
+		return 0, fmt.Errorf("error waiting for container %s: %w", containerID, notFound(err))
+	}
+
+	return exitCode, nil
+}
+
+// ContainerLogs returns what the container has printed to stdout and stderr, interleaved
+func (p PodmanAPIClient) ContainerLogs(ctx context.Context, containerID string) ([]byte, error) {
+	query := url.Values{"stdout": {"true"}, "stderr": {"true"}}
+	resp, err := p.request(ctx, http.MethodGet, fmt.Sprintf("/containers/%s/logs", url.PathEscape(containerID)), query, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of container %s: %w", containerID, notFound(err))
+	}
+
+	defer resp.Body.Close()
+
+	logs, err := demuxLogs(resp.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of container %s: %s", containerID, err)
+	}
+
+	return logs, nil
+}
+
+// demuxLogs joins the frames of a log stream. The stdout and stderr of containers without a TTY are multiplexed into
+// frames, each with an 8 byte header: the stream it came from, three bytes of padding and the frame's length
+func demuxLogs(r io.Reader) ([]byte, error) {
+	var logs bytes.Buffer
+
+	header := make([]byte, 8)
+
+	for {
+		if _, err := io.ReadFull(r, header); err == io.EOF {
+			return logs.Bytes(), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.CopyN(&logs, r, int64(binary.BigEndian.Uint32(header[4:]))); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p PodmanAPIClient) StartContainer(ctx context.Context, containerID string) error {
+	err := p.do(ctx, http.MethodPost, fmt.Sprintf("/containers/%s/start", url.PathEscape(containerID)), nil, nil, nil)
+
+	if err != nil {
+		return fmt.Errorf("error starting container %s: %w", containerID, notFound(err))
+	}
+
+	return nil
+}
+
+func (p PodmanAPIClient) RemoveContainer(ctx context.Context, containerID string) error {
+	query := url.Values{"force": {"true"}}
+	err := p.do(ctx, http.MethodDelete, "/containers/"+url.PathEscape(containerID), query, nil, nil)
+
+	if err != nil {
+		return fmt.Errorf("error removing container %s: %w", containerID, notFound(err))
+	}
+
+	return nil
+}
+
+// CreateNetwork creates a bridge network with the labels, doing nothing if a network with the name already exists
+func (p PodmanAPIClient) CreateNetwork(ctx context.Context, name string, labels map[string]string) error {
+	network := struct {
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels,omitempty"`
+	}{Name: name, Labels: labels}
+
+	err := p.do(ctx, http.MethodPost, "/networks/create", nil, network, nil)
+
+	if apiErr, ok := err.(libpodError); ok && apiErr.Response == http.StatusConflict {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating network %s: %s", name, err)
+	}
+
+	return nil
+}
+
+func (p PodmanAPIClient) RemoveNetwork(ctx context.Context, name string) error {
+	err := p.do(ctx, http.MethodDelete, "/networks/"+url.PathEscape(name), nil, nil, nil)
+
+	if err != nil {
+		return fmt.Errorf("error removing network %s: %s", name, err)
+	}
+
+	return nil
+}
+
+// CreateVolume creates a named volume with the labels, doing nothing if a volume with the name already exists
+func (p PodmanAPIClient) CreateVolume(ctx context.Context, name string, labels map[string]string) error {
+	volume := struct {
+		Name  string            `json:"Name"`
+		Label map[string]string `json:"Label,omitempty"`
+	}{Name: name, Label: labels}
+
+	err := p.do(ctx, http.MethodPost, "/volumes/create", nil, volume, nil)
+
+	if apiErr, ok := err.(libpodError); ok && apiErr.Response == http.StatusConflict {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating volume %s: %s", name, err)
+	}
+
+	return nil
+}
+
+// ListVolumes lists the volumes that have every one of the labels
+func (p PodmanAPIClient) ListVolumes(ctx context.Context, labels map[string]string) ([]Volume, error) {
+	filters := map[string][]string{}
+
+	for _, key := range sortedKeys(labels) {
+		filters["label"] = append(filters["label"], fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+
+	encoded, _ := json.Marshal(filters)
+
+	var listed []volumeListing
+
+	err := p.do(ctx, http.MethodGet, "/volumes/json", url.Values{"filters": {string(encoded)}}, nil, &listed)
+
+	if err != nil {
+		return []Volume{}, fmt.Errorf("error listing volumes: %s", err)
+	}
+
+	return volumesFromListing(listed), nil
+}
+
+func (p PodmanAPIClient) RemoveVolume(ctx context.Context, name string) error {
+	err := p.do(ctx, http.MethodDelete, "/volumes/"+url.PathEscape(name), nil, nil, nil)
+
+	if apiErr, ok := err.(libpodError); ok && apiErr.Response == http.StatusNotFound {
+		return fmt.Errorf("error removing volume %s: %w: %s", name, ErrNoSuchVolume, apiErr.Message)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing volume %s: %s", name, err)
+	}
+
+	return nil
+}
+
+func (p PodmanAPIClient) InspectContainer(ctx context.Context, containerID string) (ContainerState, error) {
+	var container containerInspect
+
+	err := p.do(ctx, http.MethodGet, fmt.Sprintf("/containers/%s/json", url.PathEscape(containerID)), nil, nil, &container)
+
+	if err != nil {
+		return ContainerState{}, fmt.Errorf("error inspecting container %s: %w", containerID, notFound(err))
+	}
+
+	return container.state(), nil
+}
+
+func (p PodmanAPIClient) ContainersUsingImage(ctx context.Context, imageRef string, statuses []string) ([]string, error) {
+	filters, _ := json.Marshal(map[string][]string{
+		"ancestor": {imageRef},
+		"status":   statuses,
+	})
+
+	var containers []struct {
+		ID string `json:"Id"`
+	}
+
+	err := p.do(ctx, http.MethodGet, "/containers/json", url.Values{"all": {"true"}, "filters": {string(filters)}}, nil, &containers)
+
+	if err != nil {
+		return []string{}, fmt.Errorf("error getting containers associated with image %s: %s", imageRef, err)
+	}
+
+	var containerIDs []string
+
+	for _, container := range containers {
+		if container.ID != "" {
+			containerIDs = append(containerIDs, container.ID)
+		}
+	}
+
+	return containerIDs, nil
+}
+
+// ListContainers lists the containers that have every one of the labels and, if any statuses are given, are in one
+// of them
+func (p PodmanAPIClient) ListContainers(ctx context.Context, labels map[string]string, statuses []string) ([]Container, error) {
+	filters := map[string][]string{}
+
+	for _, key := range sortedKeys(labels) {
+		filters["label"] = append(filters["label"], fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+
+	if len(statuses) > 0 {
+		filters["status"] = statuses
+	}
+
+	encoded, _ := json.Marshal(filters)
+
+	var listed []containerListing
+
+	err := p.do(ctx, http.MethodGet, "/containers/json", url.Values{"all": {"true"}, "filters": {string(encoded)}}, nil, &listed)
+
+	if err != nil {
+		return []Container{}, fmt.Errorf("error listing containers: %s", err)
+	}
+
+	return containersFromListing(listed), nil
+}
+
+// do sends a request to the libpod API with an optional JSON body, decoding the JSON response into result if it
+// is not nil
+func (p PodmanAPIClient) do(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+
+		if err != nil {
+			return err
+		}
+
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	resp, err := p.request(ctx, method, path, query, reqBody)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error parsing response from %s %s: %s", method, path, err)
+	}
+
+	return nil
+}
+
+// request sends a request to the libpod API, turning error responses into errors. The caller must close the body
+// of the response if there is no error
+func (p PodmanAPIClient) request(ctx context.Context, method string, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	return p.requestWithHeader(ctx, method, path, query, body, nil)
+}
+
+// requestWithHeader is request with extra headers set on the request
+func (p PodmanAPIClient) requestWithHeader(ctx context.Context, method string, path string, query url.Values, body io.Reader, header http.Header) (*http.Response, error) {
+	endpoint := p.baseURL + path
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 400 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+
+	var apiErr libpodError
+	content, _ := io.ReadAll(resp.Body)
+
+	if json.Unmarshal(content, &apiErr) != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(content))
+	}
+
+	apiErr.Response = resp.StatusCode
+
+	return nil, apiErr
+}
@@ -0,0 +1,521 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeLibpod stands in for the libpod API, recording the requests made to it
+type fakeLibpod struct {
+	mux      *http.ServeMux
+	requests []string
+	bodies   map[string][]byte
+}
+
+func newFakeLibpod() *fakeLibpod {
+	return &fakeLibpod{mux: http.NewServeMux(), bodies: map[string][]byte{}}
+}
+
+func (f *fakeLibpod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	request := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+
+	f.requests = append(f.requests, request)
+	f.bodies[request] = body
+	f.mux.ServeHTTP(w, r)
+}
+
+func (f *fakeLibpod) handle(pattern string, status int, response string) {
+	f.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	})
+}
+
+type PodmanAPISuite struct {
+	suite.Suite
+	Libpod       *fakeLibpod
+	Server       *httptest.Server
+	PodmanClient PodmanAPIClient
+	LogBuff      *bytes.Buffer
+}
+
+func TestPodmanAPISuite(t *testing.T) {
+	suite.Run(t, new(PodmanAPISuite))
+}
+
+func (p *PodmanAPISuite) SetupTest() {
+	p.Libpod = newFakeLibpod()
+	p.Server = httptest.NewServer(p.Libpod)
+	p.PodmanClient = PodmanAPIClient{
+		baseURL: fmt.Sprintf("%s/%s/libpod", p.Server.URL, libpodAPIVersion),
+		client:  p.Server.Client(),
+	}
+
+	p.LogBuff = new(bytes.Buffer)
+	log.SetOutput(p.LogBuff)
+}
+
+func (p *PodmanAPISuite) TearDownTest() {
+	p.Server.Close()
+}
+
+func (p *PodmanAPISuite) TestType() {
+	assert.Equal(p.T(), PodmanAPI, p.PodmanClient.Type())
+}
+
+func (p *PodmanAPISuite) TestCheckExistsOK() {
+	p.Libpod.handle("/v4.0.0/libpod/version", http.StatusOK, `{"Version": "4.3.1"}`)
+
+	exists, err := p.PodmanClient.CheckExists(context.Background())
+
+	assert.NoError(p.T(), err)
+	assert.True(p.T(), exists)
+}
+
+func (p *PodmanAPISuite) TestCheckExistsErrors() {
+	p.Libpod.handle("/v4.0.0/libpod/version", http.StatusInternalServerError, `{"cause": "fake", "message": "fake error", "response": 500}`)
+
+	exists, err := p.PodmanClient.CheckExists(context.Background())
+
+	assert.ErrorContains(p.T(), err, "podman service returned status 500: fake error")
+	assert.False(p.T(), exists)
+}
+
+func (p *PodmanAPISuite) TestOverUnixSocketOK() {
+	socketPath := filepath.Join(p.T().TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", socketPath)
+	assert.NoError(p.T(), err)
+
+	server := httptest.NewUnstartedServer(p.Libpod)
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	p.Libpod.handle("/v4.0.0/libpod/version", http.StatusOK, `{"Version": "4.3.1"}`)
+
+	client, err := NewPodmanAPI(socketPath)
+	assert.NoError(p.T(), err)
+
+	exists, err := client.CheckExists(context.Background())
+
+	assert.NoError(p.T(), err)
+	assert.True(p.T(), exists)
+}
+
+func (p *PodmanAPISuite) TestNewPodmanAPIWithoutSocketErrors() {
+	_, err := NewPodmanAPI("")
+
+	assert.ErrorContains(p.T(), err, "no podman socket found")
+}
+
+func (p *PodmanAPISuite) TestPullImageOK() {
+	progress := `{"stream": "Trying to pull fakeImageRef...\n"}
+{"stream": "Writing manifest to image destination\n"}
+{"images": ["fakeImageId"], "id": "fakeImageId"}
+`
+	p.Libpod.handle("/v4.0.0/libpod/images/pull", http.StatusOK, progress)
+
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", nil)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []string{"POST /v4.0.0/libpod/images/pull"}, p.Libpod.requests)
+}
+
+func (p *PodmanAPISuite) TestPullImageWithAuth() {
+	var header string
+
+	p.Libpod.mux.HandleFunc("/v4.0.0/libpod/images/pull", func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Registry-Auth")
+		w.Write([]byte(`{"images": ["fakeImageId"], "id": "fakeImageId"}`))
+	})
+
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", &RegistryAuth{Server: "docker.io", Username: "fakeUser", Password: "fakePassword"})
+
+	assert.NoError(p.T(), err)
+
+	decoded, err := base64.URLEncoding.DecodeString(header)
+
+	assert.NoError(p.T(), err)
+	assert.JSONEq(p.T(), `{"username": "fakeUser", "password": "fakePassword", "identitytoken": "", "serveraddress": "docker.io"}`, string(decoded))
+}
+
+func (p *PodmanAPISuite) TestPullImageStreamErrors() {
+	// Pull errors are reported in the stream after the 200 status has already been sent
+	progress := `{"stream": "Trying to pull fakeImageRef...\n"}
+{"error": "manifest unknown"}
+`
+	p.Libpod.handle("/v4.0.0/libpod/images/pull", http.StatusOK, progress)
+
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", nil)
+
+	assert.ErrorContains(p.T(), err, "error pulling podman image fakeImageRef: manifest unknown")
+}
+
+func (p *PodmanAPISuite) TestPullImageCancelled() {
+	p.Libpod.mux.HandleFunc("/v4.0.0/libpod/images/pull", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := p.PodmanClient.PullImage(ctx, "fakeImageRef", nil)
+
+	assert.ErrorContains(p.T(), err, context.DeadlineExceeded.Error())
+}
+
+func (p *PodmanAPISuite) TestPullImageErrors() {
+	p.Libpod.handle("/v4.0.0/libpod/images/pull", http.StatusInternalServerError, `{"message": "fake error", "response": 500}`)
+
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", nil)
+
+	assert.ErrorContains(p.T(), err, "error pulling podman image fakeImageRef")
+}
+
+func (p *PodmanAPISuite) TestRunImageOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	options := RunOptions{Resources: Resources{CPUQuota: 50000, Memory: 1024}}
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+	assert.Equal(p.T(), []string{"POST /v4.0.0/libpod/containers/create", "POST /v4.0.0/libpod/containers/fakeContainerId/start"}, p.Libpod.requests)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), map[string]interface{}{
+		"image": "fakeImageRef",
+		"resource_limits": map[string]interface{}{
+			"cpu":    map[string]interface{}{"quota": float64(50000), "period": float64(100000)},
+			"memory": map[string]interface{}{"limit": float64(1024)},
+		},
+	}, spec)
+}
+
+func (p *PodmanAPISuite) TestRunImageCreateErrors() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusNotFound, `{"message": "fakeImageRef: image not known", "response": 404}`)
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{})
+
+	assert.ErrorContains(p.T(), err, "error creating container for podman image fakeImageRef")
+	assert.NotErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanAPISuite) TestRunImageStartErrorsRemoveContainer() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusInternalServerError, `{"message": "fake error", "response": 500}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId", http.StatusOK, "[]")
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{})
+
+	assert.ErrorContains(p.T(), err, "error running podman image fakeImageRef")
+	assert.Equal(p.T(), []string{
+		"POST /v4.0.0/libpod/containers/create",
+		"POST /v4.0.0/libpod/containers/fakeContainerId/start",
+		"DELETE /v4.0.0/libpod/containers/fakeContainerId",
+	}, p.Libpod.requests)
+}
+
+func (p *PodmanAPISuite) TestInspectContainerOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/json", http.StatusOK,
+		`{"Id": "fakeContainerId", "State": {"Status": "running", "StartedAt": "2023-01-02T15:04:05Z", "Health": {"Status": "healthy"}}}`)
+
+	state, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), ContainerState{ID: "fakeContainerId", Status: "running", Health: "healthy", StartedAt: state.StartedAt}, state)
+	assert.Equal(p.T(), 2023, state.StartedAt.Year())
+}
+
+func (p *PodmanAPISuite) TestRunImageWithPortsOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Ports: []int{8080}})
+
+	assert.NoError(p.T(), err)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), []interface{}{
+		map[string]interface{}{"container_port": float64(8080), "host_ip": "127.0.0.1"},
+	}, spec["portmappings"])
+}
+
+func (p *PodmanAPISuite) TestRunImageWithEnvAndMountsOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	options := RunOptions{
+		Env:    map[string]string{"A": "fakeValueA"},
+		Mounts: []Mount{{Source: "/fakeSource", Target: "/fakeTarget"}},
+	}
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), map[string]interface{}{"A": "fakeValueA"}, spec["env"])
+	assert.Equal(p.T(), []interface{}{
+		map[string]interface{}{"destination": "/fakeTarget", "source": "/fakeSource", "type": "bind", "options": []interface{}{"ro"}},
+	}, spec["mounts"])
+}
+
+func (p *PodmanAPISuite) TestInspectContainerWithPortsOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/json", http.StatusOK,
+		`{"Id": "fakeContainerId", "State": {"Status": "running"}, "NetworkSettings": {"Ports": {"8080/tcp": [{"HostIp": "127.0.0.1", "HostPort": "40123"}], "9090/tcp": null}}}`)
+
+	state, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), map[int]string{8080: "127.0.0.1:40123"}, state.Ports)
+}
+
+func (p *PodmanAPISuite) TestInspectContainerMissing() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/json", http.StatusNotFound, `{"message": "no such container", "response": 404}`)
+
+	_, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanAPISuite) TestRemoveContainerOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId", http.StatusOK, `[{"Id": "fakeContainerId"}]`)
+
+	err := p.PodmanClient.RemoveContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []string{"DELETE /v4.0.0/libpod/containers/fakeContainerId"}, p.Libpod.requests)
+}
+
+func (p *PodmanAPISuite) TestStopContainerMissing() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/stop", http.StatusNotFound, `{"message": "no such container", "response": 404}`)
+
+	err := p.PodmanClient.StopContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanAPISuite) TestStartContainerMissing() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNotFound, `{"message": "no such container", "response": 404}`)
+
+	err := p.PodmanClient.StartContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanAPISuite) TestStopContainerErrors() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/stop", http.StatusInternalServerError, `{"message": "fake error", "response": 500}`)
+
+	err := p.PodmanClient.StopContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorContains(p.T(), err, "error stopping container fakeContainerId")
+	assert.NotErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanAPISuite) TestContainersUsingImageOK() {
+	var filters map[string][]string
+
+	p.Libpod.mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+		w.Write([]byte(`[{"Id": "containerIdA"}, {"Id": "containerIdB"}]`))
+	})
+
+	containers, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", []string{"running"})
+
+	assert.NoError(p.T(), err)
+	assert.ElementsMatch(p.T(), []string{"containerIdA", "containerIdB"}, containers)
+	assert.Equal(p.T(), map[string][]string{"ancestor": {"fakeImageRef"}, "status": {"running"}}, filters)
+}
+
+func (p *PodmanAPISuite) TestStopContainersByImageStopErrors() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/json", http.StatusOK, `[{"Id": "containerIdA"}, {"Id": "containerIdB"}]`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/containerIdA/stop", http.StatusInternalServerError, `{"message": "fake error", "response": 500}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/containerIdB/stop", http.StatusNoContent, "")
+
+	err := p.PodmanClient.StopContainersByImage(context.Background(), "fakeImageRef")
+
+	assert.NoError(p.T(), err)
+	assert.Contains(p.T(), p.LogBuff.String(), "error stopping container containerIdA")
+	assert.Contains(p.T(), p.Libpod.requests, "POST /v4.0.0/libpod/containers/containerIdB/stop")
+}
+
+func (p *PodmanAPISuite) TestRemoveImagesContinuesOnError() {
+	p.Libpod.handle("/v4.0.0/libpod/images/json", http.StatusOK, `[{"Id": "imageIdA"}, {"Id": "imageIdB"}]`)
+	p.Libpod.handle("/v4.0.0/libpod/images/imageIdA", http.StatusConflict, `{"message": "image in use", "response": 409}`)
+	p.Libpod.handle("/v4.0.0/libpod/images/imageIdB", http.StatusOK, `{"Deleted": ["imageIdB"]}`)
+
+	err := p.PodmanClient.RemoveImages(context.Background(), "fakeImagePrefix", "oldImageRef")
+
+	assert.ErrorContains(p.T(), err, "imageIdA")
+	assert.NotContains(p.T(), err.Error(), "imageIdB")
+	assert.Contains(p.T(), p.Libpod.requests, "DELETE /v4.0.0/libpod/images/imageIdB")
+}
+
+func (p *PodmanAPISuite) TestListContainersOK() {
+	var filters map[string][]string
+
+	p.Libpod.mux.HandleFunc("/v4.0.0/libpod/containers/json", func(w http.ResponseWriter, r *http.Request) {
+		json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+		w.Write([]byte(`[{"Id": "containerIdA", "Image": "fakeImageRef", "State": "exited", "Labels": {"com.lytbeacon.beacon-id": "fakeBeaconId"}}]`))
+	})
+
+	containers, err := p.PodmanClient.ListContainers(context.Background(), map[string]string{LabelBeaconID: "fakeBeaconId", LabelProbe: "fakeNamespace/fakeRepo"}, nil)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []Container{{
+		ID:     "containerIdA",
+		Image:  "fakeImageRef",
+		Status: "exited",
+		Labels: map[string]string{LabelBeaconID: "fakeBeaconId"},
+	}}, containers)
+	assert.Equal(p.T(), map[string][]string{"label": {"com.lytbeacon.beacon-id=fakeBeaconId", "com.lytbeacon.probe=fakeNamespace/fakeRepo"}}, filters)
+}
+
+func (p *PodmanAPISuite) TestRunImageWithNetworksOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	options := RunOptions{Networks: []string{"beacon"}, NetworkAliases: []string{"fakerepo.fakenamespace"}}
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), map[string]interface{}{"nsmode": "bridge"}, spec["netns"])
+	assert.Equal(p.T(), map[string]interface{}{
+		"beacon": map[string]interface{}{"aliases": []interface{}{"fakerepo.fakenamespace"}},
+	}, spec["Networks"])
+}
+
+func (p *PodmanAPISuite) TestCreateNetworkExists() {
+	p.Libpod.handle("/v4.0.0/libpod/networks/create", http.StatusConflict, `{"message": "network name beacon already used", "response": 409}`)
+
+	err := p.PodmanClient.CreateNetwork(context.Background(), "beacon", map[string]string{LabelBeaconID: "fakeBeaconId"})
+
+	assert.NoError(p.T(), err)
+	assert.JSONEq(p.T(), `{"name": "beacon", "labels": {"com.lytbeacon.beacon-id": "fakeBeaconId"}}`, string(p.Libpod.bodies["POST /v4.0.0/libpod/networks/create"]))
+}
+
+func (p *PodmanAPISuite) TestRemoveNetworkOK() {
+	p.Libpod.handle("/v4.0.0/libpod/networks/beacon", http.StatusOK, `[{"Name": "beacon"}]`)
+
+	err := p.PodmanClient.RemoveNetwork(context.Background(), "beacon")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []string{"DELETE /v4.0.0/libpod/networks/beacon"}, p.Libpod.requests)
+}
+
+func (p *PodmanAPISuite) TestRunImageWithVolumesOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Volumes: []VolumeMount{{Name: "fakeVolume", Target: "/data"}}})
+
+	assert.NoError(p.T(), err)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), []interface{}{map[string]interface{}{"Name": "fakeVolume", "Dest": "/data"}}, spec["volumes"])
+}
+
+func (p *PodmanAPISuite) TestCreateVolumeExists() {
+	p.Libpod.handle("/v4.0.0/libpod/volumes/create", http.StatusConflict, `{"message": "volume with name fakeVolume already exists", "response": 409}`)
+
+	err := p.PodmanClient.CreateVolume(context.Background(), "fakeVolume", map[string]string{LabelVolume: "data"})
+
+	assert.NoError(p.T(), err)
+	assert.JSONEq(p.T(), `{"Name": "fakeVolume", "Label": {"com.lytbeacon.volume": "data"}}`, string(p.Libpod.bodies["POST /v4.0.0/libpod/volumes/create"]))
+}
+
+func (p *PodmanAPISuite) TestListVolumesOK() {
+	p.Libpod.mux.HandleFunc("/v4.0.0/libpod/volumes/json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(p.T(), `{"label":["com.lytbeacon.beacon-id=fakeBeaconId"]}`, r.URL.Query().Get("filters"))
+		w.Write([]byte(`[{"Name": "fakeVolume", "Mountpoint": "", "CreatedAt": "2023-01-02T15:04:05Z", "Labels": {"com.lytbeacon.beacon-id": "fakeBeaconId"}}]`))
+	})
+
+	volumes, err := p.PodmanClient.ListVolumes(context.Background(), map[string]string{LabelBeaconID: "fakeBeaconId"})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []Volume{{
+		Name:      "fakeVolume",
+		Labels:    map[string]string{LabelBeaconID: "fakeBeaconId"},
+		CreatedAt: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		Size:      -1,
+	}}, volumes)
+}
+
+func (p *PodmanAPISuite) TestRemoveVolumeMissing() {
+	p.Libpod.handle("/v4.0.0/libpod/volumes/fakeVolume", http.StatusNotFound, `{"message": "no volume with name \"fakeVolume\" found: no such volume", "response": 404}`)
+
+	err := p.PodmanClient.RemoveVolume(context.Background(), "fakeVolume")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchVolume)
+}
+
+func (p *PodmanAPISuite) TestRunImageWithCommandOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/create", http.StatusCreated, `{"Id": "fakeContainerId", "Warnings": []}`)
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/start", http.StatusNoContent, "")
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Command: []string{"./migrate", "up"}})
+
+	assert.NoError(p.T(), err)
+
+	var spec map[string]interface{}
+	json.Unmarshal(p.Libpod.bodies["POST /v4.0.0/libpod/containers/create"], &spec)
+
+	assert.Equal(p.T(), []interface{}{"./migrate", "up"}, spec["command"])
+}
+
+func (p *PodmanAPISuite) TestWaitContainerOK() {
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/wait", http.StatusOK, "2")
+
+	exitCode, err := p.PodmanClient.WaitContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), 2, exitCode)
+}
+
+func (p *PodmanAPISuite) TestContainerLogsOK() {
+	var frames bytes.Buffer
+
+	for _, frame := range []struct {
+		stream byte
+		data   string
+	}{{1, "migrating\n"}, {2, "error: table exists\n"}} {
+		frames.Write([]byte{frame.stream, 0, 0, 0, 0, 0, 0, byte(len(frame.data))})
+		frames.WriteString(frame.data)
+	}
+
+	p.Libpod.handle("/v4.0.0/libpod/containers/fakeContainerId/logs", http.StatusOK, frames.String())
+
+	logs, err := p.PodmanClient.ContainerLogs(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "migrating\nerror: table exists\n", string(logs))
+}
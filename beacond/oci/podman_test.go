@@ -2,8 +2,12 @@ package oci
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/labstack/gommon/log"
 
@@ -40,9 +44,9 @@ func (p *PodmanSuite) TestCheckExistsPasses() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "--version").Return([]byte("fake version"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "--version").Return([]byte("fake version"), nil)
 
-	exists, err := p.PodmanClient.CheckExists()
+	exists, err := p.PodmanClient.CheckExists(context.Background())
 
 	assert.NoError(p.T(), err)
 	assert.True(p.T(), exists)
@@ -54,9 +58,9 @@ func (p *PodmanSuite) TestCheckExistsFails() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "--version").Return([]byte("fake output"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "--version").Return([]byte("fake output"), nil)
 
-	exists, err := p.PodmanClient.CheckExists()
+	exists, err := p.PodmanClient.CheckExists(context.Background())
 
 	assert.ErrorContains(p.T(), err, "The output was not recognised")
 	assert.False(p.T(), exists)
@@ -68,9 +72,9 @@ func (p *PodmanSuite) TestCheckExistsErrors() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "--version").Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "--version").Return([]byte(""), fmt.Errorf("fake error"))
 
-	exists, err := p.PodmanClient.CheckExists()
+	exists, err := p.PodmanClient.CheckExists(context.Background())
 
 	assert.Error(p.T(), err)
 	assert.False(p.T(), exists)
@@ -82,11 +86,34 @@ func (p *PodmanSuite) TestRunImageOK() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "run", "fakeImageRef").Return([]byte("fake output"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "fakeImageRef").Return([]byte("fake warning\nfakeContainerId\n"), nil)
 
-	err := p.PodmanClient.RunImage("fakeImageRef")
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{})
 
 	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestRunImageWithResourcesOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "run", "--detach",
+		"--cpu-shares", "512",
+		"--cpu-period", "100000", "--cpu-quota", "50000",
+		"--memory", "268435456b",
+		"--pids-limit", "100",
+		"fakeImageRef",
+	}
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeContainerId"), nil)
+
+	options := RunOptions{Resources: Resources{CPUShares: 512, CPUQuota: 50000, Memory: 256 * 1024 * 1024, PidsLimit: 100}}
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
 }
 
 func (p *PodmanSuite) TestRunImageErrors() {
@@ -95,9 +122,9 @@ func (p *PodmanSuite) TestRunImageErrors() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "run", "fakeImageRef").Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "fakeImageRef").Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.RunImage("fakeImageRef")
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{})
 
 	assert.ErrorContains(p.T(), err, "error running podman image fakeImageRef")
 }
@@ -108,22 +135,46 @@ func (p *PodmanSuite) TestPullImageOK() {
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "pull", "fakeImageRef").Return([]byte("fake output"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "pull", "fakeImageRef").Return([]byte("fake output"), nil)
 
-	err := p.PodmanClient.PullImage("fakeImageRef")
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", nil)
 
 	assert.NoError(p.T(), err)
 }
 
+func (p *PodmanSuite) TestPullImageWithAuth() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	var authFile string
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "pull", "--authfile", gomock.Any(), "fakeImageRef").DoAndReturn(func(ctx context.Context, args ...string) ([]byte, error) {
+		authFile = args[3]
+		content, err := os.ReadFile(authFile)
+
+		assert.NoError(p.T(), err)
+		assert.JSONEq(p.T(), `{"auths": {"docker.io": {"auth": "ZmFrZVVzZXI6ZmFrZVBhc3N3b3Jk"}}}`, string(content))
+
+		return []byte("fake output"), nil
+	})
+
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", &RegistryAuth{Server: "docker.io", Username: "fakeUser", Password: "fakePassword"})
+
+	assert.NoError(p.T(), err)
+	assert.NoFileExists(p.T(), authFile)
+}
+
 func (p *PodmanSuite) TestPullImageErrors() {
 	mockController := gomock.NewController(p.T())
 	defer mockController.Finish()
 
 	p.PodmanClient.runner = NewMockRunner(mockController)
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "pull", "fakeImageRef").Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "pull", "fakeImageRef").Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.PullImage("fakeImageRef")
+	err := p.PodmanClient.PullImage(context.Background(), "fakeImageRef", nil)
 
 	assert.ErrorContains(p.T(), err, "error pulling podman image fakeImageRef")
 }
@@ -140,9 +191,9 @@ func (p *PodmanSuite) TestGetImagesOK() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
 
-	actual, err := p.PodmanClient.GetImages("fakeImagePrefix", "oldImageRef", true)
+	actual, err := p.PodmanClient.GetImages(context.Background(), "fakeImagePrefix", "oldImageRef", true)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), actual, []string{"imageIdA", "imageIdB"})
@@ -160,9 +211,9 @@ func (p *PodmanSuite) TestGetImagesOKEmptyList() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"OtherField\": \"imageIdA\"}, {\"OtherField\": \"imageIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"OtherField\": \"imageIdA\"}, {\"OtherField\": \"imageIdB\"}]"), nil)
 
-	actual, err := p.PodmanClient.GetImages("fakeImagePrefix", "oldImageRef", true)
+	actual, err := p.PodmanClient.GetImages(context.Background(), "fakeImagePrefix", "oldImageRef", true)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), actual, []string{})
@@ -180,9 +231,9 @@ func (p *PodmanSuite) TestGetImagesInvalidJSON() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("not a json"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("not a json"), nil)
 
-	_, err := p.PodmanClient.GetImages("fakeImagePrefix", "oldImageRef", true)
+	_, err := p.PodmanClient.GetImages(context.Background(), "fakeImagePrefix", "oldImageRef", true)
 
 	assert.Error(p.T(), err, "error parsing images output for ref prefix fakeImagePrefix")
 }
@@ -199,9 +250,9 @@ func (p *PodmanSuite) TestGetImagesErrors() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	_, err := p.PodmanClient.GetImages("fakeImagePrefix", "oldImageRef", true)
+	_, err := p.PodmanClient.GetImages(context.Background(), "fakeImagePrefix", "oldImageRef", true)
 
 	assert.Error(p.T(), err, "error getting images associated with prefix fakeImagePrefix")
 }
@@ -221,10 +272,10 @@ func (p *PodmanSuite) TestRemoveImagesOK() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(getImagesArgs...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(removeImagesArgs...).Return([]byte(""), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), getImagesArgs...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), removeImagesArgs...).Return([]byte(""), nil)
 
-	err := p.PodmanClient.RemoveImages("fakeImagePrefix", "oldImageRef")
+	err := p.PodmanClient.RemoveImages(context.Background(), "fakeImagePrefix", "oldImageRef")
 
 	assert.NoError(p.T(), err)
 }
@@ -241,9 +292,9 @@ func (p *PodmanSuite) TestRemoveImagesGetImagesError() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(getImagesArgs...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), getImagesArgs...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.RemoveImages("fakeImagePrefix", "oldImageRef")
+	err := p.PodmanClient.RemoveImages(context.Background(), "fakeImagePrefix", "oldImageRef")
 
 	assert.Errorf(p.T(), err, "error getting images associated with prefix fakeImagePrefix")
 }
@@ -263,10 +314,10 @@ func (p *PodmanSuite) TestRemoveImagesRmError() {
 		fmt.Sprintf("--filter=dangling=%t", true),
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(getImagesArgs...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(removeImagesArgs...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), getImagesArgs...).Return([]byte("[{\"Id\": \"imageIdA\"}, {\"Id\": \"imageIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), removeImagesArgs...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.RemoveImages("fakeImagePrefix", "oldImageRef")
+	err := p.PodmanClient.RemoveImages(context.Background(), "fakeImagePrefix", "oldImageRef")
 
 	assert.Errorf(p.T(), err, "error removing podman images")
 }
@@ -279,9 +330,9 @@ func (p *PodmanSuite) TestStopContainerOK() {
 
 	args := []interface{}{"podman", "stop", "fakeImageRef"}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte(""), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(""), nil)
 
-	err := p.PodmanClient.StopContainer("fakeImageRef")
+	err := p.PodmanClient.StopContainer(context.Background(), "fakeImageRef")
 
 	assert.NoError(p.T(), err)
 }
@@ -294,9 +345,9 @@ func (p *PodmanSuite) TestStopContainerErrors() {
 
 	args := []interface{}{"podman", "stop", "fakeImageRef"}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.StopContainer("fakeImageRef")
+	err := p.PodmanClient.StopContainer(context.Background(), "fakeImageRef")
 
 	assert.Errorf(p.T(), err, "error stopping container fakeImageRef")
 }
@@ -315,9 +366,9 @@ func (p *PodmanSuite) TestContainersUsingImageMultipleStatusesOK() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
 
-	containers, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	containers, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), containers, []string{"containerIdA", "containerIdB"})
@@ -337,9 +388,9 @@ func (p *PodmanSuite) TestContainersUsingImageSingleStatusOK() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
 
-	containers, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	containers, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), containers, []string{"containerIdA", "containerIdB"})
@@ -359,9 +410,9 @@ func (p *PodmanSuite) TestContainersUsingImagePartialIds() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"NotAnId\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"NotAnId\": \"containerIdB\"}]"), nil)
 
-	containers, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	containers, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), containers, []string{"containerIdA"})
@@ -381,9 +432,9 @@ func (p *PodmanSuite) TestContainersUsingImageNoIds() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("[{\"NotAnId\": \"containerIdA\"}, {\"NotAnId\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("[{\"NotAnId\": \"containerIdA\"}, {\"NotAnId\": \"containerIdB\"}]"), nil)
 
-	containers, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	containers, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.NoError(p.T(), err)
 	assert.ElementsMatch(p.T(), containers, []string{})
@@ -403,9 +454,9 @@ func (p *PodmanSuite) TestContainersUsingImageErrors() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	_, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	_, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.Error(p.T(), err, "error getting containers associated with image fakeImagePrefix")
 }
@@ -424,9 +475,9 @@ func (p *PodmanSuite) TestContainersUsingImageInvalidJSON() {
 		args = append(args, fmt.Sprintf("--filter=status='%s'", status))
 	}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(args...).Return([]byte("not json"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("not json"), nil)
 
-	_, err := p.PodmanClient.ContainersUsingImage("fakeImageRef", statuses)
+	_, err := p.PodmanClient.ContainersUsingImage(context.Background(), "fakeImageRef", statuses)
 
 	assert.Error(p.T(), err, "error parsing containers output for image fakeImagePrefix")
 }
@@ -439,11 +490,11 @@ func (p *PodmanSuite) TestStopContainersByImageOK() {
 
 	psArgs := []interface{}{"podman", "ps", "--format", "json", "--filter=ancestor='fakeImageRef'", "--filter=status='running'"}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(psArgs...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "stop", "containerIdA").Return([]byte(""), nil)
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "stop", "containerIdB").Return([]byte(""), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), psArgs...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "stop", "containerIdA").Return([]byte(""), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "stop", "containerIdB").Return([]byte(""), nil)
 
-	err := p.PodmanClient.StopContainersByImage("fakeImageRef")
+	err := p.PodmanClient.StopContainersByImage(context.Background(), "fakeImageRef")
 
 	assert.NoError(p.T(), err)
 }
@@ -456,9 +507,9 @@ func (p *PodmanSuite) TestStopContainersByImagePsErrors() {
 
 	psArgs := []interface{}{"podman", "ps", "--format", "json", "--filter=ancestor='fakeImageRef'", "--filter=status='running'"}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(psArgs...).Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), psArgs...).Return([]byte(""), fmt.Errorf("fake error"))
 
-	err := p.PodmanClient.StopContainersByImage("fakeImageRef")
+	err := p.PodmanClient.StopContainersByImage(context.Background(), "fakeImageRef")
 
 	assert.Error(p.T(), err, "fake error")
 }
@@ -471,12 +522,420 @@ func (p *PodmanSuite) TestStopContainersByImageStopErrors() {
 
 	psArgs := []interface{}{"podman", "ps", "--format", "json", "--filter=ancestor='fakeImageRef'", "--filter=status='running'"}
 
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run(psArgs...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "stop", "containerIdA").Return([]byte(""), fmt.Errorf("fake error"))
-	p.PodmanClient.runner.(*MockRunner).EXPECT().run("podman", "stop", "containerIdB").Return([]byte(""), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), psArgs...).Return([]byte("[{\"Id\": \"containerIdA\"}, {\"Id\": \"containerIdB\"}]"), nil)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "stop", "containerIdA").Return([]byte(""), fmt.Errorf("fake error"))
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "stop", "containerIdB").Return([]byte(""), nil)
 
-	err := p.PodmanClient.StopContainersByImage("fakeImageRef")
+	err := p.PodmanClient.StopContainersByImage(context.Background(), "fakeImageRef")
 
 	assert.Contains(p.T(), p.LogBuff.String(), "error stopping container containerIdA")
 	assert.NoError(p.T(), err)
 }
+
+func (p *PodmanSuite) TestStartContainerOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "start", "fakeContainerId").Return([]byte("fakeContainerId"), nil)
+
+	err := p.PodmanClient.StartContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+}
+
+func (p *PodmanSuite) TestStartContainerErrors() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "start", "fakeContainerId").Return([]byte(""), fmt.Errorf("fake error"))
+
+	err := p.PodmanClient.StartContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorContains(p.T(), err, "error starting container fakeContainerId")
+}
+
+func (p *PodmanSuite) TestRemoveContainerOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "rm", "--force", "fakeContainerId").Return([]byte("fakeContainerId"), nil)
+
+	err := p.PodmanClient.RemoveContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+}
+
+func (p *PodmanSuite) TestRemoveContainerMissing() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := "Error: no container with name or ID \"fakeContainerId\" found: no such container"
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "rm", "--force", "fakeContainerId").Return([]byte(output), fmt.Errorf("exit status 1"))
+
+	err := p.PodmanClient.RemoveContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanSuite) TestInspectContainerOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := `[{"Id": "fakeContainerId", "State": {"Status": "running", "ExitCode": 0, "StartedAt": "2023-01-02T15:04:05Z", "Health": {"Status": "unhealthy"}}}]`
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "container", "inspect", "fakeContainerId").Return([]byte(output), nil)
+
+	state, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", state.ID)
+	assert.Equal(p.T(), "running", state.Status)
+	assert.Equal(p.T(), "unhealthy", state.Health)
+	assert.Equal(p.T(), 2023, state.StartedAt.Year())
+}
+
+func (p *PodmanSuite) TestInspectContainerLegacyHealthcheckOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := `[{"Id": "fakeContainerId", "State": {"Status": "exited", "ExitCode": 137, "Healthcheck": {"Status": "healthy"}}}]`
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "container", "inspect", "fakeContainerId").Return([]byte(output), nil)
+
+	state, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "exited", state.Status)
+	assert.Equal(p.T(), 137, state.ExitCode)
+	assert.Equal(p.T(), "healthy", state.Health)
+}
+
+func (p *PodmanSuite) TestInspectContainerMissing() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := "Error: no such container fakeContainerId"
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "container", "inspect", "fakeContainerId").Return([]byte(output), fmt.Errorf("exit status 125"))
+
+	_, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanSuite) TestInspectContainerErrors() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "container", "inspect", "fakeContainerId").Return([]byte("not json"), nil)
+
+	_, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorContains(p.T(), err, "error parsing inspect output for container fakeContainerId")
+	assert.NotErrorIs(p.T(), err, ErrNoSuchContainer)
+}
+
+func (p *PodmanSuite) TestRunImageWithLabelsOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "run", "--detach",
+		"--label", "com.lytbeacon.digest=fakeDigest",
+		"--label", "com.lytbeacon.probe=fakeNamespace/fakeRepo",
+		"fakeImageRef",
+	}
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeContainerId"), nil)
+
+	options := RunOptions{Labels: map[string]string{LabelProbe: "fakeNamespace/fakeRepo", LabelDigest: "fakeDigest"}}
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestRunImageWithPortsOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "--publish", "127.0.0.1::8080", "fakeImageRef").Return([]byte("fakeContainerId"), nil)
+
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Ports: []int{8080}})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestInspectContainerWithPortsOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	// Ports published on every interface are reached through the loopback interface
+	output := `[{"Id": "fakeContainerId", "State": {"Status": "running"}, "NetworkSettings": {"Ports": {"8080/tcp": [{"HostIp": "", "HostPort": "40123"}]}}}]`
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "container", "inspect", "fakeContainerId").Return([]byte(output), nil)
+
+	state, err := p.PodmanClient.InspectContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), map[int]string{8080: "127.0.0.1:40123"}, state.Ports)
+}
+
+func (p *PodmanSuite) TestRunImageWithEnvAndMountsOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	var envFile string
+
+	// The values are passed in a file rather than as arguments
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "--env-file", gomock.Any(), "--volume", "/fakeSource:/fakeTarget:ro", "fakeImageRef").
+		DoAndReturn(func(ctx context.Context, args ...string) ([]byte, error) {
+			envFile = args[4]
+			content, err := os.ReadFile(envFile)
+
+			assert.NoError(p.T(), err)
+			assert.Equal(p.T(), "A=fakeValueA\nB=fakeValueB\n", string(content))
+
+			return []byte("fakeContainerId"), nil
+		})
+
+	options := RunOptions{
+		Env:    map[string]string{"B": "fakeValueB", "A": "fakeValueA"},
+		Mounts: []Mount{{Source: "/fakeSource", Target: "/fakeTarget"}},
+	}
+
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+
+	_, err = os.Stat(envFile)
+
+	assert.ErrorIs(p.T(), err, os.ErrNotExist)
+}
+
+func (p *PodmanSuite) TestRunImageWithMultilineEnvErrors() {
+	_, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Env: map[string]string{"A": "fakeLine\nB=fakeValue"}})
+
+	assert.ErrorContains(p.T(), err, "environment variable A can't span more than one line")
+}
+
+func (p *PodmanSuite) TestListContainersOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "ps", "--all", "--format", "json",
+		"--filter=label=com.lytbeacon.beacon-id=fakeBeaconId",
+		"--filter=status=running",
+	}
+
+	output := `[{"Id": "containerIdA", "Image": "fakeImageRef", "State": "running", "Labels": {"com.lytbeacon.beacon-id": "fakeBeaconId"}}, {"NotAnId": "containerIdB"}]`
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte(output), nil)
+
+	containers, err := p.PodmanClient.ListContainers(context.Background(), map[string]string{LabelBeaconID: "fakeBeaconId"}, []string{"running"})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []Container{{
+		ID:     "containerIdA",
+		Image:  "fakeImageRef",
+		Status: "running",
+		Labels: map[string]string{LabelBeaconID: "fakeBeaconId"},
+	}}, containers)
+}
+
+func (p *PodmanSuite) TestListContainersErrors() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "ps", "--all", "--format", "json").Return([]byte(""), fmt.Errorf("fake error"))
+
+	_, err := p.PodmanClient.ListContainers(context.Background(), nil, nil)
+
+	assert.ErrorContains(p.T(), err, "error listing containers")
+}
+
+func (p *PodmanSuite) TestRunImageWithNetworksOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "run", "--detach",
+		"--network", "beacon",
+		"--network", "fakeNetwork",
+		"--network-alias", "fakerepo.fakenamespace",
+		"fakeImageRef",
+	}
+
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeContainerId"), nil)
+
+	options := RunOptions{Networks: []string{"beacon", "fakeNetwork"}, NetworkAliases: []string{"fakerepo.fakenamespace"}}
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", options)
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestCreateNetworkOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "network", "create", "--ignore", "--label", "com.lytbeacon.beacon-id=fakeBeaconId", "beacon"}
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("beacon"), nil)
+
+	err := p.PodmanClient.CreateNetwork(context.Background(), "beacon", map[string]string{LabelBeaconID: "fakeBeaconId"})
+
+	assert.NoError(p.T(), err)
+}
+
+func (p *PodmanSuite) TestRemoveNetworkErrors() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "network", "rm", "beacon").Return([]byte("network is in use"), fmt.Errorf("exit status 2"))
+
+	err := p.PodmanClient.RemoveNetwork(context.Background(), "beacon")
+
+	assert.ErrorContains(p.T(), err, "error removing network beacon")
+}
+
+func (p *PodmanSuite) TestRunImageWithVolumesOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "--volume", "fakeVolume:/data", "fakeImageRef").Return([]byte("fakeContainerId"), nil)
+
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Volumes: []VolumeMount{{Name: "fakeVolume", Target: "/data"}}})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestCreateVolumeOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	args := []interface{}{"podman", "volume", "create", "--ignore", "--label", "com.lytbeacon.probe=fakeNamespace/fakeRepo", "fakeVolume"}
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), args...).Return([]byte("fakeVolume"), nil)
+
+	err := p.PodmanClient.CreateVolume(context.Background(), "fakeVolume", map[string]string{LabelProbe: "fakeNamespace/fakeRepo"})
+
+	assert.NoError(p.T(), err)
+}
+
+func (p *PodmanSuite) TestListVolumesOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	mountpoint := p.T().TempDir()
+	os.WriteFile(filepath.Join(mountpoint, "bot.sqlite"), make([]byte, 1024), 0600)
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := fmt.Sprintf(`[{"Name": "fakeVolume", "Driver": "local", "Mountpoint": %q, "CreatedAt": "2023-01-02T15:04:05Z", "Labels": {"com.lytbeacon.probe": "fakeNamespace/fakeRepo"}}]`, mountpoint)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "volume", "ls", "--format", "json", "--filter=label=com.lytbeacon.probe=fakeNamespace/fakeRepo").Return([]byte(output), nil)
+
+	volumes, err := p.PodmanClient.ListVolumes(context.Background(), map[string]string{LabelProbe: "fakeNamespace/fakeRepo"})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), []Volume{{
+		Name:       "fakeVolume",
+		Labels:     map[string]string{LabelProbe: "fakeNamespace/fakeRepo"},
+		Mountpoint: mountpoint,
+		CreatedAt:  time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		Size:       1024,
+	}}, volumes)
+}
+
+func (p *PodmanSuite) TestRemoveVolumeMissing() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+
+	output := "Error: no volume with name \"fakeVolume\" found: no such volume"
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "volume", "rm", "fakeVolume").Return([]byte(output), fmt.Errorf("exit status 1"))
+
+	err := p.PodmanClient.RemoveVolume(context.Background(), "fakeVolume")
+
+	assert.ErrorIs(p.T(), err, ErrNoSuchVolume)
+}
+
+func (p *PodmanSuite) TestRunImageWithCommandOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "run", "--detach", "fakeImageRef", "./migrate", "up").Return([]byte("fakeContainerId"), nil)
+
+	containerID, err := p.PodmanClient.RunImage(context.Background(), "fakeImageRef", RunOptions{Command: []string{"./migrate", "up"}})
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "fakeContainerId", containerID)
+}
+
+func (p *PodmanSuite) TestWaitContainerOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "wait", "fakeContainerId").Return([]byte("3\n"), nil)
+
+	exitCode, err := p.PodmanClient.WaitContainer(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), 3, exitCode)
+}
+
+func (p *PodmanSuite) TestWaitContainerInvalidOutput() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "wait", "fakeContainerId").Return([]byte(""), nil)
+
+	_, err := p.PodmanClient.WaitContainer(context.Background(), "fakeContainerId")
+
+	assert.ErrorContains(p.T(), err, "No exit code was returned")
+}
+
+func (p *PodmanSuite) TestContainerLogsOK() {
+	mockController := gomock.NewController(p.T())
+	defer mockController.Finish()
+
+	p.PodmanClient.runner = NewMockRunner(mockController)
+	p.PodmanClient.runner.(*MockRunner).EXPECT().run(gomock.Any(), "podman", "logs", "fakeContainerId").Return([]byte("migrating\ndone\n"), nil)
+
+	logs, err := p.PodmanClient.ContainerLogs(context.Background(), "fakeContainerId")
+
+	assert.NoError(p.T(), err)
+	assert.Equal(p.T(), "migrating\ndone\n", string(logs))
+}
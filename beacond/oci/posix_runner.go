@@ -1,11 +1,15 @@
 package oci
 
-import "os/exec"
+import (
+	"context"
+	"os/exec"
+)
 
 type PosixRunner struct{}
 
-func (p PosixRunner) run(cmds ...string) ([]byte, error) {
-	cmd := exec.Command(cmds[0], cmds[1:]...)
+// run kills the command if ctx is done before it exits
+func (p PosixRunner) run(ctx context.Context, cmds ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, cmds[0], cmds[1:]...)
 
 	return cmd.CombinedOutput()
 }
@@ -1,13 +1,14 @@
 package oci
 
 import (
+	"context"
 	"os/exec"
 )
 
 type PowershellRunner struct{}
 
-func (p PowershellRunner) run(cmds ...string) ([]byte, error) {
-	cmd := exec.Command("powershell", cmds...)
+func (p PowershellRunner) run(ctx context.Context, cmds ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "powershell", cmds...)
 
 	return cmd.CombinedOutput()
 }
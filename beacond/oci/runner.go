@@ -1,6 +1,8 @@
 //go:generate mockgen -source $GOFILE -package oci -destination ./runner_mock.go Runner
 package oci
 
+import "context"
+
 type Runner interface {
-	run(cmds ...string) ([]byte, error)
+	run(ctx context.Context, cmds ...string) ([]byte, error)
 }
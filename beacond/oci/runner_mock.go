@@ -5,6 +5,7 @@
 package oci
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,9 +35,9 @@ func (m *MockRunner) EXPECT() *MockRunnerMockRecorder {
 }
 
 // run mocks base method.
-func (m *MockRunner) run(cmds ...string) ([]byte, error) {
+func (m *MockRunner) run(ctx context.Context, cmds ...string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{}
+	varargs := []interface{}{ctx}
 	for _, a := range cmds {
 		varargs = append(varargs, a)
 	}
@@ -47,7 +48,8 @@ func (m *MockRunner) run(cmds ...string) ([]byte, error) {
 }
 
 // run indicates an expected call of run.
-func (mr *MockRunnerMockRecorder) run(cmds ...interface{}) *gomock.Call {
+func (mr *MockRunnerMockRecorder) run(ctx interface{}, cmds ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "run", reflect.TypeOf((*MockRunner)(nil).run), cmds...)
+	varargs := append([]interface{}{ctx}, cmds...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "run", reflect.TypeOf((*MockRunner)(nil).run), varargs...)
 }
@@ -0,0 +1,264 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// Route sends the requests for a hostname and/or path prefix to a port of a probe's containers. Leaving out the
+// hostname matches any hostname, and leaving out the path prefix matches any path
+type Route struct {
+	Host       string `json:"host,omitempty"`
+	PathPrefix string `json:"path_prefix,omitempty"`
+	// The container port requests are sent to
+	Port int `json:"port"`
+	// If set, new containers only receive traffic once a GET of this path returns a 2xx or 3xx response
+	HealthPath string `json:"health_path,omitempty"`
+}
+
+// DefaultAddr is where the proxy listens for HTTP if it is started for a route without being given an address
+const DefaultAddr = ":8080"
+
+// ErrRouteConflict is returned when a route's hostname and path prefix are already used by another route
+var ErrRouteConflict = errors.New("route is already in use")
+
+// Proxy is a reverse proxy that sends each request to one of the backends of the route that matches it most closely.
+// Requests are spread across a route's backends in turn
+type Proxy struct {
+	mu     sync.RWMutex
+	routes map[string]*route
+	// Closed once the first route is added
+	routed     chan struct{}
+	routedOnce sync.Once
+}
+
+type route struct {
+	Route
+	backends []string
+	next     atomic.Uint64
+}
+
+func New() *Proxy {
+	return &Proxy{routes: make(map[string]*route), routed: make(chan struct{})}
+}
+
+// Add registers the route under name, without any backends until SetBackends is called
+func (p *Proxy) Add(name string, r Route) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for other, existing := range p.routes {
+		if other != name && strings.EqualFold(existing.Host, r.Host) && existing.PathPrefix == r.PathPrefix {
+			return fmt.Errorf("%w by %s", ErrRouteConflict, other)
+		}
+	}
+
+	p.routes[name] = &route{Route: r}
+	p.routedOnce.Do(func() { close(p.routed) })
+
+	return nil
+}
+
+// Routed returns a channel that is closed once a route has been added, even if it has since been removed
+func (p *Proxy) Routed() <-chan struct{} {
+	return p.routed
+}
+
+// Remove stops routing requests for the route registered under name
+func (p *Proxy) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.routes, name)
+}
+
+// SetBackends replaces the addresses (host:port) requests for the route registered under name are sent to. Requests
+// already being proxied to a removed backend are left to finish
+func (p *Proxy) SetBackends(name string, backends []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if r, ok := p.routes[name]; ok {
+		r.backends = append([]string(nil), backends...)
+	}
+}
+
+// Backends returns the addresses the route registered under name currently sends requests to
+func (p *Proxy) Backends(name string) []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if r, ok := p.routes[name]; ok {
+		return append([]string(nil), r.backends...)
+	}
+
+	return nil
+}
+
+// match finds the route for the request. Routes for the request's hostname are preferred over routes for any
+// hostname, and then longer path prefixes over shorter ones
+func (p *Proxy) match(req *http.Request) *route {
+	host := req.Host
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	var best *route
+
+	for _, r := range p.routes {
+		if r.Host != "" && !strings.EqualFold(r.Host, host) {
+			continue
+		}
+
+		if !matchesPrefix(req.URL.Path, r.PathPrefix) {
+			continue
+		}
+
+		if best == nil || moreSpecific(r, best) {
+			best = r
+		}
+	}
+
+	return best
+}
+
+// matchesPrefix reports whether the path is under the prefix. The prefix only matches whole path segments, so that
+// /api matches /api and /api/users but not /apiv2
+func matchesPrefix(path string, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+
+	return len(path) == len(prefix) || strings.HasSuffix(prefix, "/") || path[len(prefix)] == '/'
+}
+
+// moreSpecific reports whether route a should be preferred over route b when they both match a request
+func moreSpecific(a *route, b *route) bool {
+	if (a.Host != "") != (b.Host != "") {
+		return a.Host != ""
+	}
+
+	return len(a.PathPrefix) > len(b.PathPrefix)
+}
+
+// backend picks the address to send the request to, or "" if there is no route for it or its route has no backends
+func (p *Proxy) backend(req *http.Request) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	r := p.match(req)
+
+	if r == nil {
+		return "", false
+	}
+
+	if len(r.backends) == 0 {
+		return "", true
+	}
+
+	return r.backends[r.next.Add(1)%uint64(len(r.backends))], true
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	backend, routed := p.backend(req)
+
+	if !routed {
+		http.Error(w, "no route for this host and path", http.StatusNotFound)
+		return
+	}
+
+	if backend == "" {
+		http.Error(w, "no containers are ready to serve this route", http.StatusServiceUnavailable)
+		return
+	}
+
+	reverseProxy := &httputil.ReverseProxy{
+		Director: func(out *http.Request) {
+			out.URL.Scheme = "http"
+			out.URL.Host = backend
+		},
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			log.Errorf("error proxying %s%s to %s: %s", req.Host, req.URL.Path, backend, err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	reverseProxy.ServeHTTP(w, req)
+}
+
+// Listen configures where Serve accepts requests. HTTP is only served if an address is given, and TLS if a
+// certificate and key are
+type Listen struct {
+	Addr    string
+	TLSAddr string
+	TLSCert string
+	TLSKey  string
+}
+
+// ServesTLS reports whether a certificate and key were given to serve HTTPS with
+func (l Listen) ServesTLS() bool {
+	return l.TLSCert != "" && l.TLSKey != ""
+}
+
+// Serve runs the proxy until ctx is cancelled. It then stops accepting requests, and waits until the deadline it
+// gets from shutdownDeadline for the requests in flight to finish
+func (p *Proxy) Serve(ctx context.Context, listen Listen, shutdownDeadline func() time.Time) error {
+	var servers []*http.Server
+	var serveTLS []bool
+
+	if listen.Addr != "" {
+		servers = append(servers, &http.Server{Addr: listen.Addr, Handler: p})
+		serveTLS = append(serveTLS, false)
+	}
+
+	if listen.ServesTLS() {
+		servers = append(servers, &http.Server{Addr: listen.TLSAddr, Handler: p})
+		serveTLS = append(serveTLS, true)
+	}
+
+	errs := make(chan error, len(servers))
+
+	for i, server := range servers {
+		go func(server *http.Server, tls bool) {
+			var err error
+
+			if tls {
+				err = server.ListenAndServeTLS(listen.TLSCert, listen.TLSKey)
+			} else {
+				err = server.ListenAndServe()
+			}
+
+			errs <- err
+		}(server, serveTLS[i])
+	}
+
+	var err error
+
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+		err = fmt.Errorf("error serving proxy: %s", err)
+	}
+
+	shutdownCtx, cancel := context.WithDeadline(context.Background(), shutdownDeadline())
+	defer cancel()
+
+	for _, server := range servers {
+		if shutdownErr := server.Shutdown(shutdownCtx); shutdownErr != nil {
+			log.Errorf("error shutting down proxy: %s", shutdownErr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,169 @@
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProxySuite struct {
+	suite.Suite
+	Proxy *Proxy
+}
+
+func TestProxySuite(t *testing.T) {
+	suite.Run(t, new(ProxySuite))
+}
+
+func (p *ProxySuite) SetupTest() {
+	p.Proxy = New()
+}
+
+// backend starts a server that responds with its name, returning its address
+func (p *ProxySuite) backend(name string) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", name, r.URL.Path)
+	}))
+
+	p.T().Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+
+	return u.Host
+}
+
+func (p *ProxySuite) get(host string, path string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Host = host
+
+	recorder := httptest.NewRecorder()
+	p.Proxy.ServeHTTP(recorder, req)
+
+	body, _ := io.ReadAll(recorder.Body)
+
+	return recorder.Code, string(body)
+}
+
+func (p *ProxySuite) TestRoutesByHostAndPath() {
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/site", Route{Host: "example.com", Port: 80}))
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/api", Route{Host: "example.com", PathPrefix: "/api", Port: 80}))
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fallback", Route{Port: 80}))
+
+	p.Proxy.SetBackends("fakeNamespace/site", []string{p.backend("site")})
+	p.Proxy.SetBackends("fakeNamespace/api", []string{p.backend("api")})
+	p.Proxy.SetBackends("fakeNamespace/fallback", []string{p.backend("fallback")})
+
+	_, body := p.get("example.com", "/index.html")
+	assert.Equal(p.T(), "site /index.html", body)
+
+	_, body = p.get("EXAMPLE.com:8080", "/api/users")
+	assert.Equal(p.T(), "api /api/users", body)
+
+	_, body = p.get("other.com", "/api/users")
+	assert.Equal(p.T(), "fallback /api/users", body)
+}
+
+func (p *ProxySuite) TestPathPrefixMatchesWholeSegments() {
+	tests := []struct {
+		path     string
+		prefix   string
+		expected bool
+	}{
+		{"/api", "/api", true},
+		{"/api/", "/api", true},
+		{"/api/users", "/api", true},
+		{"/apiv2", "/api", false},
+		{"/apiv2/users", "/api", false},
+		{"/ap", "/api", false},
+		{"/api/users", "/api/", true},
+		{"/api", "/api/", false},
+		{"/anything", "", true},
+		{"/anything", "/", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(p.T(), test.expected, matchesPrefix(test.path, test.prefix), fmt.Sprintf("%s under %q", test.path, test.prefix))
+	}
+
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/api", Route{PathPrefix: "/api", Port: 80}))
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/site", Route{Port: 80}))
+
+	p.Proxy.SetBackends("fakeNamespace/api", []string{p.backend("api")})
+	p.Proxy.SetBackends("fakeNamespace/site", []string{p.backend("site")})
+
+	_, body := p.get("example.com", "/apiv2")
+	assert.Equal(p.T(), "site /apiv2", body)
+
+	_, body = p.get("example.com", "/api")
+	assert.Equal(p.T(), "api /api", body)
+}
+
+func (p *ProxySuite) TestSpreadsRequestsAcrossBackends() {
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeRepo", Route{Host: "example.com", Port: 80}))
+	p.Proxy.SetBackends("fakeNamespace/fakeRepo", []string{p.backend("a"), p.backend("b")})
+
+	_, first := p.get("example.com", "/")
+	_, second := p.get("example.com", "/")
+
+	assert.ElementsMatch(p.T(), []string{"a /", "b /"}, []string{first, second})
+}
+
+func (p *ProxySuite) TestSwitchesBackends() {
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeRepo", Route{Host: "example.com", Port: 80}))
+
+	p.Proxy.SetBackends("fakeNamespace/fakeRepo", []string{p.backend("old")})
+	_, body := p.get("example.com", "/")
+	assert.Equal(p.T(), "old /", body)
+
+	p.Proxy.SetBackends("fakeNamespace/fakeRepo", []string{p.backend("new")})
+	_, body = p.get("example.com", "/")
+	assert.Equal(p.T(), "new /", body)
+}
+
+func (p *ProxySuite) TestNoRouteOrBackends() {
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeRepo", Route{Host: "example.com", Port: 80}))
+
+	code, _ := p.get("example.com", "/")
+	assert.Equal(p.T(), http.StatusServiceUnavailable, code)
+
+	code, _ = p.get("other.com", "/")
+	assert.Equal(p.T(), http.StatusNotFound, code)
+
+	p.Proxy.Remove("fakeNamespace/fakeRepo")
+
+	code, _ = p.get("example.com", "/")
+	assert.Equal(p.T(), http.StatusNotFound, code)
+}
+
+func (p *ProxySuite) TestRouted() {
+	select {
+	case <-p.Proxy.Routed():
+		p.T().Fatal("routed without any routes")
+	default:
+	}
+
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeRepo", Route{Port: 80}))
+
+	p.Proxy.Remove("fakeNamespace/fakeRepo")
+
+	select {
+	case <-p.Proxy.Routed():
+	default:
+		p.T().Fatal("not routed after a route was added")
+	}
+}
+
+func (p *ProxySuite) TestConflictingRoutes() {
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeRepo", Route{Host: "example.com", PathPrefix: "/api", Port: 80}))
+
+	err := p.Proxy.Add("fakeNamespace/fakeOtherRepo", Route{Host: "Example.com", PathPrefix: "/api", Port: 8080})
+
+	assert.ErrorIs(p.T(), err, ErrRouteConflict)
+	assert.NoError(p.T(), p.Proxy.Add("fakeNamespace/fakeOtherRepo", Route{Host: "example.com", PathPrefix: "/web", Port: 8080}))
+}
@@ -0,0 +1,242 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// The host Docker Hub's credentials are kept under. Docker itself stores them under dockerHubServerURL
+const dockerHubHost = "docker.io"
+
+// The server URL docker login uses for Docker Hub, which credential helpers expect to be asked for
+const dockerHubServerURL = "https://index.docker.io/v1/"
+
+// The username credential helpers return when the secret is an identity token rather than a password
+const identityTokenUsername = "<token>"
+
+// Credentials log in to a registry
+type Credentials struct {
+	// The registry host the credentials are for, such as docker.io
+	ServerAddress string
+	Username      string
+	Password      string
+	// A token that some registries accept in place of a password, exchanged for registry tokens as an OAuth2
+	// refresh token
+	IdentityToken string
+}
+
+// Keychain looks up registry credentials in the auth files written by `podman login` and `docker login`, and in the
+// credential helpers (docker-credential-*) those files point to. The files are read on every lookup, so logging in
+// again doesn't need beacond to be restarted
+type Keychain struct {
+	// The auth files to look in, in order of preference
+	Files []string
+	// helper runs the credential helper for the server, returning what it printed
+	helper func(ctx context.Context, name string, serverURL string) ([]byte, error)
+}
+
+// The auth.json and config.json formats, which share the parts beacon needs
+type authFile struct {
+	Auths map[string]struct {
+		Auth          string `json:"auth"`
+		IdentityToken string `json:"identitytoken"`
+	} `json:"auths"`
+	CredsStore  string            `json:"credsStore"`
+	CredHelpers map[string]string `json:"credHelpers"`
+}
+
+// NewKeychain creates a keychain reading the given auth files or, if there are none, the files podman and docker
+// read by default
+func NewKeychain(files ...string) *Keychain {
+	if len(files) == 0 {
+		files = DefaultAuthFiles()
+	}
+
+	return &Keychain{Files: files, helper: runCredentialHelper}
+}
+
+// DefaultAuthFiles are the files podman and docker keep registry credentials in, in the order podman looks at them
+func DefaultAuthFiles() []string {
+	var files []string
+
+	if path := os.Getenv("REGISTRY_AUTH_FILE"); path != "" {
+		files = append(files, path)
+	}
+
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		files = append(files, filepath.Join(dir, "containers", "auth.json"))
+	}
+
+	home, _ := os.UserHomeDir()
+
+	if configDir, err := os.UserConfigDir(); err == nil {
+		files = append(files, filepath.Join(configDir, "containers", "auth.json"))
+	}
+
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		files = append(files, filepath.Join(dir, "config.json"))
+	} else if home != "" {
+		files = append(files, filepath.Join(home, ".docker", "config.json"))
+	}
+
+	return files
+}
+
+// Lookup returns the credentials for the registry host, or nil if there are none and requests should be anonymous.
+// The first file that has credentials for the host wins, whether they come from the file itself or from a helper
+func (k *Keychain) Lookup(ctx context.Context, host string) (*Credentials, error) {
+	if k == nil {
+		return nil, nil
+	}
+
+	host = normaliseHost(host)
+
+	for _, path := range k.Files {
+		contents, err := os.ReadFile(path)
+
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading auth file %s: %s", path, err)
+		}
+
+		var file authFile
+
+		if err := json.Unmarshal(contents, &file); err != nil {
+			return nil, fmt.Errorf("error unmarshalling auth file %s: %s", path, err)
+		}
+
+		credentials, err := k.lookupFile(ctx, file, host)
+
+		if err != nil {
+			return nil, fmt.Errorf("error looking up credentials for %s in %s: %s", host, path, err)
+		}
+
+		if credentials != nil {
+			return credentials, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (k *Keychain) lookupFile(ctx context.Context, file authFile, host string) (*Credentials, error) {
+	// Helpers configured for the host take precedence over anything stored in the file, as they do for docker
+	for server, helper := range file.CredHelpers {
+		if normaliseHost(server) == host {
+			return k.lookupHelper(ctx, helper, host)
+		}
+	}
+
+	for server, auth := range file.Auths {
+		if normaliseHost(server) != host {
+			continue
+		}
+
+		credentials := &Credentials{ServerAddress: host, IdentityToken: auth.IdentityToken}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+
+			if err != nil {
+				return nil, fmt.Errorf("error decoding auth: %s", err)
+			}
+
+			username, password, ok := strings.Cut(string(decoded), ":")
+
+			if !ok {
+				return nil, fmt.Errorf("auth is not in the form username:password")
+			}
+
+			credentials.Username, credentials.Password = username, password
+		}
+
+		// docker login with a credential store leaves an empty entry behind, the credentials being in the store
+		if credentials.Username != "" || credentials.IdentityToken != "" {
+			return credentials, nil
+		}
+	}
+
+	if file.CredsStore != "" {
+		return k.lookupHelper(ctx, file.CredsStore, host)
+	}
+
+	return nil, nil
+}
+
+func (k *Keychain) lookupHelper(ctx context.Context, helper string, host string) (*Credentials, error) {
+	serverURL := host
+
+	if host == dockerHubHost {
+		serverURL = dockerHubServerURL
+	}
+
+	output, err := k.helper(ctx, helper, serverURL)
+
+	if err != nil {
+		// Helpers report credentials they don't have as an error
+		if strings.Contains(string(output), "credentials not found") {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error running docker-credential-%s. Output was: %s; Error was: %s", helper, strings.TrimSpace(string(output)), err)
+	}
+
+	var response struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshalling the output of docker-credential-%s: %s", helper, err)
+	}
+
+	if response.Username == identityTokenUsername {
+		return &Credentials{ServerAddress: host, IdentityToken: response.Secret}, nil
+	}
+
+	return &Credentials{ServerAddress: host, Username: response.Username, Password: response.Secret}, nil
+}
+
+// runCredentialHelper runs `docker-credential-<name> get`, which reads the server URL from stdin
+func runCredentialHelper(ctx context.Context, name string, serverURL string) ([]byte, error) {
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "docker-credential-"+name, "get")
+	cmd.Stdin = strings.NewReader(serverURL)
+	cmd.Stdout = &stdout
+
+	err := cmd.Run()
+
+	return stdout.Bytes(), err
+}
+
+// normaliseHost reduces the keys used in auth files (which may be URLs, or one of Docker Hub's several hostnames)
+// to a host that can be compared
+func normaliseHost(server string) string {
+	host := server
+
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		host = u.Host
+	} else {
+		host, _, _ = strings.Cut(server, "/")
+	}
+
+	switch host = strings.ToLower(host); host {
+	case "index.docker.io", "registry-1.docker.io", "registry.hub.docker.com", "hub.docker.com":
+		return dockerHubHost
+	}
+
+	return host
+}
@@ -0,0 +1,212 @@
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CredentialsSuite struct {
+	suite.Suite
+	Dir string
+}
+
+func TestCredentialsSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsSuite))
+}
+
+func (c *CredentialsSuite) SetupTest() {
+	c.Dir = c.T().TempDir()
+}
+
+// authFile writes an auth file with the given content, returning its path
+func (c *CredentialsSuite) authFile(name string, content string) string {
+	path := filepath.Join(c.Dir, name)
+
+	c.Require().NoError(os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+// keychain creates a keychain for the files whose helpers answer from the credentials given, by server URL
+func (c *CredentialsSuite) keychain(helperCredentials map[string]string, files ...string) *Keychain {
+	return &Keychain{
+		Files: files,
+		helper: func(ctx context.Context, name string, serverURL string) ([]byte, error) {
+			if credentials, ok := helperCredentials[name+" "+serverURL]; ok {
+				return []byte(credentials), nil
+			}
+
+			return []byte("credentials not found in native keychain"), fmt.Errorf("exit status 1")
+		},
+	}
+}
+
+func basicAuth(username string, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+}
+
+func (c *CredentialsSuite) TestLookupFromAuthFile() {
+	path := c.authFile("config.json", fmt.Sprintf(`{"auths": {"https://index.docker.io/v1/": {"auth": %q}, "ghcr.io": {"identitytoken": "fakeToken"}}}`, basicAuth("fakeUser", "fake:Password")))
+	keychain := c.keychain(nil, path)
+
+	credentials, err := keychain.Lookup(context.Background(), "registry-1.docker.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), &Credentials{ServerAddress: "docker.io", Username: "fakeUser", Password: "fake:Password"}, credentials)
+
+	credentials, err = keychain.Lookup(context.Background(), "https://ghcr.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), &Credentials{ServerAddress: "ghcr.io", IdentityToken: "fakeToken"}, credentials)
+
+	credentials, err = keychain.Lookup(context.Background(), "quay.io")
+
+	assert.NoError(c.T(), err)
+	assert.Nil(c.T(), credentials)
+}
+
+func (c *CredentialsSuite) TestLookupPrefersEarlierFiles() {
+	podman := c.authFile("auth.json", fmt.Sprintf(`{"auths": {"docker.io": {"auth": %q}}}`, basicAuth("podmanUser", "fakePassword")))
+	docker := c.authFile("config.json", fmt.Sprintf(`{"auths": {"docker.io": {"auth": %q}, "ghcr.io": {"auth": %q}}}`, basicAuth("dockerUser", "fakePassword"), basicAuth("ghcrUser", "fakePassword")))
+	keychain := c.keychain(nil, filepath.Join(c.Dir, "missing.json"), podman, docker)
+
+	credentials, err := keychain.Lookup(context.Background(), "docker.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), "podmanUser", credentials.Username)
+
+	credentials, err = keychain.Lookup(context.Background(), "ghcr.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), "ghcrUser", credentials.Username)
+}
+
+func (c *CredentialsSuite) TestLookupFromCredentialHelpers() {
+	// docker login leaves an empty entry in auths when the credentials are kept in the store
+	path := c.authFile("config.json", `{"auths": {"https://index.docker.io/v1/": {}}, "credsStore": "desktop", "credHelpers": {"ghcr.io": "gh"}}`)
+	keychain := c.keychain(map[string]string{
+		"desktop https://index.docker.io/v1/": `{"ServerURL": "https://index.docker.io/v1/", "Username": "fakeUser", "Secret": "fakePassword"}`,
+		"gh ghcr.io":                          `{"ServerURL": "ghcr.io", "Username": "<token>", "Secret": "fakeToken"}`,
+	}, path)
+
+	credentials, err := keychain.Lookup(context.Background(), "docker.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), &Credentials{ServerAddress: "docker.io", Username: "fakeUser", Password: "fakePassword"}, credentials)
+
+	credentials, err = keychain.Lookup(context.Background(), "ghcr.io")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), &Credentials{ServerAddress: "ghcr.io", IdentityToken: "fakeToken"}, credentials)
+
+	credentials, err = keychain.Lookup(context.Background(), "quay.io")
+
+	assert.NoError(c.T(), err)
+	assert.Nil(c.T(), credentials)
+}
+
+func (c *CredentialsSuite) TestLookupHelperErrors() {
+	path := c.authFile("config.json", `{"credsStore": "broken"}`)
+	keychain := &Keychain{
+		Files: []string{path},
+		helper: func(ctx context.Context, name string, serverURL string) ([]byte, error) {
+			return []byte("fake output"), fmt.Errorf("fake error")
+		},
+	}
+
+	_, err := keychain.Lookup(context.Background(), "docker.io")
+
+	assert.ErrorContains(c.T(), err, "error running docker-credential-broken")
+}
+
+func (c *CredentialsSuite) TestLookupWithoutKeychain() {
+	var keychain *Keychain
+
+	credentials, err := keychain.Lookup(context.Background(), "docker.io")
+
+	assert.NoError(c.T(), err)
+	assert.Nil(c.T(), credentials)
+}
+
+func (c *CredentialsSuite) TestDistributionClientAuthenticatesWithCredentials() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if username, password, ok := r.BasicAuth(); !ok || username != "fakeUser" || password != "fakePassword" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			json.NewEncoder(w).Encode(map[string]string{"token": "fakeToken"})
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer fakeToken" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="fake"`, r.Host))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Write([]byte(`{"schemaVersion": 2}`))
+	}))
+	defer server.Close()
+
+	path := c.authFile("auth.json", fmt.Sprintf(`{"auths": {%q: {"auth": %q}}}`, server.Listener.Addr().String(), basicAuth("fakeUser", "fakePassword")))
+	client := NewDistributionClient(server.URL, c.keychain(nil, path))
+
+	manifest, err := client.Manifest(context.Background(), "namespace/repo", "latest")
+
+	assert.NoError(c.T(), err)
+	assert.Equal(c.T(), 2, manifest.SchemaVersion)
+}
+
+func (c *CredentialsSuite) TestDockerRegistryLogsIn() {
+	logins := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/users/login" {
+			var body map[string]string
+
+			json.NewDecoder(r.Body).Decode(&body)
+
+			if body["username"] != "fakeUser" || body["password"] != "fakePassword" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			logins++
+			json.NewEncoder(w).Encode(map[string]string{"token": fmt.Sprintf("fakeToken%d", logins)})
+			return
+		}
+
+		// The first token has expired, so only the second one is accepted
+		if r.Header.Get("Authorization") != "Bearer fakeToken2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message": "unauthorized"}`))
+			return
+		}
+
+		w.Write([]byte(`{"count": 1, "results": []}`))
+	}))
+	defer server.Close()
+
+	path := c.authFile("config.json", fmt.Sprintf(`{"auths": {"docker.io": {"auth": %q}}}`, basicAuth("fakeUser", "fakePassword")))
+	registry, err := NewDockerRegistry(server.URL, c.keychain(nil, path))
+
+	assert.NoError(c.T(), err)
+	assert.NoError(c.T(), registry.TestRepo(context.Background(), "namespace", "repo"))
+	assert.Equal(c.T(), 2, logins)
+
+	// The token is kept for later requests
+	assert.NoError(c.T(), registry.TestRepo(context.Background(), "namespace", "repo"))
+	assert.Equal(c.T(), 2, logins)
+}
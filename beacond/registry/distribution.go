@@ -0,0 +1,310 @@
+package registry
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Media types accepted when fetching manifests, in order of preference
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// DistributionClient talks to the OCI distribution API of a registry (as opposed to the Docker Hub API used by
+// DockerRegistry). This is where manifests, blobs and artifacts such as image signatures are stored.
+// See https://github.com/opencontainers/distribution-spec/blob/main/spec.md
+type DistributionClient struct {
+	URL      string
+	client   *http.Client
+	keychain *Keychain
+	mu       sync.Mutex
+	// The Authorization header to send for each repository, once a request for it has been challenged
+	authorizations map[string]string
+}
+
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// NewDistributionClient creates a client for the registry at registryURL. Requests are anonymous unless the keychain
+// has credentials for the registry's host
+func NewDistributionClient(registryURL string, keychain *Keychain) *DistributionClient {
+	if registryURL == "" {
+		registryURL = "https://registry-1.docker.io"
+	}
+
+	return &DistributionClient{
+		URL:            strings.TrimSuffix(registryURL, "/"),
+		client:         &http.Client{Timeout: requestTimeout},
+		keychain:       keychain,
+		authorizations: make(map[string]string),
+	}
+}
+
+// Manifest fetches the manifest for the reference (a tag or a digest) in the repository
+func (d *DistributionClient) Manifest(ctx context.Context, repository string, reference string) (Manifest, error) {
+	var manifest Manifest
+
+	endpoint := fmt.Sprintf("%s/v2/%s/manifests/%s", d.URL, repository, reference)
+	body, err := d.get(ctx, endpoint, repository, strings.Join(manifestMediaTypes, ", "))
+
+	if err != nil {
+		return manifest, err
+	}
+
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return manifest, GeneralServerError{fmt.Errorf("error unmarshalling manifest %s for %s: %s", reference, repository, err)}
+	}
+
+	return manifest, nil
+}
+
+// Blob fetches the content of the blob with the given digest in the repository
+func (d *DistributionClient) Blob(ctx context.Context, repository string, digest string) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/v2/%s/blobs/%s", d.URL, repository, digest)
+
+	return d.get(ctx, endpoint, repository, "")
+}
+
+func (d *DistributionClient) get(ctx context.Context, endpoint string, repository string, accept string) ([]byte, error) {
+	resp, err := d.do(ctx, endpoint, repository, accept)
+
+	if err != nil {
+		return nil, GeneralServerError{fmt.Errorf("error fetching %s: %s", endpoint, err)}
+	}
+
+	defer resp.Body.Close()
+
+	// Registries require a bearer token even for anonymous pulls - the challenge tells us where to get one
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+
+		if err := d.authenticate(ctx, repository, challenge); err != nil {
+			return nil, GeneralClientError{fmt.Errorf("error authenticating against %s: %s", d.URL, err)}
+		}
+
+		resp.Body.Close()
+		resp, err = d.do(ctx, endpoint, repository, accept)
+
+		if err != nil {
+			return nil, GeneralServerError{fmt.Errorf("error fetching %s: %s", endpoint, err)}
+		}
+
+		defer resp.Body.Close()
+	}
+
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, GeneralServerError{fmt.Errorf("error reading response body from %s: %s", endpoint, err)}
+	}
+
+	switch sc := resp.StatusCode; {
+	case sc == http.StatusNotFound:
+		return nil, NotFoundError{fmt.Errorf("%s was not found", endpoint)}
+	case sc >= 400 && sc <= 499:
+		return nil, GeneralClientError{fmt.Errorf("client error fetching %s: status %d", endpoint, sc)}
+	case sc >= 500 && sc <= 599:
+		return nil, GeneralServerError{fmt.Errorf("server error fetching %s: status %d", endpoint, sc)}
+	}
+
+	return body, nil
+}
+
+func (d *DistributionClient) do(ctx context.Context, endpoint string, repository string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	d.mu.Lock()
+	authorization, ok := d.authorizations[repository]
+	d.mu.Unlock()
+
+	if ok {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return d.client.Do(req)
+}
+
+// authenticate answers the registry's challenge for the repository. Registries that ask for basic authentication get
+// the keychain's credentials directly, while for the others a bearer token for pulling from the repository is
+// fetched, following the token authentication flow described in
+// https://distribution.github.io/distribution/spec/auth/token/. The token is anonymous if there are no credentials
+func (d *DistributionClient) authenticate(ctx context.Context, repository string, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	credentials, err := d.keychain.Lookup(ctx, d.URL)
+
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(scheme, "basic") {
+		if credentials == nil || credentials.Username == "" {
+			return fmt.Errorf("the registry needs a username and password, but there are none for %s", d.URL)
+		}
+
+		d.setAuthorization(repository, "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials.Username+":"+credentials.Password)))
+
+		return nil
+	}
+
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return fmt.Errorf("unsupported authentication challenge: %q", challenge)
+	}
+
+	query := url.Values{}
+
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+
+	scope := params["scope"]
+
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", repository)
+	}
+
+	query.Set("scope", scope)
+
+	req, err := d.tokenRequest(ctx, params["realm"], query, credentials)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("token endpoint %s returned status %d", params["realm"], resp.StatusCode)
+	}
+
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return fmt.Errorf("error unmarshalling token response: %s", err)
+	}
+
+	token := tokenResponse.Token
+
+	if token == "" {
+		token = tokenResponse.AccessToken
+	}
+
+	d.setAuthorization(repository, "Bearer "+token)
+
+	return nil
+}
+
+// tokenRequest creates the request for a bearer token. Identity tokens are exchanged with an OAuth2 refresh token
+// grant, while usernames and passwords are sent with basic authentication
+func (d *DistributionClient) tokenRequest(ctx context.Context, realm string, query url.Values, credentials *Credentials) (*http.Request, error) {
+	if credentials != nil && credentials.IdentityToken != "" {
+		form := url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {credentials.IdentityToken},
+			"client_id":     {"beacon"},
+			"service":       {query.Get("service")},
+			"scope":         {query.Get("scope")},
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, realm, strings.NewReader(form.Encode()))
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return req, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", realm, query.Encode()), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if credentials != nil && credentials.Username != "" {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	return req, nil
+}
+
+func (d *DistributionClient) setAuthorization(repository string, authorization string) {
+	d.mu.Lock()
+	d.authorizations[repository] = authorization
+	d.mu.Unlock()
+}
+
+// parseChallenge parses a WWW-Authenticate header such as:
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/httpd:pull"
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+
+	for rest != "" {
+		var pair string
+		rest = strings.TrimLeft(rest, " ,")
+
+		key, value, ok := strings.Cut(rest, "=")
+
+		if !ok {
+			break
+		}
+
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+
+			if end < 0 {
+				break
+			}
+
+			pair, rest = value[1:end+1], value[end+2:]
+		} else {
+			pair, rest, _ = strings.Cut(value, ",")
+		}
+
+		params[strings.ToLower(strings.TrimSpace(key))] = pair
+	}
+
+	return scheme, params
+}
@@ -1,19 +1,31 @@
 package registry
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 )
 
 type DockerRegistry struct {
-	HubURL string
+	HubURL   string
+	client   *http.Client
+	keychain *Keychain
+	mu       sync.Mutex
+	// The JWT returned by logging in to Docker Hub, if there are credentials for it
+	token string
+	// The rate limit reported with the latest response from Docker Hub
+	rateLimit RateLimit
 }
 
 type TagFilter interface {
 	latestImageDigest() (Image, error)
+	tagName() string
 }
 
 type Tag struct {
@@ -43,13 +55,17 @@ type Layer struct {
 	Instruction string `json:"instruction"`
 }
 
-func NewDockerRegistry(hubURL string) (Registry, error) {
+// NewDockerRegistry creates a client for the Docker Hub API at hubURL. Requests are anonymous unless the keychain has
+// credentials for Docker Hub
+func NewDockerRegistry(hubURL string, keychain *Keychain) (Registry, error) {
 	if hubURL == "" {
 		hubURL = "https://hub.docker.com"
 	}
 
 	return &DockerRegistry{
-		HubURL: hubURL,
+		HubURL:   hubURL,
+		client:   &http.Client{Timeout: requestTimeout},
+		keychain: keychain,
 	}, nil
 }
 
@@ -57,20 +73,26 @@ func (d *DockerRegistry) URL() string {
 	return d.HubURL
 }
 
-func (d *DockerRegistry) LatestImageDigest(namespace string, repo string) (string, error) {
-	latestTag, err := d.latestTag(namespace, repo)
+// Credentials returns the credentials images are pulled from Docker Hub with, or nil to pull anonymously
+func (d *DockerRegistry) Credentials(ctx context.Context) (*Credentials, error) {
+	return d.keychain.Lookup(ctx, dockerHubHost)
+}
+
+// LatestImage returns the most recently pushed image of the most recently pushed tag in the repo
+func (d *DockerRegistry) LatestImage(ctx context.Context, namespace string, repo string) (ImageDetails, error) {
+	latestTag, err := d.latestTag(ctx, namespace, repo)
 
 	if err != nil {
-		return "", err
+		return ImageDetails{}, err
 	}
 
 	image, err := latestTag.latestImageDigest()
 
 	if err != nil {
-		return "", err
+		return ImageDetails{}, err
 	}
 
-	return image.Digest, nil
+	return ImageDetails{Digest: image.Digest, Tag: latestTag.tagName(), Pushed: image.LastPushed}, nil
 }
 
 func filterLatestTag(tags []Tag) Tag {
@@ -92,8 +114,9 @@ func filterLatestTag(tags []Tag) Tag {
 	return currentTag
 }
 
-func (d *DockerRegistry) TestRepo(namespace string, repo string) error {
-	// Pinging tags URL since that does not need authentication to access
+func (d *DockerRegistry) TestRepo(ctx context.Context, namespace string, repo string) error {
+	// Pinging tags URL since that does not need authentication to access for public repos. Private repos need the
+	// credentials for Docker Hub
 	manifestPath := fmt.Sprintf("v2/namespaces/%s/repositories/%s/tags", namespace, repo)
 	endpoint := fmt.Sprintf("%s/%s", d.HubURL, manifestPath)
 
@@ -102,10 +125,10 @@ func (d *DockerRegistry) TestRepo(namespace string, repo string) error {
 		Message string `json:"message"`
 	}
 
-	resp, err := http.Get(endpoint)
+	resp, err := d.get(ctx, endpoint)
 
 	if err != nil {
-		return GeneralServerError(fmt.Errorf("error getting images summary from %s: %s", endpoint, err))
+		return GeneralServerError{fmt.Errorf("error getting images summary from %s: %s", endpoint, err)}
 	}
 
 	defer resp.Body.Close()
@@ -113,25 +136,74 @@ func (d *DockerRegistry) TestRepo(namespace string, repo string) error {
 	body, err := io.ReadAll(resp.Body)
 
 	if err != nil {
-		return GeneralServerError(fmt.Errorf("error reading response reading images summary: %s", err))
+		return GeneralServerError{fmt.Errorf("error reading response reading images summary: %s", err)}
 	}
 
 	if err := json.Unmarshal(body, &checkResponse); err != nil {
-		return GeneralServerError(fmt.Errorf("error unmarshalling JSON response while reading images summary: %s", err))
+		return GeneralServerError{fmt.Errorf("error unmarshalling JSON response while reading images summary: %s", err)}
 	}
 
 	switch sc := resp.StatusCode; {
 	case sc == http.StatusNotFound:
-		return NotFoundError(fmt.Errorf("repo %s under namespace %s does not exist: %s", repo, namespace, checkResponse.Message))
+		return NotFoundError{fmt.Errorf("repo %s under namespace %s does not exist: %s", repo, namespace, checkResponse.Message)}
 	case sc >= 400 && sc <= 499:
-		return GeneralClientError(fmt.Errorf("client error checking namespace %s and repo %s: %s", namespace, repo, checkResponse.Message))
+		return GeneralClientError{fmt.Errorf("client error checking namespace %s and repo %s: %s", namespace, repo, checkResponse.Message)}
 	case sc >= 500 && sc <= 599:
-		return GeneralServerError(fmt.Errorf("server error checking namespace %s and repo %s: %s", namespace, repo, checkResponse.Message))
+		return GeneralServerError{fmt.Errorf("server error checking namespace %s and repo %s: %s", namespace, repo, checkResponse.Message)}
 	}
 
 	return nil
 }
 
+// Health fetches a single tag of a public repo, which Docker Hub counts against the rate limit like any other request.
+// Being rate limited isn't an error, as the rate limit returned says so
+func (d *DockerRegistry) Health(ctx context.Context) (RateLimit, error) {
+	endpoint := fmt.Sprintf("%s/v2/namespaces/library/repositories/hello-world/tags?page_size=1", d.HubURL)
+	resp, err := d.get(ctx, endpoint)
+
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("error reaching %s: %s", d.HubURL, err)
+	}
+
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusTooManyRequests {
+		return RateLimit{}, fmt.Errorf("unexpected status from %s: %s", d.HubURL, resp.Status)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.rateLimit, nil
+}
+
+// parseRateLimit reads the rate limit headers Docker Hub sets on its responses. ok is false if they are missing
+func parseRateLimit(header http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	rateLimit := RateLimit{Limit: limit, Remaining: remaining}
+
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rateLimit.Reset = time.Unix(reset, 0)
+	}
+
+	return rateLimit, true
+}
+
+func (t Tag) tagName() string {
+	return t.Name
+}
+
 func (t Tag) latestImageDigest() (Image, error) {
 	if len(t.Images) == 0 {
 		return Image{}, fmt.Errorf("no images found for tag %s", t.Name)
@@ -155,7 +227,7 @@ func (t Tag) latestImageDigest() (Image, error) {
 	return currentImage, nil
 }
 
-func (d *DockerRegistry) latestTag(namespace string, repo string) (TagFilter, error) {
+func (d *DockerRegistry) latestTag(ctx context.Context, namespace string, repo string) (TagFilter, error) {
 	manifestPath := fmt.Sprintf("v2/namespaces/%s/repositories/%s/tags", namespace, repo)
 	endpoint := fmt.Sprintf("%s/%s", d.HubURL, manifestPath)
 
@@ -167,7 +239,7 @@ func (d *DockerRegistry) latestTag(namespace string, repo string) (TagFilter, er
 		Results  []Tag  `json:"results"`
 	}
 
-	resp, err := http.Get(endpoint)
+	resp, err := d.get(ctx, endpoint)
 
 	if err != nil {
 		return nil, fmt.Errorf("error fetching initial tags from %s: %s", endpoint, err)
@@ -195,7 +267,7 @@ func (d *DockerRegistry) latestTag(namespace string, repo string) (TagFilter, er
 
 	for tagsResponse.Count != tagsChecked {
 		nextPage := tagsResponse.Next
-		resp, err := http.Get(nextPage)
+		resp, err := d.get(ctx, nextPage)
 
 		if err != nil {
 			return nil, fmt.Errorf("error fetching tags at page %s: %s", nextPage, err)
@@ -219,3 +291,119 @@ func (d *DockerRegistry) latestTag(namespace string, repo string) (TagFilter, er
 
 	return latestTag, nil
 }
+
+// get fetches the endpoint, logged in if there are credentials for Docker Hub. If the token the last login returned
+// is refused, it has most likely expired, so the request is retried once after logging in again
+func (d *DockerRegistry) get(ctx context.Context, endpoint string) (*http.Response, error) {
+	resp, token, err := d.do(ctx, endpoint)
+
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || token == "" {
+		return resp, err
+	}
+
+	resp.Body.Close()
+
+	d.mu.Lock()
+	if d.token == token {
+		d.token = ""
+	}
+	d.mu.Unlock()
+
+	resp, _, err = d.do(ctx, endpoint)
+
+	return resp, err
+}
+
+func (d *DockerRegistry) do(ctx context.Context, endpoint string) (*http.Response, string, error) {
+	token, err := d.login(ctx)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := d.client.Do(req)
+
+	if err == nil {
+		if rateLimit, ok := parseRateLimit(resp.Header); ok {
+			d.mu.Lock()
+			d.rateLimit = rateLimit
+			d.mu.Unlock()
+		}
+	}
+
+	return resp, token, err
+}
+
+// login returns the JWT for the Docker Hub API, logging in with the keychain's credentials if there isn't one yet.
+// There is no token if there are no credentials
+// See https://docs.docker.com/docker-hub/api/latest/#tag/authentication/operation/PostUsersLogin
+func (d *DockerRegistry) login(ctx context.Context) (string, error) {
+	d.mu.Lock()
+	token := d.token
+	d.mu.Unlock()
+
+	if token != "" {
+		return token, nil
+	}
+
+	credentials, err := d.Credentials(ctx)
+
+	if err != nil || credentials == nil {
+		return "", err
+	}
+
+	if credentials.Password == "" {
+		return "", fmt.Errorf("logging in to %s needs a username and password (or access token), not an identity token", d.HubURL)
+	}
+
+	body, err := json.Marshal(map[string]string{"username": credentials.Username, "password": credentials.Password})
+
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("%s/v2/users/login", d.HubURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+
+	if err != nil {
+		return "", fmt.Errorf("error logging in to %s: %s", d.HubURL, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error logging in to %s as %s: status %d", d.HubURL, credentials.Username, resp.StatusCode)
+	}
+
+	var loginResponse struct {
+		Token string `json:"token"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&loginResponse); err != nil {
+		return "", fmt.Errorf("error unmarshalling login response from %s: %s", d.HubURL, err)
+	}
+
+	d.mu.Lock()
+	d.token = loginResponse.Token
+	d.mu.Unlock()
+
+	return loginResponse.Token, nil
+}
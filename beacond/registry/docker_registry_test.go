@@ -0,0 +1,71 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DockerRegistrySuite struct {
+	suite.Suite
+}
+
+func TestDockerRegistrySuite(t *testing.T) {
+	suite.Run(t, new(DockerRegistrySuite))
+}
+
+// hub serves Docker Hub's API, answering every request with the status and rate limit headers given
+func (d *DockerRegistrySuite) hub(status int, headers map[string]string) *DockerRegistry {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for key, value := range headers {
+			w.Header().Set(key, value)
+		}
+
+		w.WriteHeader(status)
+		w.Write([]byte(`{"results": []}`))
+	}))
+	d.T().Cleanup(server.Close)
+
+	registry, err := NewDockerRegistry(server.URL, &Keychain{Files: []string{}})
+	d.Require().NoError(err)
+
+	return registry.(*DockerRegistry)
+}
+
+func (d *DockerRegistrySuite) TestHealthReturnsRateLimit() {
+	registry := d.hub(http.StatusOK, map[string]string{
+		"X-RateLimit-Limit":     "180",
+		"X-RateLimit-Remaining": "179",
+		"X-RateLimit-Reset":     "1700000000",
+	})
+
+	rateLimit, err := registry.Health(context.Background())
+
+	assert.NoError(d.T(), err)
+	assert.Equal(d.T(), RateLimit{Limit: 180, Remaining: 179, Reset: time.Unix(1700000000, 0)}, rateLimit)
+}
+
+func (d *DockerRegistrySuite) TestHealthWhenRateLimited() {
+	registry := d.hub(http.StatusTooManyRequests, map[string]string{"X-RateLimit-Limit": "180", "X-RateLimit-Remaining": "0"})
+
+	rateLimit, err := registry.Health(context.Background())
+
+	assert.NoError(d.T(), err)
+	assert.Equal(d.T(), RateLimit{Limit: 180}, rateLimit)
+}
+
+func (d *DockerRegistrySuite) TestHealthWithoutRateLimit() {
+	rateLimit, err := d.hub(http.StatusOK, nil).Health(context.Background())
+
+	assert.NoError(d.T(), err)
+	assert.Equal(d.T(), RateLimit{}, rateLimit)
+
+	_, err = d.hub(http.StatusBadGateway, nil).Health(context.Background())
+
+	assert.ErrorContains(d.T(), err, "unexpected status")
+}
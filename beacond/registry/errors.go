@@ -1,5 +1,11 @@
 package registry
 
-type GeneralServerError error
-type GeneralClientError error
-type NotFoundError error
+// The errors returned by registries, which callers can tell apart to decide how to report them
+
+type GeneralServerError struct{ Err error }
+type GeneralClientError struct{ Err error }
+type NotFoundError struct{ Err error }
+
+func (e GeneralServerError) Error() string { return e.Err.Error() }
+func (e GeneralClientError) Error() string { return e.Err.Error() }
+func (e NotFoundError) Error() string      { return e.Err.Error() }
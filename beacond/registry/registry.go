@@ -1,6 +1,11 @@
+//go:generate mockgen -source $GOFILE -package registry -destination ./registry_mock.go Registry
 package registry
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+)
 
 const (
 	Docker RegistryType = "docker"
@@ -8,16 +13,40 @@ const (
 
 type RegistryType string
 
+// How long a single request to a registry can take before it is abandoned
+const requestTimeout = 30 * time.Second
+
+// ImageDetails describes the latest image pushed to a repo
+type ImageDetails struct {
+	Digest string
+	// The tag the image was pushed with
+	Tag string
+	// When the image was pushed. It is zero if the registry doesn't say
+	Pushed time.Time
+}
+
+// RateLimit is how many requests the registry allows, as it last reported. It is zero if the registry doesn't say
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	// When the remaining requests go back up to the limit
+	Reset time.Time
+}
+
 type Registry interface {
-	LatestImageDigest(string, string) (string, error)
-	TestRepo(string, string) error
+	LatestImage(context.Context, string, string) (ImageDetails, error)
+	TestRepo(context.Context, string, string) error
 	URL() string
+	Credentials(context.Context) (*Credentials, error)
+	// Health checks that the registry can be reached with beacond's credentials, returning its rate limit
+	Health(context.Context) (RateLimit, error)
 }
 
-func NewRegistry(registryType RegistryType) (Registry, error) {
+// NewRegistry creates a client for the registry, authenticating with the keychain's credentials for it if it has any
+func NewRegistry(registryType RegistryType, keychain *Keychain) (Registry, error) {
 	switch registryType {
 	case Docker:
-		return NewDockerRegistry("https://hub.docker.com")
+		return NewDockerRegistry("https://hub.docker.com", keychain)
 	default:
 		return nil, fmt.Errorf("registry type not supported: %s", registryType)
 	}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registry.go
+
+// Package registry is a generated GoMock package.
+package registry
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRegistry is a mock of Registry interface.
+type MockRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryMockRecorder
+}
+
+// MockRegistryMockRecorder is the mock recorder for MockRegistry.
+type MockRegistryMockRecorder struct {
+	mock *MockRegistry
+}
+
+// NewMockRegistry creates a new mock instance.
+func NewMockRegistry(ctrl *gomock.Controller) *MockRegistry {
+	mock := &MockRegistry{ctrl: ctrl}
+	mock.recorder = &MockRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
+	return m.recorder
+}
+
+// Credentials mocks base method.
+func (m *MockRegistry) Credentials(arg0 context.Context) (*Credentials, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Credentials", arg0)
+	ret0, _ := ret[0].(*Credentials)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Credentials indicates an expected call of Credentials.
+func (mr *MockRegistryMockRecorder) Credentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Credentials", reflect.TypeOf((*MockRegistry)(nil).Credentials), arg0)
+}
+
+// Health mocks base method.
+func (m *MockRegistry) Health(arg0 context.Context) (RateLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health", arg0)
+	ret0, _ := ret[0].(RateLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockRegistryMockRecorder) Health(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockRegistry)(nil).Health), arg0)
+}
+
+// LatestImage mocks base method.
+func (m *MockRegistry) LatestImage(arg0 context.Context, arg1, arg2 string) (ImageDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(ImageDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestImage indicates an expected call of LatestImage.
+func (mr *MockRegistryMockRecorder) LatestImage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestImage", reflect.TypeOf((*MockRegistry)(nil).LatestImage), arg0, arg1, arg2)
+}
+
+// TestRepo mocks base method.
+func (m *MockRegistry) TestRepo(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRepo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TestRepo indicates an expected call of TestRepo.
+func (mr *MockRegistryMockRecorder) TestRepo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRepo", reflect.TypeOf((*MockRegistry)(nil).TestRepo), arg0, arg1, arg2)
+}
+
+// URL mocks base method.
+func (m *MockRegistry) URL() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URL")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URL indicates an expected call of URL.
+func (mr *MockRegistryMockRecorder) URL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URL", reflect.TypeOf((*MockRegistry)(nil).URL))
+}
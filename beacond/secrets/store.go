@@ -0,0 +1,303 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// The version of the secrets file written by the store, so that older files can be migrated if its format changes
+const storeVersion = 1
+
+// scrypt parameters for deriving the key from a passphrase, as recommended for interactive logins in 2017
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+	keyLen  = 32
+)
+
+// A value encrypted with the key when the file is created, which is decrypted when it is opened to check the key
+var keyCheck = []byte("beacon secrets")
+
+var ErrNotFound = errors.New("secret not found")
+var ErrWrongKey = errors.New("the key does not match the one the secrets were encrypted with")
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// Secret describes a stored secret. Its value is only ever returned by Value
+type Secret struct {
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// savedSecret is a secret as it is saved to the secrets file, encrypted with the secret's name as additional data so
+// that values can't be swapped between secrets
+type savedSecret struct {
+	Value     []byte    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type savedStore struct {
+	Version int                    `json:"version"`
+	Salt    []byte                 `json:"salt"`
+	Check   []byte                 `json:"check"`
+	Secrets map[string]savedSecret `json:"secrets"`
+}
+
+// Store keeps secrets in a file, encrypted at rest with AES-256-GCM using a key derived from a passphrase
+type Store struct {
+	path  string
+	aead  cipher.AEAD
+	mu    sync.RWMutex
+	saved savedStore
+}
+
+// Open opens the secrets file at path, creating it if it doesn't exist yet. The passphrase has to be the same one
+// the file was created with
+func Open(path string, passphrase []byte) (*Store, error) {
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("a passphrase is needed to open the secrets in %s", path)
+	}
+
+	store := &Store{path: path}
+	content, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return store, store.create(passphrase)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading secrets file %s: %s", path, err)
+	}
+
+	if err := json.Unmarshal(content, &store.saved); err != nil {
+		return nil, fmt.Errorf("error decoding secrets file %s: %s", path, err)
+	}
+
+	if store.saved.Version > storeVersion {
+		return nil, fmt.Errorf("secrets file %s was written by a newer version of beacond (version %d)", path, store.saved.Version)
+	}
+
+	if store.aead, err = newAEAD(passphrase, store.saved.Salt); err != nil {
+		return nil, err
+	}
+
+	if _, err := store.open(store.saved.Check, ""); err != nil {
+		return nil, ErrWrongKey
+	}
+
+	if store.saved.Secrets == nil {
+		store.saved.Secrets = map[string]savedSecret{}
+	}
+
+	return store, nil
+}
+
+func (s *Store) create(passphrase []byte) error {
+	salt := make([]byte, 16)
+
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("error generating salt: %s", err)
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+
+	if err != nil {
+		return err
+	}
+
+	s.aead = aead
+	s.saved = savedStore{Version: storeVersion, Salt: salt, Secrets: map[string]savedSecret{}}
+
+	if s.saved.Check, err = s.seal(keyCheck, ""); err != nil {
+		return err
+	}
+
+	return s.save()
+}
+
+func newAEAD(passphrase []byte, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, keyLen)
+
+	if err != nil {
+		return nil, fmt.Errorf("error deriving key: %s", err)
+	}
+
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %s", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the value, prefixing it with the random nonce it was encrypted with
+func (s *Store) seal(value []byte, name string) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %s", err)
+	}
+
+	return s.aead.Seal(nonce, nonce, value, []byte(name)), nil
+}
+
+func (s *Store) open(sealed []byte, name string) ([]byte, error) {
+	if len(sealed) < s.aead.NonceSize() {
+		return nil, fmt.Errorf("secret %s is corrupt", name)
+	}
+
+	nonce, ciphertext := sealed[:s.aead.NonceSize()], sealed[s.aead.NonceSize():]
+
+	return s.aead.Open(nil, nonce, ciphertext, []byte(name))
+}
+
+// save replaces the secrets file in one go, so that beacond being killed while saving doesn't leave it half written.
+// The caller must hold the store's lock
+func (s *Store) save() error {
+	content, err := json.MarshalIndent(s.saved, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding secrets: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("error creating directory for secrets file %s: %s", s.path, err)
+	}
+
+	tmp := s.path + ".tmp"
+
+	if err := os.WriteFile(tmp, content, 0600); err != nil {
+		return fmt.Errorf("error writing secrets file %s: %s", tmp, err)
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("error replacing secrets file %s: %s", s.path, err)
+	}
+
+	return nil
+}
+
+// ValidateName returns an error if the name can't be used for a secret
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("secret names must start with a letter or digit and only contain letters, digits, _, . and -, got %q", name)
+	}
+
+	return nil
+}
+
+// Set creates or replaces the secret
+func (s *Store) Set(name string, value []byte) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sealed, err := s.seal(value, name)
+
+	if err != nil {
+		return err
+	}
+
+	previous, existed := s.saved.Secrets[name]
+	s.saved.Secrets[name] = savedSecret{Value: sealed, UpdatedAt: time.Now().UTC()}
+
+	if err := s.save(); err != nil {
+		if existed {
+			s.saved.Secrets[name] = previous
+		} else {
+			delete(s.saved.Secrets, name)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Get describes the secret, without its value
+func (s *Store) Get(name string) (Secret, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	saved, ok := s.saved.Secrets[name]
+
+	if !ok {
+		return Secret{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	return Secret{Name: name, UpdatedAt: saved.UpdatedAt}, nil
+}
+
+// Value decrypts the secret's value
+func (s *Store) Value(name string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	saved, ok := s.saved.Secrets[name]
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	value, err := s.open(saved.Value, name)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting secret %s", name)
+	}
+
+	return value, nil
+}
+
+// List returns the names of every secret, in order
+func (s *Store) List() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := []string{}
+
+	for name := range s.saved.Secrets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Delete removes the secret
+func (s *Store) Delete(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.saved.Secrets[name]
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	delete(s.saved.Secrets, name)
+
+	if err := s.save(); err != nil {
+		s.saved.Secrets[name] = previous
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type StoreSuite struct {
+	suite.Suite
+	Path string
+}
+
+func TestStoreSuite(t *testing.T) {
+	suite.Run(t, new(StoreSuite))
+}
+
+func (s *StoreSuite) SetupTest() {
+	s.Path = filepath.Join(s.T().TempDir(), "beacon", "secrets.json")
+}
+
+func (s *StoreSuite) TestSetAndReopen() {
+	store, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+
+	assert.NoError(s.T(), store.Set("fakeSecret", []byte("fakeValue")))
+	assert.NoError(s.T(), store.Set("fakeOtherSecret", []byte("fakeOtherValue")))
+	assert.NoError(s.T(), store.Delete("fakeOtherSecret"))
+
+	reopened, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+
+	value, err := reopened.Value("fakeSecret")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "fakeValue", string(value))
+	assert.Equal(s.T(), []string{"fakeSecret"}, reopened.List())
+
+	secret, err := reopened.Get("fakeSecret")
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "fakeSecret", secret.Name)
+	assert.False(s.T(), secret.UpdatedAt.IsZero())
+}
+
+func (s *StoreSuite) TestValuesAreEncryptedAtRest() {
+	store, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+	assert.NoError(s.T(), store.Set("fakeSecret", []byte("fakeValue")))
+
+	content, err := os.ReadFile(s.Path)
+
+	assert.NoError(s.T(), err)
+	assert.NotContains(s.T(), string(content), "fakeValue")
+
+	info, err := os.Stat(s.Path)
+
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), os.FileMode(0600), info.Mode().Perm())
+}
+
+func (s *StoreSuite) TestWrongPassphrase() {
+	_, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+
+	_, err = Open(s.Path, []byte("fakeWrongPassphrase"))
+
+	assert.ErrorIs(s.T(), err, ErrWrongKey)
+}
+
+func (s *StoreSuite) TestValuesCannotBeSwapped() {
+	store, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+	assert.NoError(s.T(), store.Set("fakeSecretA", []byte("fakeValueA")))
+	assert.NoError(s.T(), store.Set("fakeSecretB", []byte("fakeValueB")))
+
+	store.saved.Secrets["fakeSecretA"] = store.saved.Secrets["fakeSecretB"]
+
+	_, err = store.Value("fakeSecretA")
+
+	assert.Error(s.T(), err)
+	assert.NotContains(s.T(), err.Error(), "fakeValue")
+}
+
+func (s *StoreSuite) TestMissingAndInvalidSecrets() {
+	store, err := Open(s.Path, []byte("fakePassphrase"))
+	assert.NoError(s.T(), err)
+
+	_, err = store.Value("fakeSecret")
+	assert.ErrorIs(s.T(), err, ErrNotFound)
+
+	assert.ErrorIs(s.T(), store.Delete("fakeSecret"), ErrNotFound)
+	assert.Error(s.T(), store.Set("../fakeSecret", []byte("fakeValue")))
+	assert.Error(s.T(), store.Set(strings.Repeat(" ", 3), []byte("fakeValue")))
+}
+
+func (s *StoreSuite) TestEmptyPassphrase() {
+	_, err := Open(s.Path, nil)
+
+	assert.Error(s.T(), err)
+}
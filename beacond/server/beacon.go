@@ -1,9 +1,19 @@
 package server
 
 import (
+	"beacon/beacond/host"
+	"beacon/beacond/notify"
 	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
 	"beacon/beacond/registry"
+	"beacon/beacond/secrets"
+	"beacon/beacond/signature"
+	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/labstack/gommon/log"
@@ -11,276 +21,718 @@ import (
 
 var Beacon beaconManager
 
+// Deadlines for the work done for a probe. Each is cancelled early if the probe is stopped or beacond shuts down
 const (
-	Probing  ProbeStatus = "probing"
-	Outdated ProbeStatus = "outdated"
-	Starting ProbeStatus = "starting"
-	Exited   ProbeStatus = "exited"
+	// Checking the registry for a new digest, including verifying its signature
+	probeTimeout = time.Minute
+	// Pulling and running a new digest on every replica
+	deployTimeout = 15 * time.Minute
+	// Waiting for a replica running a new digest to be running (and healthy, if it has a health check)
+	readyTimeout = 5 * time.Minute
+	// Finding the containers to adopt when beacond starts
+	adoptTimeout = time.Minute
+	// Checking on a container and restarting it if needed
+	healTimeout = 2 * time.Minute
+	// Listing or removing volumes, and purging what a deleted probe left behind
+	volumeTimeout = time.Minute
+	// Running a hook that doesn't set a timeout of its own
+	hookTimeout = 10 * time.Minute
+	// Inspecting a probe's containers to describe it
+	describeTimeout = 10 * time.Second
 )
 
-type ProbeStatus string
-
-type BeaconErrorProbeDoesNotExist error
-type BeaconErrorProbeAlreadyExists error
+type BeaconErrorProbeDoesNotExist struct{ error }
+type BeaconErrorProbeAlreadyExists struct{ error }
+type BeaconErrorInsufficientCapacity struct{ error }
+type BeaconErrorRouteConflict struct{ error }
+type BeaconErrorUnknownSink struct{ error }
+type BeaconErrorNotPendingApproval struct{ error }
 
 type beacon struct {
 	OCIClient      oci.OCIRuntime
 	RegistryClient registry.Registry
-	close          chan struct{}
-	confirmClosing chan struct{}
-	Probes         map[string]*Probe
+	Verifier       signature.Verifier
 	CleanOnExit    bool
-}
-
-type Probe struct {
-	close          chan struct{}
-	confirmClosing chan struct{}
-	resume         chan struct{}
-	Namespace      string
-	Repo           string
-	Status         ProbeStatus
-	CurrentDigest  string
-	LatestDigest   string
-	LastChecked    time.Time
-	LastUpdated    time.Time
+	StateFile      string
+	// ID is set as a label on every container the beacon creates. It is saved with the state, so that the containers
+	// can be adopted when beacond restarts
+	ID              string
+	AllowOvercommit bool
+	HostCapacity    host.Capacity
+	// Routes requests to the probes that declare a route
+	ReverseProxy *proxy.Proxy
+	// Holds the secrets injected into containers. It is nil if beacond was started without a key for it
+	Secrets *secrets.Store
+	// Where the files for secrets mounted into containers are written
+	SecretsDir string
+	// Sends notifications about deploys and probes. It is nil if no sinks are configured
+	Notifier *notify.Notifier
+	// The network every managed container joins, unless its probe opts out. It is empty if there is none
+	Network string
+	// Checked by Ready, to tell whether the reconcile loop is stuck
+	heartbeat heartbeat
+	// The result of the last registry health check, which is reused for a while
+	registryHealth registryHealth
+	// ctx is cancelled when the beacon is closed, which cancels the work in flight for every probe
+	ctx    context.Context
+	cancel context.CancelFunc
+	// wake is signalled whenever the reconcile loop has work to do
+	wake chan struct{}
+	// Deploys and new replicas run in their own goroutines, so that a slow rollout doesn't hold up healing and scaling
+	// other probes
+	deploys sync.WaitGroup
+	mu      sync.RWMutex
+	probes  map[string]*Probe
 }
 
 type beaconManager interface {
 	Close()
-	ConfirmClosing()
-	Start() error
+	Start(context.Context) error
+	Shutdown(time.Time) error
+	SaveState() error
+	RestoreState(time.Duration) error
 	Registry() registry.Registry
 	Runtime() oci.OCIRuntime
+	Proxy() *proxy.Proxy
+	Capacity() host.Capacity
+	Allocated() oci.Resources
 	ListProbes() []string
+	QueryProbes(ProbeQuery) ([]ProbeSummary, int)
 	GetProbe(string, string) (*Probe, bool)
-	StartProbe(string, string, time.Duration) error
+	DescribeProbe(string, string) (ProbeDetails, error)
+	StartProbe(string, string, ProbeOptions, time.Duration) error
 	StopProbe(string, string, time.Duration) error
+	ScaleProbe(string, string, int) error
+	ApproveProbe(string, string, string) (string, error)
+	RejectProbe(string, string, string) (string, error)
+	SetSecret(string, []byte) error
+	GetSecret(string) (SecretDetails, error)
+	ListSecrets() ([]string, error)
+	DeleteSecret(string) error
+	ListVolumes() ([]VolumeDetails, error)
+	GetVolume(string) (VolumeDetails, error)
+	RemoveVolume(string) error
+	PurgeProbe(string, string) error
+	Ready(context.Context) Readiness
 	StopProbes(time.Duration) error
-	StopManagedContainers(time.Duration) error
+	CleanUpManagedContainers(time.Duration) error
 }
 
-func NewProbe(namespace string, repo string) *Probe {
-	return &Probe{
-		Namespace:      namespace,
-		Repo:           repo,
-		Status:         Starting,
-		close:          make(chan struct{}),
-		confirmClosing: make(chan struct{}),
-		resume:         make(chan struct{}),
-	}
-}
-
-func NewBeacon(ociClient oci.OCIRuntime, registryClient registry.Registry, cleanOnExit bool) beaconManager {
+// NewBeacon creates the beacon manager. Image signatures are only verified before deployment if verifier is not nil
+func NewBeacon(ociClient oci.OCIRuntime, registryClient registry.Registry, verifier signature.Verifier, config Config) beaconManager {
 	if Beacon == nil {
-		Beacon = &beacon{
-			OCIClient:      ociClient,
-			RegistryClient: registryClient,
-			CleanOnExit:    cleanOnExit,
-			Probes:         make(map[string]*Probe),
-			close:          make(chan struct{}),
-			confirmClosing: make(chan struct{}),
+		capacity, err := host.HostCapacity()
+
+		// Without knowing the host's memory (as on anything but Linux), probes' memory limits can't be checked
+		// against it, but their CPU limits still are
+		if err != nil {
+			log.Warnf("could not determine host memory, memory limits will not be checked against it: %s", err)
 		}
+
+		Beacon = newBeacon(ociClient, registryClient, verifier, config, capacity)
 	}
 
 	return Beacon
 }
 
+func newBeacon(ociClient oci.OCIRuntime, registryClient registry.Registry, verifier signature.Verifier, config Config, capacity host.Capacity) *beacon {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &beacon{
+		OCIClient:       ociClient,
+		RegistryClient:  registryClient,
+		Verifier:        verifier,
+		CleanOnExit:     config.CleanOnExit,
+		StateFile:       config.StateFile,
+		ID:              newBeaconID(),
+		AllowOvercommit: config.AllowOvercommit,
+		HostCapacity:    capacity,
+		ReverseProxy:    proxy.New(),
+		Secrets:         config.Secrets,
+		SecretsDir:      config.SecretsDir,
+		Notifier:        config.Notifier,
+		Network:         config.Network,
+		probes:          make(map[string]*Probe),
+		ctx:             ctx,
+		cancel:          cancel,
+		wake:            make(chan struct{}, 1),
+	}
+}
+
 func (b *beacon) Runtime() oci.OCIRuntime {
 	return b.OCIClient
 }
 
+func (b *beacon) Proxy() *proxy.Proxy {
+	return b.ReverseProxy
+}
+
 func (b *beacon) Registry() registry.Registry {
 	return b.RegistryClient
 }
 
+func (b *beacon) Capacity() host.Capacity {
+	return b.HostCapacity
+}
+
+// Allocated sums the resource limits of every replica of every probe. Probes without a limit on a resource don't
+// count towards it
+func (b *beacon) Allocated() oci.Resources {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.allocated()
+}
+
+// allocated is Allocated for callers that already hold the beacon's lock
+func (b *beacon) allocated() oci.Resources {
+	var allocated oci.Resources
+
+	for _, probe := range b.probes {
+		resources := probe.Resources.Times(probe.Options().Replicas)
+
+		allocated.CPUShares += resources.CPUShares
+		allocated.CPUQuota += resources.CPUQuota
+		allocated.Memory += resources.Memory
+		allocated.PidsLimit += resources.PidsLimit
+	}
+
+	return allocated
+}
+
+// checkCapacity returns an error if the resources requested don't fit in what is left unallocated on the host. The
+// caller must hold the beacon's lock
+func (b *beacon) checkCapacity(requested oci.Resources) error {
+	if b.AllowOvercommit {
+		return nil
+	}
+
+	allocated := b.allocated()
+
+	if requested.CPUQuota > 0 && b.HostCapacity.CPUs > 0 && allocated.CPUs()+requested.CPUs() > b.HostCapacity.CPUs {
+		return BeaconErrorInsufficientCapacity{fmt.Errorf("requested %.2f CPUs but only %.2f of %.2f are unallocated",
+			requested.CPUs(), b.HostCapacity.CPUs-allocated.CPUs(), b.HostCapacity.CPUs)}
+	}
+
+	if requested.Memory > 0 && b.HostCapacity.Memory > 0 && allocated.Memory+requested.Memory > b.HostCapacity.Memory {
+		return BeaconErrorInsufficientCapacity{fmt.Errorf("requested %d bytes of memory but only %d of %d are unallocated",
+			requested.Memory, b.HostCapacity.Memory-allocated.Memory, b.HostCapacity.Memory)}
+	}
+
+	return nil
+}
+
+// Close stops the reconcile loop and every probe, cancelling anything they are doing. It can be called more than once
 func (b *beacon) Close() {
-	b.close <- struct{}{}
+	b.cancel()
 }
 
-func (b *beacon) ConfirmClosing() {
-	b.confirmClosing <- struct{}{}
+// notify wakes the reconcile loop without blocking. Notifications sent while it is already awake are coalesced,
+// as a single pass of the loop looks at every probe
+func (b *beacon) notify() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
 }
 
-func (b *beacon) Start() error {
-	defer b.ConfirmClosing()
+// Start runs the reconcile loop, which deploys new digests when a probe reports them and periodically checks that
+// managed containers are still running. It sleeps in between, rather than polling the probes. The loop runs until
+// ctx is cancelled or the beacon is closed, either of which also stops every probe
+func (b *beacon) Start(ctx context.Context) error {
+	if err := b.createNetwork(ctx); err != nil {
+		return err
+	}
 
-	for {
+	go func() {
 		select {
-		case <-b.close:
-			if b.CleanOnExit {
-				err := b.StopManagedContainers(30 * time.Second)
-
-				if err != nil {
-					log.Error(err)
-					return err
-				}
-			}
+		case <-ctx.Done():
+			b.Close()
+		case <-b.ctx.Done():
+		}
+	}()
 
+	ticker := time.NewTicker(healInterval)
+	defer ticker.Stop()
+
+	for {
+		b.heartbeat.beat(false)
+
+		select {
+		case <-b.ctx.Done():
 			return nil
-		default:
-			for _, probe := range b.Probes {
-				if probe.Status == Outdated {
-					imageRef := fmt.Sprintf("%s/%s@%s", probe.Namespace, probe.Repo, probe.LatestDigest)
-
-					// Check that a container for this image isn't already running - this can happen if the OCI runtime fails
-					// to clear the containers requested by Beacon on exit
-					runningContainers, err := b.OCIClient.ContainersUsingImage(imageRef, []string{"running"})
-
-					if err != nil {
-						log.Errorf("error checking if image %s is already running:", imageRef, err)
-						continue
-					}
-
-					if len(runningContainers) > 0 {
-						probe.CurrentDigest = probe.LatestDigest
-						probe.Resume()
-						continue
-					}
-
-					err = b.OCIClient.PullImage(imageRef)
-
-					if err != nil {
-						log.Errorf("error pulling image %s:", imageRef, err)
-						continue
-					}
-
-					b.OCIClient.StopContainersByImage(imageRef)
-					err = b.OCIClient.RunImage(imageRef)
-
-					if err != nil {
-						log.Errorf("error running image %s:", imageRef, err)
-						continue
-					}
-
-					probe.CurrentDigest = probe.LatestDigest
-					probe.Resume()
-				}
+		case <-b.wake:
+		case <-ticker.C:
+		}
+
+		b.heartbeat.beat(true)
+		b.reconcile()
+	}
+}
+
+// reconcile makes one pass over every probe, deploying the ones that are outdated, and scaling, healing and restarting
+// the rest for redeployed dependencies. Each deploy runs in its own goroutine, and the probe is left alone by the loop
+// until it is done. Probes are visited after the probes they depend on, and wait for any of them being deployed, so
+// that dependencies are deployed first
+func (b *beacon) reconcile() {
+	for _, probe := range orderProbes(b.snapshot()) {
+		if probe.isDeploying() {
+			continue
+		}
+
+		state := probe.State()
+
+		if state.Status == Outdated {
+			if err := b.dependenciesRunning(probe); err != nil {
+				probe.RecordEvent(EventWaitingForDependency, fmt.Sprintf("waiting to deploy %s: %s", state.LatestDigest, err))
+				continue
 			}
+
+			b.startDeploy(probe, state, b.deploy)
+			continue
 		}
+
+		b.scale(probe, state)
+
+		// New replicas are waited on until they are ready, so they are added in the background like a deploy
+		if len(probe.missingReplicas(state)) > 0 {
+			b.startDeploy(probe, state, b.addReplicas)
+			continue
+		}
+
+		b.restartForDependencies(probe)
+		b.heal(probe)
 	}
 }
 
+// startDeploy runs deploy for the probe in its own goroutine, waking the reconcile loop once it is done so that the
+// probe is scaled and healed again
+func (b *beacon) startDeploy(probe *Probe, state ProbeState, deploy func(*Probe, ProbeState)) {
+	probe.setDeploying(true)
+	b.deploys.Add(1)
+
+	go func() {
+		defer b.deploys.Done()
+		defer b.notify()
+		defer probe.setDeploying(false)
+
+		deploy(probe, state)
+	}()
+}
+
+// snapshot returns the probes that currently exist, so that they can be iterated over without holding the lock
+func (b *beacon) snapshot() []*Probe {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	probes := make([]*Probe, 0, len(b.probes))
+
+	for _, probe := range b.probes {
+		probes = append(probes, probe)
+	}
+
+	return probes
+}
+
 func (b *beacon) ListProbes() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
 	probes := []string{}
 
-	for probeRef := range b.Probes {
+	for probeRef := range b.probes {
 		probes = append(probes, probeRef)
 	}
 
+	sort.Strings(probes)
+
 	return probes
 }
 
 func (b *beacon) GetProbe(namespace string, repo string) (*Probe, bool) {
-	probeRef := fmt.Sprintf("%s/%s", namespace, repo)
-	probe, ok := b.Probes[probeRef]
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	probe, ok := b.probes[fmt.Sprintf("%s/%s", namespace, repo)]
 
 	return probe, ok
 }
 
-func (b *beacon) StartProbe(namespace string, repo string, delay time.Duration) error {
-	probeRef := fmt.Sprintf("%s/%s", namespace, repo)
+func (b *beacon) StartProbe(namespace string, repo string, options ProbeOptions, delay time.Duration) error {
+	if err := b.checkSecretRefs(options.Secrets); err != nil {
+		return err
+	}
 
-	if _, ok := b.Probes[probeRef]; ok {
-		return BeaconErrorProbeAlreadyExists(fmt.Errorf("probe already exists"))
+	if err := checkVolumeRefs(options.Volumes); err != nil {
+		return err
 	}
 
-	b.Probes[probeRef] = NewProbe(namespace, repo)
+	if err := checkHooks(options.Hooks); err != nil {
+		return err
+	}
+
+	if err := b.Notifier.Check(options.Notify); err != nil {
+		return BeaconErrorUnknownSink{err}
+	}
+
+	err := b.addProbe(NewProbe(b.ctx, namespace, repo, options), delay, true)
+
+	if err != nil {
+		return err
+	}
 
-	go runProbe(b.Probes[probeRef], b.RegistryClient, delay)
+	b.saveState()
 
 	return nil
 }
 
-func (b *beacon) StopProbes(delay time.Duration) error {
-	return withTimeout(func() error {
-		for _, probe := range b.Probes {
-			probe.Close()
+// addProbe starts the probe unless one already exists for its repo, its dependencies would form a cycle, its route is
+// already used by another probe or, if checkCapacity is set, its resource limits don't fit on the host
+func (b *beacon) addProbe(probe *Probe, delay time.Duration, checkCapacity bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.probes[probe.Ref()]; ok {
+		return BeaconErrorProbeAlreadyExists{fmt.Errorf("probe already exists")}
+	}
+
+	if checkCapacity {
+		if err := b.checkCapacity(probe.Resources.Times(probe.Replicas)); err != nil {
+			return err
 		}
+	}
 
-		b.Probes = make(map[string]*Probe)
+	if err := b.checkDependencies(probe.Ref(), probe.DependsOn); err != nil {
+		return err
+	}
 
-		return nil
-	}, delay, "timed out stopping probes")
+	if probe.Route != nil {
+		if err := b.ReverseProxy.Add(probe.Ref(), *probe.Route); err != nil {
+			return BeaconErrorRouteConflict{err}
+		}
+	}
+
+	b.probes[probe.Ref()] = probe
+	probe.notifier = b.Notifier
+
+	go probe.run(b.RegistryClient, b.Verifier, delay, b.notify)
+
+	return nil
 }
 
-func (b *beacon) StopManagedContainers(delay time.Duration) error {
-	return withTimeout(func() error {
-		for _, probe := range b.Probes {
-			imageRef := fmt.Sprintf("%s/%s@%s", probe.Namespace, probe.Repo, probe.LatestDigest)
-			err := b.OCIClient.StopContainersByImage(imageRef)
+// ScaleProbe changes the number of replicas the probe runs. The reconcile loop adds or removes containers to match,
+// without redeploying the replicas that are kept
+func (b *beacon) ScaleProbe(namespace string, repo string, replicas int) error {
+	b.mu.Lock()
+	probe, ok := b.probes[fmt.Sprintf("%s/%s", namespace, repo)]
 
-			if err != nil {
-				log.Error(err.Error())
-			}
+	if !ok {
+		b.mu.Unlock()
+		return BeaconErrorProbeDoesNotExist{fmt.Errorf("probe does not exist")}
+	}
+
+	current := probe.Options().Replicas
+
+	if replicas > current {
+		if err := b.checkCapacity(probe.Resources.Times(replicas - current)); err != nil {
+			b.mu.Unlock()
+			return err
 		}
+	}
 
-		return nil
-	}, delay, "timed out stopping managed containers")
+	probe.update(func(s *ProbeState) { s.Replicas = replicas })
+	b.mu.Unlock()
+
+	if replicas != current {
+		probe.RecordEvent(EventScaled, fmt.Sprintf("scaled from %d to %d replicas", current, replicas))
+	}
+
+	b.saveState()
+	b.notify()
+
+	return nil
 }
 
-func (b *beacon) StopProbe(namespace string, repo string, delay time.Duration) error {
-	probeRef := fmt.Sprintf("%s/%s", namespace, repo)
-	_, ok := b.GetProbe(namespace, repo)
+// ApproveProbe deploys the digest the probe found while waiting for approval, returning it. If digest isn't empty, it
+// has to be the digest waiting to be approved
+func (b *beacon) ApproveProbe(namespace string, repo string, digest string) (string, error) {
+	probe, ok := b.GetProbe(namespace, repo)
 
 	if !ok {
-		return BeaconErrorProbeDoesNotExist(fmt.Errorf("probe does not exist"))
+		return "", BeaconErrorProbeDoesNotExist{fmt.Errorf("probe does not exist")}
 	}
 
-	b.Probes[probeRef].Close()
-	<-b.Probes[probeRef].confirmClosing
-	delete(b.Probes, probeRef)
+	approved, err := probe.approve(digest)
 
-	return nil
+	if err != nil {
+		return "", err
+	}
+
+	b.saveState()
+	b.notify()
+
+	return approved, nil
 }
 
-func (p *Probe) Close() {
-	p.close <- struct{}{}
+// RejectProbe skips the digest the probe found while waiting for approval, returning it. If digest isn't empty, it
+// has to be the digest waiting to be approved
+func (b *beacon) RejectProbe(namespace string, repo string, digest string) (string, error) {
+	probe, ok := b.GetProbe(namespace, repo)
+
+	if !ok {
+		return "", BeaconErrorProbeDoesNotExist{fmt.Errorf("probe does not exist")}
+	}
+
+	rejected, err := probe.reject(digest)
+
+	if err != nil {
+		return "", err
+	}
+
+	b.saveState()
+
+	return rejected, nil
 }
 
-func (p *Probe) ConfirmClosing() {
-	p.confirmClosing <- struct{}{}
+// saveState saves the state after a probe is created, changed or deleted. Failing to save it doesn't undo the change, which
+// still applies until beacond is restarted
+func (b *beacon) saveState() {
+	if err := b.SaveState(); err != nil {
+		log.Errorf("error saving state: %s", err)
+	}
 }
 
-func (p *Probe) Resume() {
-	p.resume <- struct{}{}
+// Shutdown stops every probe and, if beacond was started with --clean-up, removes their containers and network,
+// giving up on anything still running after the deadline. The state is saved last, so that the probes are restored when beacond next starts
+func (b *beacon) Shutdown(deadline time.Time) error {
+	probes := b.snapshot()
+
+	var errs []error
+
+	b.Close()
+
+	for _, probe := range probes {
+		select {
+		case <-probe.Done():
+		case <-time.After(time.Until(deadline)):
+			errs = append(errs, fmt.Errorf("timed out stopping probe %s", probe.Ref()))
+		}
+	}
+
+	deployed := make(chan struct{})
+
+	go func() {
+		b.deploys.Wait()
+		close(deployed)
+	}()
+
+	select {
+	case <-deployed:
+	case <-time.After(time.Until(deadline)):
+		errs = append(errs, fmt.Errorf("timed out waiting for deploys to stop"))
+	}
+
+	if b.CleanOnExit {
+		if err := b.CleanUpManagedContainers(time.Until(deadline)); err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := b.removeNetwork(time.Until(deadline)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := b.SaveState(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// StopProbes stops every probe, waiting up to delay for them all to finish
+func (b *beacon) StopProbes(delay time.Duration) error {
+	b.mu.Lock()
+	probes := b.probes
+	b.probes = make(map[string]*Probe)
+	b.mu.Unlock()
+
+	for _, probe := range probes {
+		b.ReverseProxy.Remove(probe.Ref())
+		probe.Close()
+	}
+
+	deadline := time.After(delay)
+
+	for _, probe := range probes {
+		select {
+		case <-probe.Done():
+		case <-deadline:
+			return fmt.Errorf("timed out stopping probes")
+		}
+	}
+
+	return nil
 }
 
-func runProbe(probe *Probe, registryClient registry.Registry, delay time.Duration) {
-	defer probe.ConfirmClosing()
+// CleanUpManagedContainers stops every running container labelled as created by this beacon, then removes them all
+// so that the network they joined can be removed too. It gives up on any still running once delay has passed. A
+// container that couldn't be stopped is left alone rather than removed by force
+func (b *beacon) CleanUpManagedContainers(delay time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), delay)
+	defer cancel()
+
+	containers, err := b.OCIClient.ListContainers(ctx, map[string]string{oci.LabelBeaconID: b.ID}, nil)
 
-	prober := func() {
-		if probe.Status == Probing {
-			digest, err := registryClient.LatestImageDigest(probe.Namespace, probe.Repo)
+	if err != nil {
+		return fmt.Errorf("error listing managed containers: %s", err)
+	}
+
+	for _, container := range containers {
+		if container.Status == "running" {
+			err := b.OCIClient.StopContainer(ctx, container.ID)
+
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out stopping managed containers")
+			}
 
 			if err != nil {
-				log.Errorf("failed to get latest digest while probing: %s", err)
-				probe.Status = Exited
-				return
+				log.Error(err.Error())
+				continue
 			}
+		}
 
-			probe.LastChecked = time.Now()
+		err := b.OCIClient.RemoveContainer(ctx, container.ID)
 
-			if digest != probe.CurrentDigest {
-				probe.LatestDigest = digest
-				probe.LastUpdated = time.Now()
-				probe.Status = Outdated
-			}
+		if ctx.Err() != nil {
+			return fmt.Errorf("timed out removing managed containers")
+		}
 
-			time.Sleep(delay)
+		if err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+			log.Errorf("error removing container %s: %s", container.ID, err)
 		}
 	}
 
-	probe.Status = Probing
+	return nil
+}
 
-	for {
-		select {
-		case <-probe.close:
-			return
-		case <-probe.resume:
-			probe.Status = Probing
-			prober()
-		default:
-			prober()
+// labels identifies the container created by this beacon for a replica of the probe running a digest
+func (b *beacon) labels(probe *Probe, digest string, replica int) map[string]string {
+	return map[string]string{
+		oci.LabelBeaconID: b.ID,
+		oci.LabelProbe:    probe.Ref(),
+		oci.LabelDigest:   digest,
+		oci.LabelReplica:  strconv.Itoa(replica),
+	}
+}
+
+func (b *beacon) runOptions(probe *Probe, digest string, replica int) oci.RunOptions {
+	options := oci.RunOptions{
+		Resources: probe.Resources,
+		Labels:    b.labels(probe, digest, replica),
+	}
+
+	if probe.Route != nil {
+		options.Ports = []int{probe.Route.Port}
+	}
+
+	if len(probe.Env) > 0 {
+		options.Env = make(map[string]string, len(probe.Env))
+
+		for key, value := range probe.Env {
+			options.Env[key] = value
 		}
 	}
+
+	if b.Network != "" && !probe.NoNetwork {
+		options.Networks = []string{b.Network}
+		options.NetworkAliases = []string{probe.networkAlias()}
+	}
+
+	options.Networks = append(options.Networks, probe.Networks...)
+
+	return options
+}
+
+// runImage runs a container for a replica of the probe, with the probe's secrets injected into it and its volumes
+// mounted
+func (b *beacon) runImage(ctx context.Context, probe *Probe, digest string, replica int) (string, error) {
+	options := b.runOptions(probe, digest, replica)
+
+	if _, err := b.injectSecrets(probe, &options); err != nil {
+		return "", err
+	}
+
+	if err := b.mountVolumes(ctx, probe, probe.Volumes, &options); err != nil {
+		return "", err
+	}
+
+	return b.OCIClient.RunImage(ctx, probe.imageRef(digest), options)
+}
+
+// pullImage pulls the image, logged in to the registry if there are credentials for it
+func (b *beacon) pullImage(ctx context.Context, imageRef string) error {
+	credentials, err := b.RegistryClient.Credentials(ctx)
+
+	if err != nil {
+		return fmt.Errorf("error looking up credentials for %s: %s", b.RegistryClient.URL(), err)
+	}
+
+	var auth *oci.RegistryAuth
+
+	if credentials != nil {
+		auth = &oci.RegistryAuth{
+			Server:        credentials.ServerAddress,
+			Username:      credentials.Username,
+			Password:      credentials.Password,
+			IdentityToken: credentials.IdentityToken,
+		}
+	}
+
+	return b.OCIClient.PullImage(ctx, imageRef, auth)
+}
+
+// route points the probe's route at the replicas that are ready to serve it. Replicas being scaled away are left
+// out, so that they stop receiving requests before their containers are removed
+func (b *beacon) route(probe *Probe) {
+	if probe.Route == nil {
+		return
+	}
+
+	state := probe.State()
+	backends := []string{}
+
+	for replica, container := range state.Containers {
+		if replica < state.Replicas && container.Address != "" {
+			backends = append(backends, container.Address)
+		}
+	}
+
+	b.ReverseProxy.SetBackends(probe.Ref(), backends)
+}
+
+// StopProbe stops the probe and waits up to delay for it to finish. The probe is removed straight away, so that the
+// reconcile loop stops acting on it even if it takes longer than that
+func (b *beacon) StopProbe(namespace string, repo string, delay time.Duration) error {
+	probeRef := fmt.Sprintf("%s/%s", namespace, repo)
+
+	b.mu.Lock()
+	probe, ok := b.probes[probeRef]
+	delete(b.probes, probeRef)
+	b.mu.Unlock()
+
+	if !ok {
+		return BeaconErrorProbeDoesNotExist{fmt.Errorf("probe does not exist")}
+	}
+
+	b.saveState()
+	b.ReverseProxy.Remove(probeRef)
+	probe.Close()
+
+	select {
+	case <-probe.Done():
+		b.removeSecrets(probe)
+		return nil
+	case <-time.After(delay):
+		return fmt.Errorf("timed out stopping probe %s", probeRef)
+	}
 }
@@ -0,0 +1,611 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"beacon/beacond/registry"
+	"beacon/beacond/signature"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BeaconSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestBeaconSuite(t *testing.T) {
+	suite.Run(t, new(BeaconSuite))
+}
+
+func (b *BeaconSuite) SetupTest() {
+	b.LogBuff = new(bytes.Buffer)
+	log.SetOutput(b.LogBuff)
+}
+
+// start runs the beacon's reconcile loop, returning a function that stops it
+func (b *BeaconSuite) start(beacon *beacon) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		beacon.Start(ctx)
+	}()
+
+	return func() {
+		cancel()
+		<-stopped
+	}
+}
+
+func (b *BeaconSuite) TestStartProbeAlreadyExists() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	err := beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour)
+
+	assert.IsType(b.T(), BeaconErrorProbeAlreadyExists{}, err)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+}
+
+func (b *BeaconSuite) TestStartProbeWithUnknownSink() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registry.NewMockRegistry(mockController), nil, Config{}, host.Capacity{})
+
+	err := beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{Notify: []string{"ops"}}, time.Hour)
+
+	assert.IsType(b.T(), BeaconErrorUnknownSink{}, err)
+	assert.Empty(b.T(), beacon.probes)
+}
+
+func (b *BeaconSuite) TestStopProbeDoesNotExist() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registry.NewMockRegistry(mockController), nil, Config{}, host.Capacity{})
+
+	err := beacon.StopProbe("fakeNamespace", "fakeRepo", time.Second)
+
+	assert.IsType(b.T(), BeaconErrorProbeDoesNotExist{}, err)
+}
+
+func (b *BeaconSuite) TestOutdatedProbeIsDeployed() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	imageRef := "fakeNamespace/fakeRepo@fakeDigest"
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigest"}, nil).AnyTimes()
+	registryClient.EXPECT().Credentials(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, registryClient, nil, Config{}, host.Capacity{})
+	labels := map[string]string{
+		oci.LabelBeaconID: beacon.ID,
+		oci.LabelProbe:    "fakeNamespace/fakeRepo",
+		oci.LabelDigest:   "fakeDigest",
+		oci.LabelReplica:  "0",
+	}
+
+	ociClient.EXPECT().ListContainers(gomock.Any(), labels, []string{"running"}).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().PullImage(gomock.Any(), imageRef, nil).Return(nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), imageRef, oci.RunOptions{Labels: labels}).Return("fakeContainerId", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running"}, nil).AnyTimes()
+
+	stop := b.start(beacon)
+	defer stop()
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, 10*time.Millisecond))
+
+	probe, ok := beacon.GetProbe("fakeNamespace", "fakeRepo")
+	assert.True(b.T(), ok)
+
+	assert.Eventually(b.T(), func() bool {
+		state := probe.State()
+		return state.Status == Probing && state.CurrentDigest == "fakeDigest"
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(b.T(), []Replica{{ContainerID: "fakeContainerId", Digest: "fakeDigest"}}, probe.State().Containers)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+}
+
+// pendingProbe starts a probe that needs approval, waiting for it to find a digest to approve
+func (b *BeaconSuite) pendingProbe(beacon *beacon) *Probe {
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{RequireApproval: true}, time.Hour))
+
+	probe, ok := beacon.GetProbe("fakeNamespace", "fakeRepo")
+	assert.True(b.T(), ok)
+
+	assert.Eventually(b.T(), func() bool {
+		return probe.State().Status == PendingApproval
+	}, time.Second, 10*time.Millisecond)
+
+	return probe
+}
+
+func (b *BeaconSuite) TestDigestWaitsForApproval() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	image := registry.ImageDetails{Digest: "fakeDigest", Tag: "v2", Pushed: time.Unix(1700000000, 0)}
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(image, nil).AnyTimes()
+
+	// The reconcile loop isn't running, and the runtime is never called, so nothing is deployed until it is approved
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+	probe := b.pendingProbe(beacon)
+	state := probe.State()
+
+	assert.Equal(b.T(), &image, state.Candidate)
+	assert.Empty(b.T(), state.LatestDigest)
+	assert.Equal(b.T(), EventPendingApproval, state.Events[len(state.Events)-1].Reason)
+
+	_, err := beacon.ApproveProbe("fakeNamespace", "fakeRepo", "otherDigest")
+
+	assert.IsType(b.T(), BeaconErrorNotPendingApproval{}, err)
+
+	approved, err := beacon.ApproveProbe("fakeNamespace", "fakeRepo", "fakeDigest")
+
+	assert.NoError(b.T(), err)
+	assert.Equal(b.T(), "fakeDigest", approved)
+
+	state = probe.State()
+
+	assert.Equal(b.T(), Outdated, state.Status)
+	assert.Equal(b.T(), "fakeDigest", state.LatestDigest)
+	assert.Nil(b.T(), state.Candidate)
+
+	_, err = beacon.ApproveProbe("fakeNamespace", "fakeRepo", "")
+
+	assert.IsType(b.T(), BeaconErrorNotPendingApproval{}, err)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+}
+
+func (b *BeaconSuite) TestApprovedDigestIsNotOfferedAgainAfterRestart() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigest", Tag: "v2"}, nil).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil).AnyTimes()
+
+	stateFile := filepath.Join(b.T().TempDir(), "state.json")
+	beacon := newBeacon(ociClient, registryClient, nil, Config{StateFile: stateFile}, host.Capacity{})
+
+	b.pendingProbe(beacon)
+
+	_, err := beacon.ApproveProbe("fakeNamespace", "fakeRepo", "fakeDigest")
+
+	assert.NoError(b.T(), err)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+
+	// beacond is restarted before the approved digest was deployed
+	restored := newBeacon(ociClient, registryClient, nil, Config{StateFile: stateFile}, host.Capacity{})
+
+	assert.NoError(b.T(), restored.RestoreState(time.Hour))
+
+	probe, _ := restored.GetProbe("fakeNamespace", "fakeRepo")
+
+	assert.Eventually(b.T(), func() bool {
+		return probe.State().Status == Outdated
+	}, time.Second, 10*time.Millisecond)
+
+	state := probe.State()
+
+	assert.Equal(b.T(), "fakeDigest", state.LatestDigest)
+	assert.Nil(b.T(), state.Candidate)
+	assert.NoError(b.T(), restored.StopProbes(time.Second))
+}
+
+func (b *BeaconSuite) TestRejectedDigestIsSkipped() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	checked := make(chan struct{}, 10)
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").DoAndReturn(func(ctx context.Context, namespace string, repo string) (registry.ImageDetails, error) {
+		checked <- struct{}{}
+
+		return registry.ImageDetails{Digest: "fakeDigest", Tag: "v2"}, nil
+	}).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil).AnyTimes()
+
+	stateFile := filepath.Join(b.T().TempDir(), "state.json")
+	beacon := newBeacon(ociClient, registryClient, nil, Config{StateFile: stateFile}, host.Capacity{})
+	probe := b.pendingProbe(beacon)
+
+	<-checked
+
+	rejected, err := beacon.RejectProbe("fakeNamespace", "fakeRepo", "")
+
+	assert.NoError(b.T(), err)
+	assert.Equal(b.T(), "fakeDigest", rejected)
+
+	// The rejected digest is still the latest, but isn't offered for approval again
+	lastChecked := probe.State().LastChecked
+
+	probe.Resume()
+	<-checked
+
+	assert.Eventually(b.T(), func() bool {
+		return probe.State().LastChecked.After(lastChecked)
+	}, time.Second, 10*time.Millisecond)
+
+	state := probe.State()
+
+	assert.Equal(b.T(), Probing, state.Status)
+	assert.Equal(b.T(), "fakeDigest", state.RejectedDigest)
+	assert.Nil(b.T(), state.Candidate)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+
+	// Nor after a restart
+	restored := newBeacon(ociClient, registryClient, nil, Config{StateFile: stateFile}, host.Capacity{})
+
+	assert.NoError(b.T(), restored.RestoreState(time.Hour))
+
+	probe, _ = restored.GetProbe("fakeNamespace", "fakeRepo")
+
+	assert.Equal(b.T(), "fakeDigest", probe.State().RejectedDigest)
+	assert.NoError(b.T(), restored.StopProbes(time.Second))
+}
+
+func (b *BeaconSuite) TestSupersededDigestIsNotDeployed() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	gomock.InOrder(
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigestA"}, nil),
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigestB"}, nil).Times(3),
+	)
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Soak: 50 * time.Millisecond})
+	probe.update(func(s *ProbeState) { s.Status = Probing })
+
+	assert.False(b.T(), probe.probe(registryClient, nil))
+	assert.Equal(b.T(), Soaking, probe.State().Status)
+
+	// A digest pushed while another soaks replaces it, and has to soak for the whole period itself
+	assert.False(b.T(), probe.probe(registryClient, nil))
+	assert.False(b.T(), probe.probe(registryClient, nil))
+
+	state := probe.State()
+
+	assert.Equal(b.T(), "fakeDigestB", state.Soaking.Digest)
+	assert.Equal(b.T(), EventSuperseded, state.Events[1].Reason)
+	assert.Equal(b.T(), "fakeDigestA was superseded by fakeDigestB before it finished soaking", state.Events[1].Message)
+
+	time.Sleep(50 * time.Millisecond)
+
+	assert.True(b.T(), probe.probe(registryClient, nil))
+
+	state = probe.State()
+
+	assert.Equal(b.T(), Outdated, state.Status)
+	assert.Equal(b.T(), "fakeDigestB", state.LatestDigest)
+	assert.Nil(b.T(), state.Soaking)
+}
+
+func (b *BeaconSuite) TestDigestIsCheckedAgainWhenSoaked() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigest"}, nil).Times(2)
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+
+	// The probe isn't due to be checked again for an hour, but its digest is deployed once it has soaked
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{Soak: 50 * time.Millisecond}, time.Hour))
+
+	probe, _ := beacon.GetProbe("fakeNamespace", "fakeRepo")
+
+	assert.Eventually(b.T(), func() bool {
+		return probe.State().Status == Outdated
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(b.T(), beacon.StopProbes(time.Second))
+}
+
+func (b *BeaconSuite) TestVerifiedDigestIsNotVerifiedAgain() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "fakeDigest"}, nil).Times(4)
+
+	// A digest that fails verification is checked again, but once it has been verified it isn't while it soaks
+	verifier := signature.NewMockVerifier(mockController)
+	gomock.InOrder(
+		verifier.EXPECT().Verify(gomock.Any(), "fakeNamespace", "fakeRepo", "fakeDigest").Return(fmt.Errorf("fake error")),
+		verifier.EXPECT().Verify(gomock.Any(), "fakeNamespace", "fakeRepo", "fakeDigest").Return(nil),
+	)
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Soak: time.Hour})
+	probe.update(func(s *ProbeState) { s.Status = Probing })
+
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Unverified, probe.State().Status)
+
+	for i := 0; i < 3; i++ {
+		assert.False(b.T(), probe.probe(registryClient, verifier))
+	}
+
+	state := probe.State()
+
+	assert.Equal(b.T(), Soaking, state.Status)
+	assert.Equal(b.T(), "fakeDigest", state.VerifiedDigest)
+	assert.Equal(b.T(), EventVerified, state.Events[1].Reason)
+	assert.Equal(b.T(), 1, state.Events[1].Count)
+}
+
+func (b *BeaconSuite) TestUnverifiedClearsWhenLatestDigestIsTrustedAgain() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	// An unsigned digest is pushed and then removed, leaving the deployed digest as the latest. Then the same happens
+	// while a verified digest soaks
+	registryClient := registry.NewMockRegistry(mockController)
+	gomock.InOrder(
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "unsignedDigest"}, nil),
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "currentDigest"}, nil),
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "signedDigest"}, nil),
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "unsignedDigest"}, nil),
+		registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{Digest: "signedDigest"}, nil),
+	)
+
+	verifier := signature.NewMockVerifier(mockController)
+	verifier.EXPECT().Verify(gomock.Any(), "fakeNamespace", "fakeRepo", "unsignedDigest").Return(fmt.Errorf("fake error")).Times(2)
+	verifier.EXPECT().Verify(gomock.Any(), "fakeNamespace", "fakeRepo", "signedDigest").Return(nil)
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Soak: time.Hour})
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.CurrentDigest = "currentDigest"
+	})
+
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Unverified, probe.State().Status)
+
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Probing, probe.State().Status)
+
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Soaking, probe.State().Status)
+
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Unverified, probe.State().Status)
+
+	// The verified digest isn't verified again
+	assert.False(b.T(), probe.probe(registryClient, verifier))
+	assert.Equal(b.T(), Soaking, probe.State().Status)
+}
+
+func (b *BeaconSuite) TestStopProbeCancelsInFlightWork() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	checking := make(chan struct{})
+
+	// The registry hangs until the request is cancelled
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").DoAndReturn(func(ctx context.Context, namespace string, repo string) (registry.ImageDetails, error) {
+		close(checking)
+		<-ctx.Done()
+
+		return registry.ImageDetails{}, ctx.Err()
+	})
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	<-checking
+
+	assert.NoError(b.T(), beacon.StopProbe("fakeNamespace", "fakeRepo", time.Second))
+	assert.NotContains(b.T(), b.LogBuff.String(), "failed to get latest digest")
+}
+
+func (b *BeaconSuite) TestStopCancelsProbes() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	checking := make(chan struct{})
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").DoAndReturn(func(ctx context.Context, namespace string, repo string) (registry.ImageDetails, error) {
+		close(checking)
+		<-ctx.Done()
+
+		return registry.ImageDetails{}, ctx.Err()
+	})
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+	stop := b.start(beacon)
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	<-checking
+	stop()
+
+	probe, _ := beacon.GetProbe("fakeNamespace", "fakeRepo")
+
+	select {
+	case <-probe.Done():
+	case <-time.After(time.Second):
+		b.T().Error("probe was not stopped when the beacon was stopped")
+	}
+}
+
+// Probes are created and deleted by API handlers while the reconcile loop deploys them, so this is run with -race
+func (b *BeaconSuite) TestConcurrentProbes() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{Digest: "fakeDigest"}, nil).AnyTimes()
+	registryClient.EXPECT().Credentials(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil).AnyTimes()
+	ociClient.EXPECT().PullImage(gomock.Any(), gomock.Any(), nil).Return(nil).AnyTimes()
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("fakeContainerId", nil).AnyTimes()
+	ociClient.EXPECT().InspectContainer(gomock.Any(), gomock.Any()).Return(oci.ContainerState{Status: "running"}, nil).AnyTimes()
+
+	beacon := newBeacon(ociClient, registryClient, nil, Config{}, host.Capacity{})
+	stop := b.start(beacon)
+	defer stop()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			repo := fmt.Sprintf("fakeRepo%d", i)
+
+			for j := 0; j < 20; j++ {
+				assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", repo, ProbeOptions{}, time.Millisecond))
+
+				beacon.ListProbes()
+				beacon.Allocated()
+
+				if probe, ok := beacon.GetProbe("fakeNamespace", repo); ok {
+					probe.State()
+				}
+
+				time.Sleep(time.Millisecond)
+
+				assert.NoError(b.T(), beacon.StopProbe("fakeNamespace", repo, time.Second))
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	assert.Empty(b.T(), beacon.ListProbes())
+}
+
+func (b *BeaconSuite) TestShutdownCleansUpAndSavesState() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+
+	stateFile := filepath.Join(b.T().TempDir(), "state.json")
+	beacon := newBeacon(ociClient, registryClient, nil, Config{CleanOnExit: true, StateFile: stateFile}, host.Capacity{})
+
+	// Only the containers labelled with this beacon's ID are stopped and removed
+	ociClient.EXPECT().ListContainers(gomock.Any(), map[string]string{oci.LabelBeaconID: beacon.ID}, nil).Return([]oci.Container{{ID: "fakeContainerId", Status: "running"}}, nil)
+	ociClient.EXPECT().StopContainer(gomock.Any(), "fakeContainerId").Return(nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainerId").Return(nil)
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	probe, _ := beacon.GetProbe("fakeNamespace", "fakeRepo")
+	probe.update(func(s *ProbeState) { s.CurrentDigest = "fakeDigest" })
+
+	assert.NoError(b.T(), beacon.Shutdown(time.Now().Add(time.Second)))
+
+	select {
+	case <-probe.Done():
+	default:
+		b.T().Error("probe was not stopped on shutdown")
+	}
+
+	content, err := os.ReadFile(stateFile)
+
+	assert.NoError(b.T(), err)
+	assert.Contains(b.T(), string(content), `"repo": "fakeRepo"`)
+	assert.Contains(b.T(), string(content), fmt.Sprintf(`"beacon_id": "%s"`, beacon.ID))
+}
+
+func (b *BeaconSuite) TestShutdownDeadlineIsShared() {
+	shutdown := &shutdownDeadline{gracePeriod: time.Minute}
+	deadline := shutdown.Time()
+
+	assert.WithinDuration(b.T(), time.Now().Add(time.Minute), deadline, time.Second)
+
+	// Stages that start shutting down later get what is left of the same grace period
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Equal(b.T(), deadline, shutdown.Time())
+}
+
+func (b *BeaconSuite) TestProxyWaitsForARoute() {
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error)
+
+	go func() { served <- serveProxy(ctx, proxy.New(), proxy.Listen{}, time.Now) }()
+
+	// Without an address and a probe with a route, nothing is listened on until beacond shuts down
+	select {
+	case err := <-served:
+		b.T().Fatalf("proxy stopped before shutting down: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+
+	assert.NoError(b.T(), <-served)
+}
+
+func (b *BeaconSuite) TestShutdownTimesOut() {
+	mockController := gomock.NewController(b.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), "fakeNamespace", "fakeRepo").Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	// The runtime ignores cancellation, so stopping the containers outlasts the grace period
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{{ID: "fakeContainerId", Status: "running"}}, nil)
+	ociClient.EXPECT().StopContainer(gomock.Any(), "fakeContainerId").DoAndReturn(func(ctx context.Context, containerID string) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	beacon := newBeacon(ociClient, registryClient, nil, Config{CleanOnExit: true}, host.Capacity{})
+
+	assert.NoError(b.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	probe, _ := beacon.GetProbe("fakeNamespace", "fakeRepo")
+	probe.update(func(s *ProbeState) { s.CurrentDigest = "fakeDigest" })
+
+	err := beacon.Shutdown(time.Now().Add(50 * time.Millisecond))
+
+	assert.ErrorContains(b.T(), err, "timed out stopping managed containers")
+}
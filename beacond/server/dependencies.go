@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type BeaconErrorInvalidDependency struct{ error }
+
+// checkDependencies returns an error if a dependency isn't a probe ref, or if the probe would end up depending on
+// itself, directly or through other probes. Dependencies don't have to exist yet, so that probes can be created in any
+// order. The caller must hold the beacon's lock
+func (b *beacon) checkDependencies(probeRef string, dependsOn []string) error {
+	for _, dependency := range dependsOn {
+		namespace, repo, ok := strings.Cut(dependency, "/")
+
+		if !ok || namespace == "" || repo == "" {
+			return BeaconErrorInvalidDependency{fmt.Errorf("expected dependency as <namespace>/<repo>, got %q", dependency)}
+		}
+
+		if path := b.dependencyPath(dependency, probeRef, map[string]bool{}); path != nil {
+			cycle := append([]string{probeRef}, path...)
+
+			return BeaconErrorInvalidDependency{fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))}
+		}
+	}
+
+	return nil
+}
+
+// dependencyPath returns the chain of dependencies that leads from one probe to another, or nil if there isn't one.
+// The caller must hold the beacon's lock
+func (b *beacon) dependencyPath(from string, to string, visited map[string]bool) []string {
+	if from == to {
+		return []string{from}
+	}
+
+	probe, ok := b.probes[from]
+
+	if !ok || visited[from] {
+		return nil
+	}
+
+	visited[from] = true
+
+	for _, dependency := range probe.DependsOn {
+		if path := b.dependencyPath(dependency, to, visited); path != nil {
+			return append([]string{from}, path...)
+		}
+	}
+
+	return nil
+}
+
+// orderProbes sorts the probes so that each comes after the probes it depends on, and otherwise by ref, so that the
+// reconcile loop deploys dependencies first
+func orderProbes(probes []*Probe) []*Probe {
+	byRef := make(map[string]*Probe, len(probes))
+	refs := make([]string, 0, len(probes))
+
+	for _, probe := range probes {
+		byRef[probe.Ref()] = probe
+		refs = append(refs, probe.Ref())
+	}
+
+	sort.Strings(refs)
+
+	ordered := make([]*Probe, 0, len(probes))
+	visited := make(map[string]bool, len(probes))
+
+	var visit func(string)
+
+	visit = func(probeRef string) {
+		probe, ok := byRef[probeRef]
+
+		if !ok || visited[probeRef] {
+			return
+		}
+
+		visited[probeRef] = true
+
+		for _, dependency := range probe.DependsOn {
+			visit(dependency)
+		}
+
+		ordered = append(ordered, probe)
+	}
+
+	for _, probeRef := range refs {
+		visit(probeRef)
+	}
+
+	return ordered
+}
+
+// dependenciesRunning returns an error naming the first of the probe's dependencies that isn't running yet, is being
+// deployed, or has a replica that isn't ready. Replicas are ready by the same criteria as a new container during a
+// rollout, so a dependency that is crash looping or still starting up holds its dependents back
+func (b *beacon) dependenciesRunning(probe *Probe) error {
+	ctx, cancel := context.WithTimeout(probe.ctx, healTimeout)
+	defer cancel()
+
+	for _, dependency := range probe.DependsOn {
+		namespace, repo, _ := strings.Cut(dependency, "/")
+		dependencyProbe, ok := b.GetProbe(namespace, repo)
+
+		if !ok {
+			return fmt.Errorf("dependency %s does not exist", dependency)
+		}
+
+		if dependencyProbe.isDeploying() {
+			return fmt.Errorf("dependency %s is being deployed", dependency)
+		}
+
+		state := dependencyProbe.State()
+
+		if !state.running() {
+			return fmt.Errorf("dependency %s is not running yet", dependency)
+		}
+
+		if err := b.replicasReady(ctx, dependencyProbe, state); err != nil {
+			return fmt.Errorf("dependency %s is not ready: %s", dependency, err)
+		}
+	}
+
+	return nil
+}
+
+// replicasReady returns an error for the first of the probe's replicas whose container isn't ready
+func (b *beacon) replicasReady(ctx context.Context, probe *Probe, state ProbeState) error {
+	for _, container := range state.Containers[:state.Replicas] {
+		containerState, err := b.OCIClient.InspectContainer(ctx, container.ContainerID)
+
+		if err != nil {
+			return fmt.Errorf("error checking container %s: %s", container.ContainerID, err)
+		}
+
+		_, ready, err := readiness(ctx, probe, container.ContainerID, containerState)
+
+		if err != nil {
+			return err
+		}
+
+		if !ready {
+			return fmt.Errorf("container %s is not ready yet", container.ContainerID)
+		}
+	}
+
+	return nil
+}
+
+// running reports whether the probe has been deployed and has a container for each of its replicas
+func (s ProbeState) running() bool {
+	if s.CurrentDigest == "" || len(s.Containers) < s.Replicas {
+		return false
+	}
+
+	for _, container := range s.Containers[:s.Replicas] {
+		if container.ContainerID == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// restartDependents asks the probes that depend on the probe, and asked to be restarted when it is redeployed, to
+// restart their containers so that they reconnect to its new containers. The restarts are left to the reconcile loop,
+// so that they don't race with a deploy of the dependent
+func (b *beacon) restartDependents(probe *Probe, digest string) {
+	for _, dependent := range b.snapshot() {
+		if dependent.RestartWithDependencies && dependent.dependsOn(probe.Ref()) {
+			dependent.requestRestart(fmt.Sprintf("%s was redeployed with %s", probe.Ref(), digest))
+		}
+	}
+}
+
+// restartForDependencies restarts the probe's containers if any of its dependencies have been redeployed since they
+// were last restarted
+func (b *beacon) restartForDependencies(probe *Probe) {
+	reasons := probe.takeRestartRequests()
+
+	if len(reasons) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(probe.ctx, healTimeout)
+	defer cancel()
+
+	for replica, container := range probe.State().Containers {
+		if container.ContainerID == "" {
+			continue
+		}
+
+		err := b.OCIClient.StopContainer(ctx, container.ContainerID)
+
+		if err == nil {
+			err = b.OCIClient.StartContainer(ctx, container.ContainerID)
+		}
+
+		if err != nil {
+			probe.RecordEvent(EventRestartFailed, fmt.Sprintf("error restarting container %s of replica %d: %s", container.ContainerID, replica, err))
+		}
+	}
+
+	probe.RecordEvent(EventDependencyRedeployed, fmt.Sprintf("restarted after %s", strings.Join(reasons, " and ")))
+}
+
+// dependsOn reports whether the probe declared a dependency on the probe with the ref
+func (p *Probe) dependsOn(probeRef string) bool {
+	for _, dependency := range p.DependsOn {
+		if dependency == probeRef {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,322 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DependenciesSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestDependenciesSuite(t *testing.T) {
+	suite.Run(t, new(DependenciesSuite))
+}
+
+func (d *DependenciesSuite) SetupTest() {
+	d.LogBuff = new(bytes.Buffer)
+	log.SetOutput(d.LogBuff)
+}
+
+// probe creates a probe for the repo that isn't running, so that the test decides what state it is in
+func (d *DependenciesSuite) probe(beacon *beacon, repo string, options ProbeOptions) *Probe {
+	probe := NewProbe(context.Background(), "fakeNamespace", repo, options)
+	beacon.probes[probe.Ref()] = probe
+
+	return probe
+}
+
+// running makes the probe's only replica a container running digest, which isn't due to be checked on
+func (d *DependenciesSuite) running(probe *Probe, containerID string, digest string) {
+	probe.setReplica(0, Replica{ContainerID: containerID, Digest: digest})
+	probe.healState(0).checkedAt = time.Now()
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.CurrentDigest = digest
+		s.LatestDigest = digest
+	})
+}
+
+func (d *DependenciesSuite) TestCyclesAreRejected() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+
+	// Dependencies don't have to exist yet
+	assert.NoError(d.T(), beacon.StartProbe("fakeNamespace", "app", ProbeOptions{DependsOn: []string{"fakeNamespace/worker"}}, time.Hour))
+	assert.NoError(d.T(), beacon.StartProbe("fakeNamespace", "worker", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}}, time.Hour))
+
+	err := beacon.StartProbe("fakeNamespace", "redis", ProbeOptions{DependsOn: []string{"fakeNamespace/app"}}, time.Hour)
+
+	assert.IsType(d.T(), BeaconErrorInvalidDependency{}, err)
+	assert.EqualError(d.T(), err, "dependency cycle: fakeNamespace/redis -> fakeNamespace/app -> fakeNamespace/worker -> fakeNamespace/redis")
+
+	err = beacon.StartProbe("fakeNamespace", "redis", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}}, time.Hour)
+
+	assert.IsType(d.T(), BeaconErrorInvalidDependency{}, err)
+
+	err = beacon.StartProbe("fakeNamespace", "redis", ProbeOptions{DependsOn: []string{"redis"}}, time.Hour)
+
+	assert.IsType(d.T(), BeaconErrorInvalidDependency{}, err)
+	assert.Equal(d.T(), []string{"fakeNamespace/app", "fakeNamespace/worker"}, beacon.ListProbes())
+	assert.NoError(d.T(), beacon.StopProbes(time.Second))
+}
+
+func (d *DependenciesSuite) TestProbesAreOrderedByDependency() {
+	probes := []*Probe{
+		NewProbe(context.Background(), "fakeNamespace", "app", ProbeOptions{DependsOn: []string{"fakeNamespace/worker", "fakeNamespace/db"}}),
+		NewProbe(context.Background(), "fakeNamespace", "worker", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}}),
+		NewProbe(context.Background(), "fakeNamespace", "redis", ProbeOptions{}),
+		NewProbe(context.Background(), "fakeNamespace", "db", ProbeOptions{DependsOn: []string{"fakeNamespace/missing"}}),
+		NewProbe(context.Background(), "fakeNamespace", "blog", ProbeOptions{}),
+	}
+
+	var refs []string
+
+	for _, probe := range orderProbes(probes) {
+		refs = append(refs, probe.Repo)
+	}
+
+	assert.Equal(d.T(), []string{"redis", "worker", "db", "app", "blog"}, refs)
+}
+
+func (d *DependenciesSuite) TestDependentWaitsForDependency() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	redis := d.probe(beacon, "redis", ProbeOptions{})
+	app := d.probe(beacon, "app", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}})
+	app.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "appDigest"
+	})
+
+	// Nothing is run for the app while redis hasn't been deployed
+	beacon.reconcile()
+
+	state := app.State()
+
+	assert.Equal(d.T(), Outdated, state.Status)
+	assert.Equal(d.T(), EventWaitingForDependency, state.Events[0].Reason)
+	assert.Equal(d.T(), "waiting to deploy appDigest: dependency fakeNamespace/redis is not running yet", state.Events[0].Message)
+
+	d.running(redis, "redisContainer", "redisDigest")
+
+	// Nor while redis has a container that is still starting up
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "redisContainer").Return(oci.ContainerState{Status: "running", Health: "starting"}, nil)
+
+	beacon.reconcile()
+
+	state = app.State()
+
+	assert.Equal(d.T(), Outdated, state.Status)
+	assert.Equal(d.T(), "waiting to deploy appDigest: dependency fakeNamespace/redis is not ready: container redisContainer is not ready yet", state.Events[len(state.Events)-1].Message)
+
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "redisContainer").Return(oci.ContainerState{Status: "running", Health: "healthy"}, nil)
+	ociClient.EXPECT().PullImage(gomock.Any(), "fakeNamespace/app@appDigest", nil).Return(nil)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), []string{"running"}).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/app@appDigest", gomock.Any()).Return("appContainer", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "appContainer").Return(oci.ContainerState{Status: "running"}, nil)
+
+	beacon.reconcile()
+	beacon.deploys.Wait()
+
+	assert.Equal(d.T(), "appDigest", app.State().CurrentDigest)
+}
+
+func (d *DependenciesSuite) TestDependentWaitsForCrashingDependency() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	redis := d.probe(beacon, "redis", ProbeOptions{})
+	app := d.probe(beacon, "app", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}})
+
+	d.running(redis, "redisContainer", "redisDigest")
+	app.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "appDigest"
+	})
+
+	// Redis has a container, but it has exited and is waiting to be restarted
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "redisContainer").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil)
+
+	beacon.reconcile()
+
+	state := app.State()
+
+	assert.Equal(d.T(), Outdated, state.Status)
+	assert.Equal(d.T(), "waiting to deploy appDigest: dependency fakeNamespace/redis is not ready: container redisContainer exited with code 1", state.Events[0].Message)
+}
+
+func (d *DependenciesSuite) TestSlowDeployDoesNotHoldUpOtherProbes() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	redis := d.probe(beacon, "redis", ProbeOptions{})
+	app := d.probe(beacon, "app", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}})
+	blog := d.probe(beacon, "blog", ProbeOptions{})
+
+	d.running(redis, "oldRedisContainer", "oldDigest")
+	d.running(app, "appContainer", "appDigest")
+	d.running(blog, "blogContainer", "blogDigest")
+
+	redis.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "newDigest"
+	})
+	app.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "newAppDigest"
+	})
+
+	// The blog's container is due to be checked, and has exited
+	blog.healState(0).checkedAt = time.Time{}
+
+	pulling := make(chan struct{})
+	pulled := make(chan struct{})
+
+	ociClient.EXPECT().PullImage(gomock.Any(), "fakeNamespace/redis@newDigest", nil).DoAndReturn(func(ctx context.Context, imageRef string, auth *oci.RegistryAuth) error {
+		close(pulling)
+		<-pulled
+
+		return fmt.Errorf("fake error")
+	})
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "blogContainer").Return(oci.ContainerState{Status: "exited"}, nil)
+	ociClient.EXPECT().StartContainer(gomock.Any(), "blogContainer").Return(nil)
+
+	beacon.reconcile()
+	<-pulling
+
+	// The blog is healed while redis is still being pulled, and the app waits for redis to be deployed first
+	assert.Equal(d.T(), 1, blog.State().Restarts)
+	assert.Equal(d.T(), "waiting to deploy newAppDigest: dependency fakeNamespace/redis is being deployed", app.State().Events[0].Message)
+
+	// Redis isn't deployed a second time while its deploy is running
+	beacon.reconcile()
+
+	close(pulled)
+	beacon.deploys.Wait()
+
+	assert.False(d.T(), redis.isDeploying())
+	assert.Equal(d.T(), "error pulling fakeNamespace/redis@newDigest: fake error", redis.State().LastDeploy.Error)
+}
+
+func (d *DependenciesSuite) TestDependentsRestartWhenDependencyIsRedeployed() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	redis := d.probe(beacon, "redis", ProbeOptions{})
+	app := d.probe(beacon, "app", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}, RestartWithDependencies: true})
+	worker := d.probe(beacon, "worker", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}})
+
+	d.running(redis, "oldRedisContainer", "oldDigest")
+	d.running(app, "appContainer", "appDigest")
+	d.running(worker, "workerContainer", "workerDigest")
+
+	redis.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "newDigest"
+	})
+
+	gomock.InOrder(
+		ociClient.EXPECT().PullImage(gomock.Any(), "fakeNamespace/redis@newDigest", nil).Return(nil),
+		ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), []string{"running"}).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/redis@newDigest", gomock.Any()).Return("newRedisContainer", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newRedisContainer").Return(oci.ContainerState{Status: "running"}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldRedisContainer").Return(nil),
+	)
+
+	beacon.deploy(redis, redis.State())
+
+	// Only the app asked to be restarted, and it is by the next pass of the reconcile loop
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newRedisContainer").Return(oci.ContainerState{Status: "running"}, nil)
+	gomock.InOrder(
+		ociClient.EXPECT().StopContainer(gomock.Any(), "appContainer").Return(nil),
+		ociClient.EXPECT().StartContainer(gomock.Any(), "appContainer").Return(nil),
+	)
+
+	beacon.reconcile()
+
+	events := app.State().Events
+
+	assert.Equal(d.T(), EventDependencyRedeployed, events[len(events)-1].Reason)
+	assert.Equal(d.T(), "restarted after fakeNamespace/redis was redeployed with newDigest", events[len(events)-1].Message)
+	assert.Empty(d.T(), worker.State().Events)
+
+	// The restart isn't repeated
+	beacon.reconcile()
+}
+
+func (d *DependenciesSuite) TestDependentIsNotRestartedWhileBeingDeployed() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	redis := d.probe(beacon, "redis", ProbeOptions{})
+	app := d.probe(beacon, "app", ProbeOptions{DependsOn: []string{"fakeNamespace/redis"}, RestartWithDependencies: true})
+
+	d.running(redis, "oldRedisContainer", "oldDigest")
+	d.running(app, "appContainer", "appDigest")
+
+	redis.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.LatestDigest = "newDigest"
+	})
+
+	// The app is in the middle of its own deploy when redis is redeployed
+	app.setDeploying(true)
+
+	gomock.InOrder(
+		ociClient.EXPECT().PullImage(gomock.Any(), "fakeNamespace/redis@newDigest", nil).Return(nil),
+		ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), []string{"running"}).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/redis@newDigest", gomock.Any()).Return("newRedisContainer", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newRedisContainer").Return(oci.ContainerState{Status: "running"}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldRedisContainer").Return(nil),
+	)
+
+	beacon.deploy(redis, redis.State())
+
+	// The app's containers are left to its deploy
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newRedisContainer").Return(oci.ContainerState{Status: "running"}, nil)
+
+	beacon.reconcile()
+
+	assert.Empty(d.T(), app.State().Events)
+
+	// and restarted once it is done
+	app.setDeploying(false)
+
+	gomock.InOrder(
+		ociClient.EXPECT().StopContainer(gomock.Any(), "appContainer").Return(nil),
+		ociClient.EXPECT().StartContainer(gomock.Any(), "appContainer").Return(nil),
+	)
+
+	beacon.reconcile()
+
+	assert.Equal(d.T(), EventDependencyRedeployed, app.State().Events[0].Reason)
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The fields probes can be sorted by when they are queried
+const (
+	SortByName        ProbeSortKey = "name"
+	SortByStatus      ProbeSortKey = "status"
+	SortByLastChecked ProbeSortKey = "last_checked"
+	SortByLastUpdated ProbeSortKey = "last_updated"
+	SortByRestarts    ProbeSortKey = "restarts"
+)
+
+type ProbeSortKey string
+
+// ProbeStatuses are the statuses a probe can be in
+var ProbeStatuses = []ProbeStatus{Probing, Outdated, Starting, Exited, Unverified, Soaking, PendingApproval}
+
+// failureReasons are the events that record something going wrong for a probe
+var failureReasons = map[EventReason]bool{
+	EventVerificationFailed: true,
+	EventRestartFailed:      true,
+	EventRolloutFailed:      true,
+	EventScaleFailed:        true,
+	EventHookFailed:         true,
+}
+
+// ProbeQuery selects which probes are listed, in what order. A zero query lists every probe by name
+type ProbeQuery struct {
+	// Only probes with one of the statuses are listed. Every probe is if it is empty
+	Statuses []ProbeStatus
+	SortBy   ProbeSortKey
+	// Whether the probes are listed in reverse order
+	Descending bool
+	// The number of probes skipped, and the most listed after them. All of them are listed if Limit is zero
+	Offset int
+	Limit  int
+}
+
+// ProbeSummary is the state of a probe at a glance, as listed
+type ProbeSummary struct {
+	Namespace     string
+	Repo          string
+	Status        ProbeStatus
+	CurrentDigest string
+	LatestDigest  string
+	// The number of replicas the probe should run, and how many of them have a container
+	Replicas    int
+	Running     int
+	Restarts    int
+	LastChecked time.Time
+	LastUpdated time.Time
+	// The latest event recording something going wrong, if any
+	LastError *Event
+}
+
+// ProbeDetails describes a probe in full: how it was configured, its state and its containers as the runtime sees
+// them
+type ProbeDetails struct {
+	Namespace  string
+	Repo       string
+	Options    ProbeOptions
+	State      ProbeState
+	Containers []ContainerDetails
+}
+
+// ContainerDetails is one of a probe's replicas, with its container's state. Error is set instead of the state if
+// the container couldn't be inspected
+type ContainerDetails struct {
+	Replica
+	Index     int
+	Status    string
+	Health    string
+	ExitCode  int
+	StartedAt time.Time
+	Error     string
+}
+
+// ValidSortKey reports whether probes can be sorted by the key
+func ValidSortKey(key ProbeSortKey) bool {
+	switch key {
+	case SortByName, SortByStatus, SortByLastChecked, SortByLastUpdated, SortByRestarts:
+		return true
+	}
+
+	return false
+}
+
+// QueryProbes lists the probes matching the query, returning the page asked for and how many matched in total
+func (b *beacon) QueryProbes(query ProbeQuery) ([]ProbeSummary, int) {
+	b.mu.RLock()
+	probes := make([]*Probe, 0, len(b.probes))
+
+	for _, probe := range b.probes {
+		probes = append(probes, probe)
+	}
+
+	b.mu.RUnlock()
+
+	summaries := []ProbeSummary{}
+
+	for _, probe := range probes {
+		summary := summarise(probe.Namespace, probe.Repo, probe.State())
+
+		if len(query.Statuses) == 0 || containsStatus(query.Statuses, summary.Status) {
+			summaries = append(summaries, summary)
+		}
+	}
+
+	sortSummaries(summaries, query.SortBy, query.Descending)
+
+	total := len(summaries)
+	start, end := query.Offset, total
+
+	if start > total {
+		start = total
+	}
+
+	if query.Limit > 0 && start+query.Limit < total {
+		end = start + query.Limit
+	}
+
+	return summaries[start:end], total
+}
+
+// DescribeProbe describes the probe, inspecting each of its containers
+func (b *beacon) DescribeProbe(namespace string, repo string) (ProbeDetails, error) {
+	probe, ok := b.GetProbe(namespace, repo)
+
+	if !ok {
+		return ProbeDetails{}, BeaconErrorProbeDoesNotExist{fmt.Errorf("probe %s/%s does not exist", namespace, repo)}
+	}
+
+	state := probe.State()
+	details := ProbeDetails{
+		Namespace:  namespace,
+		Repo:       repo,
+		Options:    probe.Options(),
+		State:      state,
+		Containers: []ContainerDetails{},
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, describeTimeout)
+	defer cancel()
+
+	for i, replica := range state.Containers {
+		container := ContainerDetails{Replica: replica, Index: i}
+
+		if replica.ContainerID == "" {
+			details.Containers = append(details.Containers, container)
+			continue
+		}
+
+		inspected, err := b.OCIClient.InspectContainer(ctx, replica.ContainerID)
+
+		if err != nil {
+			container.Error = err.Error()
+		} else {
+			container.Status = inspected.Status
+			container.Health = inspected.Health
+			container.ExitCode = inspected.ExitCode
+			container.StartedAt = inspected.StartedAt
+		}
+
+		details.Containers = append(details.Containers, container)
+	}
+
+	return details, nil
+}
+
+// summarise sums up the state of a probe
+func summarise(namespace string, repo string, state ProbeState) ProbeSummary {
+	summary := ProbeSummary{
+		Namespace:     namespace,
+		Repo:          repo,
+		Status:        state.Status,
+		CurrentDigest: state.CurrentDigest,
+		LatestDigest:  state.LatestDigest,
+		Replicas:      state.Replicas,
+		Restarts:      state.Restarts,
+		LastChecked:   state.LastChecked,
+		LastUpdated:   state.LastUpdated,
+		LastError:     lastError(state.Events),
+	}
+
+	for _, replica := range state.Containers {
+		if replica.ContainerID != "" {
+			summary.Running++
+		}
+	}
+
+	return summary
+}
+
+// lastError finds the latest event recording something going wrong
+func lastError(events []Event) *Event {
+	var last *Event
+
+	for i := range events {
+		if failureReasons[events[i].Reason] && (last == nil || !events[i].LastSeen.Before(last.LastSeen)) {
+			event := events[i]
+			last = &event
+		}
+	}
+
+	return last
+}
+
+// sortSummaries sorts probes by the key, falling back on their name so that the order is stable across pages
+func sortSummaries(summaries []ProbeSummary, key ProbeSortKey, descending bool) {
+	sort.SliceStable(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+
+		if descending {
+			a, b = b, a
+		}
+
+		var c int
+
+		switch key {
+		case SortByStatus:
+			c = strings.Compare(string(a.Status), string(b.Status))
+		case SortByLastChecked:
+			c = a.LastChecked.Compare(b.LastChecked)
+		case SortByLastUpdated:
+			c = a.LastUpdated.Compare(b.LastUpdated)
+		case SortByRestarts:
+			c = a.Restarts - b.Restarts
+		}
+
+		if c != 0 {
+			return c < 0
+		}
+
+		return a.Namespace+"/"+a.Repo < b.Namespace+"/"+b.Repo
+	})
+}
+
+func containsStatus(statuses []ProbeStatus, status ProbeStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
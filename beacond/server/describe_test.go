@@ -0,0 +1,150 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DescribeSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestDescribeSuite(t *testing.T) {
+	suite.Run(t, new(DescribeSuite))
+}
+
+func (d *DescribeSuite) SetupTest() {
+	d.LogBuff = new(bytes.Buffer)
+	log.SetOutput(d.LogBuff)
+}
+
+// newBeacon creates a beacon whose probes exit on their first check, so that their state is only what the test sets
+func (d *DescribeSuite) newBeacon(mockController *gomock.Controller, ociClient *oci.MockOCIRuntime) *beacon {
+	registryClient := anonymousRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	return newBeacon(ociClient, registryClient, nil, Config{}, host.Capacity{})
+}
+
+// startProbe starts a probe and sets its state once it has exited
+func (d *DescribeSuite) startProbe(b *beacon, repo string, f func(*ProbeState)) {
+	assert.NoError(d.T(), b.StartProbe("fakeNamespace", repo, ProbeOptions{}, time.Hour))
+
+	probe, _ := b.GetProbe("fakeNamespace", repo)
+
+	assert.Eventually(d.T(), func() bool { return probe.State().Status == Exited }, time.Second, time.Millisecond)
+	probe.update(f)
+}
+
+func (d *DescribeSuite) TestQueryProbes() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	b := d.newBeacon(mockController, oci.NewMockOCIRuntime(mockController))
+	now := time.Now()
+
+	d.startProbe(b, "fakeRepoA", func(s *ProbeState) {
+		s.Restarts = 3
+		s.LastUpdated = now.Add(-time.Hour)
+	})
+	d.startProbe(b, "fakeRepoB", func(s *ProbeState) {
+		s.Status = Probing
+		s.Restarts = 1
+		s.LastUpdated = now
+	})
+	d.startProbe(b, "fakeRepoC", func(s *ProbeState) {
+		s.LastUpdated = now.Add(-time.Minute)
+	})
+
+	names := func(summaries []ProbeSummary) []string {
+		repos := []string{}
+
+		for _, summary := range summaries {
+			repos = append(repos, summary.Repo)
+		}
+
+		return repos
+	}
+
+	tests := []struct {
+		query ProbeQuery
+		repos []string
+		total int
+	}{
+		{ProbeQuery{}, []string{"fakeRepoA", "fakeRepoB", "fakeRepoC"}, 3},
+		{ProbeQuery{Statuses: []ProbeStatus{Exited}}, []string{"fakeRepoA", "fakeRepoC"}, 2},
+		{ProbeQuery{Statuses: []ProbeStatus{Exited, Probing}, SortBy: SortByStatus}, []string{"fakeRepoA", "fakeRepoC", "fakeRepoB"}, 3},
+		{ProbeQuery{SortBy: SortByRestarts, Descending: true}, []string{"fakeRepoA", "fakeRepoB", "fakeRepoC"}, 3},
+		{ProbeQuery{SortBy: SortByLastUpdated}, []string{"fakeRepoA", "fakeRepoC", "fakeRepoB"}, 3},
+		{ProbeQuery{Offset: 1, Limit: 1}, []string{"fakeRepoB"}, 3},
+		{ProbeQuery{Offset: 2, Limit: 5}, []string{"fakeRepoC"}, 3},
+		{ProbeQuery{Offset: 5}, []string{}, 3},
+		{ProbeQuery{Statuses: []ProbeStatus{PendingApproval}}, []string{}, 0},
+	}
+
+	for _, test := range tests {
+		summaries, total := b.QueryProbes(test.query)
+
+		assert.Equal(d.T(), test.repos, names(summaries), "%+v", test.query)
+		assert.Equal(d.T(), test.total, total, "%+v", test.query)
+	}
+
+	assert.NoError(d.T(), b.StopProbes(time.Second))
+}
+
+func (d *DescribeSuite) TestDescribeProbe() {
+	mockController := gomock.NewController(d.T())
+	defer mockController.Finish()
+
+	started := time.Now().Add(-time.Minute)
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerA").Return(oci.ContainerState{ID: "fakeContainerA", Status: "running", Health: "healthy", StartedAt: started}, nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerB").Return(oci.ContainerState{}, fmt.Errorf("fake error"))
+
+	b := d.newBeacon(mockController, ociClient)
+
+	_, err := b.DescribeProbe("fakeNamespace", "fakeRepo")
+	assert.ErrorAs(d.T(), err, &BeaconErrorProbeDoesNotExist{})
+
+	d.startProbe(b, "fakeRepo", func(s *ProbeState) {
+		s.Replicas = 3
+		s.CurrentDigest = "fakeDigest"
+		s.Containers = []Replica{
+			{ContainerID: "fakeContainerA", Digest: "fakeDigest"},
+			{ContainerID: "fakeContainerB", Digest: "fakeDigest"},
+			{},
+		}
+	})
+
+	probe, _ := b.GetProbe("fakeNamespace", "fakeRepo")
+	probe.RecordEvent(EventRolloutFailed, "fake rollout error")
+	probe.RecordEvent(EventScaled, "scaled to 3 replicas")
+
+	details, err := b.DescribeProbe("fakeNamespace", "fakeRepo")
+
+	assert.NoError(d.T(), err)
+	assert.Equal(d.T(), 3, details.Options.Replicas)
+	assert.Equal(d.T(), []ContainerDetails{
+		{Replica: Replica{ContainerID: "fakeContainerA", Digest: "fakeDigest"}, Index: 0, Status: "running", Health: "healthy", StartedAt: started},
+		{Replica: Replica{ContainerID: "fakeContainerB", Digest: "fakeDigest"}, Index: 1, Error: "fake error"},
+		{Index: 2},
+	}, details.Containers)
+
+	summary := summarise("fakeNamespace", "fakeRepo", details.State)
+
+	assert.Equal(d.T(), 2, summary.Running)
+	assert.Equal(d.T(), EventRolloutFailed, summary.LastError.Reason)
+	assert.Equal(d.T(), "fake rollout error", summary.LastError.Message)
+	assert.NoError(d.T(), b.StopProbes(time.Second))
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// The number of events kept for each probe, after which the oldest are dropped
+const maxProbeEvents = 50
+
+const (
+	EventVerificationFailed   EventReason = "VerificationFailed"
+	EventVerified             EventReason = "Verified"
+	EventContainerRestarted   EventReason = "ContainerRestarted"
+	EventContainerRecreated   EventReason = "ContainerRecreated"
+	EventRestartFailed        EventReason = "RestartFailed"
+	EventContainerAdopted     EventReason = "ContainerAdopted"
+	EventRolloutFailed        EventReason = "RolloutFailed"
+	EventScaled               EventReason = "Scaled"
+	EventScaleFailed          EventReason = "ScaleFailed"
+	EventPendingApproval      EventReason = "PendingApproval"
+	EventSoaking              EventReason = "Soaking"
+	EventSuperseded           EventReason = "Superseded"
+	EventWaitingForDependency EventReason = "WaitingForDependency"
+	EventDependencyRedeployed EventReason = "DependencyRedeployed"
+	EventApproved             EventReason = "Approved"
+	EventRejected             EventReason = "Rejected"
+	EventHookFailed           EventReason = "HookFailed"
+)
+
+type EventReason string
+
+// Event records something notable that happened to a probe, such as a digest that was refused for deployment
+type Event struct {
+	Reason    EventReason
+	Message   string
+	Count     int
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// RecordEvent adds an event to the probe. Repeats of the latest event are folded into it so that a probe
+// failing the same way on every check doesn't push out older events
+func (p *Probe) RecordEvent(reason EventReason, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.recordEvent(reason, message)
+}
+
+// recordEvent is RecordEvent for callers that already hold the probe's lock
+func (p *Probe) recordEvent(reason EventReason, message string) {
+	now := time.Now()
+	events := p.state.Events
+
+	if last := len(events) - 1; last >= 0 && events[last].Reason == reason && events[last].Message == message {
+		events[last].Count++
+		events[last].LastSeen = now
+		return
+	}
+
+	log.Infof("probe %s: %s: %s", p.Ref(), reason, message)
+
+	events = append(events, Event{
+		Reason:    reason,
+		Message:   message,
+		Count:     1,
+		FirstSeen: now,
+		LastSeen:  now,
+	})
+
+	if len(events) > maxProbeEvents {
+		events = events[len(events)-maxProbeEvents:]
+	}
+
+	p.state.Events = events
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// How often the state of each managed container is checked
+	healInterval = 10 * time.Second
+	// How long a container can report itself as unhealthy before it is recreated
+	unhealthyGracePeriod = 30 * time.Second
+	// Restarts are delayed by initialRestartBackoff, doubling after every consecutive crash up to maxRestartBackoff
+	initialRestartBackoff = 10 * time.Second
+	maxRestartBackoff     = 5 * time.Minute
+	// How long a restarted container needs to keep running before it is no longer considered to be crash looping
+	crashLoopResetAfter = 10 * time.Minute
+)
+
+// healState tracks the checks made on a probe's container. It is only used by the reconcile loop, or by the probe's
+// deploy while one runs
+type healState struct {
+	checkedAt      time.Time
+	unhealthySince time.Time
+	nextRestart    time.Time
+	crashes        int
+}
+
+// heal checks each of the probe's replicas, restarting any that has exited and recreating any that has been removed
+// or stays unhealthy
+func (b *beacon) heal(probe *Probe) {
+	for replica, container := range probe.State().Containers {
+		if container.ContainerID != "" {
+			b.healReplica(probe, replica, container)
+		}
+	}
+}
+
+func (b *beacon) healReplica(probe *Probe, replica int, container Replica) {
+	containerID := container.ContainerID
+	now := time.Now()
+	heal := probe.healState(replica)
+
+	if now.Sub(heal.checkedAt) < healInterval || now.Before(heal.nextRestart) {
+		return
+	}
+
+	heal.checkedAt = now
+
+	ctx, cancel := context.WithTimeout(probe.ctx, healTimeout)
+	defer cancel()
+
+	state, err := b.OCIClient.InspectContainer(ctx, containerID)
+
+	switch {
+	case errors.Is(err, oci.ErrNoSuchContainer):
+		b.restart(ctx, probe, replica, EventContainerRecreated, fmt.Sprintf("container %s no longer exists", containerID), true)
+	case err != nil:
+		log.Errorf("error checking container %s for probe %s: %s", containerID, probe.Ref(), err)
+	case state.Status == "exited" || state.Status == "stopped" || state.Status == "dead":
+		b.restart(ctx, probe, replica, EventContainerRestarted, fmt.Sprintf("container %s %s with code %d", containerID, state.Status, state.ExitCode), false)
+	case state.Status == "running" && state.Health == "unhealthy":
+		if heal.unhealthySince.IsZero() {
+			heal.unhealthySince = now
+			return
+		}
+
+		if now.Sub(heal.unhealthySince) >= unhealthyGracePeriod {
+			b.restart(ctx, probe, replica, EventContainerRecreated, fmt.Sprintf("container %s has been unhealthy since %s", containerID, heal.unhealthySince.Format(time.RFC3339)), true)
+		}
+	case state.Status == "running":
+		heal.unhealthySince = time.Time{}
+
+		// Replicas that were adopted, restarted or scaled up are only routed to once they are ready, by the same
+		// criteria as a new container during a rollout
+		if probe.Route != nil {
+			address, ready, err := readiness(ctx, probe, containerID, state)
+
+			if err != nil {
+				log.Errorf("error checking container %s for probe %s: %s", containerID, probe.Ref(), err)
+			}
+
+			if ready && address != container.Address {
+				container.Address = address
+				probe.setReplica(replica, container)
+				b.route(probe)
+			}
+		}
+
+		if heal.crashes > 0 && now.Sub(state.StartedAt) >= crashLoopResetAfter {
+			heal.crashes = 0
+		}
+	}
+}
+
+// restart brings the replica's container back, delaying any further restarts with an exponential backoff
+func (b *beacon) restart(ctx context.Context, probe *Probe, replica int, reason EventReason, message string, recreate bool) {
+	heal := probe.healState(replica)
+	heal.nextRestart = time.Now().Add(restartBackoff(heal.crashes))
+	heal.crashes++
+	heal.unhealthySince = time.Time{}
+
+	var restarts int
+	var container Replica
+
+	// The replica is not routed to until it is ready again
+	probe.update(func(s *ProbeState) {
+		s.Restarts++
+		s.Containers[replica].Address = ""
+		restarts = s.Restarts
+		container = s.Containers[replica]
+	})
+	b.route(probe)
+
+	imageRef := probe.imageRef(container.Digest)
+	probe.RecordEvent(reason, fmt.Sprintf("%s (restart %d)", message, restarts))
+
+	if !recreate {
+		err := b.OCIClient.StartContainer(ctx, container.ContainerID)
+
+		if err == nil {
+			return
+		}
+
+		log.Errorf("error restarting container %s, recreating it instead: %s", container.ContainerID, err)
+	}
+
+	err := b.OCIClient.RemoveContainer(ctx, container.ContainerID)
+
+	if err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+		log.Errorf("error removing container %s: %s", container.ContainerID, err)
+	}
+
+	containerID, err := b.runImage(ctx, probe, container.Digest, replica)
+
+	if err != nil {
+		probe.RecordEvent(EventRestartFailed, fmt.Sprintf("error running image %s: %s", imageRef, err))
+		return
+	}
+
+	probe.setReplica(replica, Replica{ContainerID: containerID, Digest: container.Digest})
+}
+
+func restartBackoff(crashes int) time.Duration {
+	backoff := initialRestartBackoff
+
+	for i := 0; i < crashes && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxRestartBackoff {
+		return maxRestartBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,236 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestHealSuite(t *testing.T) {
+	suite.Run(t, new(HealSuite))
+}
+
+func (h *HealSuite) SetupTest() {
+	h.LogBuff = new(bytes.Buffer)
+	log.SetOutput(h.LogBuff)
+}
+
+// runningProbe creates a probe with a single replica running fakeContainerId
+func (h *HealSuite) runningProbe() *Probe {
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Replicas: 1})
+	probe.setReplica(0, Replica{ContainerID: "fakeContainerId", Digest: "fakeDigest"})
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.Replicas = 1
+	})
+
+	return probe
+}
+
+// heal checks the probe's replica as if healInterval had passed since it was last checked
+func (h *HealSuite) heal(beacon *beacon, probe *Probe) {
+	probe.healState(0).checkedAt = time.Time{}
+	beacon.heal(probe)
+}
+
+func (h *HealSuite) TestExitedReplicaIsRestarted() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil)
+	ociClient.EXPECT().StartContainer(gomock.Any(), "fakeContainerId").Return(nil)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+
+	h.heal(beacon, probe)
+
+	state := probe.State()
+
+	assert.Equal(h.T(), 1, state.Restarts)
+	assert.Equal(h.T(), "fakeContainerId", state.Containers[0].ContainerID)
+	assert.Equal(h.T(), EventContainerRestarted, state.Events[0].Reason)
+	assert.Equal(h.T(), "container fakeContainerId exited with code 1 (restart 1)", state.Events[0].Message)
+}
+
+func (h *HealSuite) TestRemovedReplicaIsRecreated() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{}, oci.ErrNoSuchContainer)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainerId").Return(oci.ErrNoSuchContainer)
+	ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/fakeRepo@fakeDigest", gomock.Any()).Return("newContainerId", nil)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+
+	h.heal(beacon, probe)
+
+	state := probe.State()
+
+	assert.Equal(h.T(), Replica{ContainerID: "newContainerId", Digest: "fakeDigest"}, state.Containers[0])
+	assert.Equal(h.T(), EventContainerRecreated, state.Events[0].Reason)
+}
+
+func (h *HealSuite) TestRepeatedFailuresBackOff() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil).Times(2)
+	ociClient.EXPECT().StartContainer(gomock.Any(), "fakeContainerId").Return(nil).Times(2)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+	heal := probe.healState(0)
+
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), 1, heal.crashes)
+	assert.WithinDuration(h.T(), time.Now().Add(initialRestartBackoff), heal.nextRestart, time.Second)
+
+	// The replica isn't checked again until its backoff is over
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), 1, probe.State().Restarts)
+
+	heal.nextRestart = time.Now()
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), 2, heal.crashes)
+	assert.Equal(h.T(), 2, probe.State().Restarts)
+	assert.WithinDuration(h.T(), time.Now().Add(2*initialRestartBackoff), heal.nextRestart, time.Second)
+
+	tests := []struct {
+		crashes int
+		backoff time.Duration
+	}{
+		{0, 10 * time.Second},
+		{1, 20 * time.Second},
+		{3, 80 * time.Second},
+		{5, maxRestartBackoff},
+		{50, maxRestartBackoff},
+	}
+
+	for _, test := range tests {
+		assert.Equal(h.T(), test.backoff, restartBackoff(test.crashes), fmt.Sprintf("%d crashes", test.crashes))
+	}
+}
+
+func (h *HealSuite) TestUnhealthyReplicaIsRecreatedAfterGracePeriod() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running", Health: "unhealthy"}, nil).Times(3)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainerId").Return(nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/fakeRepo@fakeDigest", gomock.Any()).Return("newContainerId", nil)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+	heal := probe.healState(0)
+
+	// Being unhealthy within the grace period doesn't get the replica recreated
+	h.heal(beacon, probe)
+	h.heal(beacon, probe)
+
+	assert.False(h.T(), heal.unhealthySince.IsZero())
+	assert.Equal(h.T(), 0, probe.State().Restarts)
+
+	heal.unhealthySince = time.Now().Add(-unhealthyGracePeriod)
+	h.heal(beacon, probe)
+
+	state := probe.State()
+
+	assert.Equal(h.T(), 1, state.Restarts)
+	assert.Equal(h.T(), "newContainerId", state.Containers[0].ContainerID)
+	assert.Equal(h.T(), EventContainerRecreated, state.Events[0].Reason)
+	assert.True(h.T(), heal.unhealthySince.IsZero())
+}
+
+func (h *HealSuite) TestBackoffResetsAfterStableRun() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	gomock.InOrder(
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running", StartedAt: time.Now().Add(-time.Minute)}, nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running", StartedAt: time.Now().Add(-crashLoopResetAfter)}, nil),
+	)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+	heal := probe.healState(0)
+	heal.crashes = 3
+
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), 3, heal.crashes)
+
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), 0, heal.crashes)
+	assert.Equal(h.T(), initialRestartBackoff, restartBackoff(heal.crashes))
+}
+
+func (h *HealSuite) TestReplicaIsRoutedOnceReady() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	// The container fails its route's health check once before it is ready
+	checks := 0
+	container := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if checks++; req.URL.Path != "/health" || checks == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer container.Close()
+
+	u, _ := url.Parse(container.URL)
+	address := u.Host
+	ports := map[int]string{8080: address}
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	gomock.InOrder(
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running", Health: "starting", Ports: ports}, nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainerId").Return(oci.ContainerState{Status: "running", Health: "healthy", Ports: ports}, nil).Times(2),
+	)
+
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.runningProbe()
+	probe.Route = &proxy.Route{Host: "example.com", Port: 8080, HealthPath: "/health"}
+
+	assert.NoError(h.T(), beacon.ReverseProxy.Add(probe.Ref(), *probe.Route))
+
+	// Neither a container that is still starting, nor one failing the route's health check, is routed to
+	h.heal(beacon, probe)
+	h.heal(beacon, probe)
+
+	assert.Empty(h.T(), probe.State().Containers[0].Address)
+	assert.Empty(h.T(), beacon.ReverseProxy.Backends(probe.Ref()))
+
+	h.heal(beacon, probe)
+
+	assert.Equal(h.T(), address, probe.State().Containers[0].Address)
+	assert.Equal(h.T(), []string{address}, beacon.ReverseProxy.Backends(probe.Ref()))
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	HealthOK ComponentStatus = "ok"
+	// The component works, but something about it needs attention
+	HealthDegraded ComponentStatus = "degraded"
+	HealthFailing  ComponentStatus = "failing"
+)
+
+type ComponentStatus string
+
+const (
+	// How long a single component check can take before it counts as failing
+	healthCheckTimeout = 10 * time.Second
+	// How long the registry check is cached for, as each check counts against the registry's rate limit
+	registryHealthTTL = time.Minute
+	// The share of the registry's rate limit below which it is reported as degraded
+	rateLimitHeadroom = 0.1
+	// How long the reconcile loop can wait without starting a pass, or run a single pass, before it is reported.
+	// Passes start at least every healInterval while the loop is waiting
+	reconcileStaleAfter = 3 * healInterval
+)
+
+// ComponentHealth is the result of checking one of the things beacond needs to work
+type ComponentHealth struct {
+	Name    string
+	Status  ComponentStatus
+	Latency time.Duration
+	Message string
+}
+
+// Readiness is the result of checking every component. Its status is the worst of theirs
+type Readiness struct {
+	Status     ComponentStatus
+	Components []ComponentHealth
+	CheckedAt  time.Time
+}
+
+// heartbeat records the progress of the reconcile loop
+type heartbeat struct {
+	mu sync.Mutex
+	// When the loop last started a pass or went back to waiting for work
+	seen time.Time
+	// When the pass in progress started. It is zero while the loop is waiting
+	running time.Time
+}
+
+// registryHealth caches the last registry check
+type registryHealth struct {
+	mu     sync.Mutex
+	result ComponentHealth
+	at     time.Time
+}
+
+// Ready checks each component beacond depends on. The checks run concurrently, so a slow component doesn't hold up
+// the others
+func (b *beacon) Ready(ctx context.Context) Readiness {
+	checks := []func(context.Context) ComponentHealth{
+		b.checkRuntime,
+		b.checkRegistry,
+		b.checkReconcile,
+		b.checkStateStore,
+	}
+
+	readiness := Readiness{Status: HealthOK, Components: make([]ComponentHealth, len(checks)), CheckedAt: time.Now()}
+
+	var wg sync.WaitGroup
+
+	for i, check := range checks {
+		wg.Add(1)
+
+		go func(i int, check func(context.Context) ComponentHealth) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			readiness.Components[i] = check(ctx)
+		}(i, check)
+	}
+
+	wg.Wait()
+
+	for _, component := range readiness.Components {
+		if component.Status == HealthFailing || (component.Status == HealthDegraded && readiness.Status == HealthOK) {
+			readiness.Status = component.Status
+		}
+	}
+
+	return readiness
+}
+
+// checkRuntime checks that the container runtime is installed and can list the beacon's containers
+func (b *beacon) checkRuntime(ctx context.Context) ComponentHealth {
+	return timed("runtime", func() (ComponentStatus, string) {
+		if _, err := b.OCIClient.CheckExists(ctx); err != nil {
+			return HealthFailing, err.Error()
+		}
+
+		containers, err := b.OCIClient.ListContainers(ctx, map[string]string{oci.LabelBeaconID: b.ID}, []string{"running"})
+
+		if err != nil {
+			return HealthFailing, err.Error()
+		}
+
+		return HealthOK, fmt.Sprintf("%s is running %d managed container(s)", b.OCIClient.Type(), len(containers))
+	})
+}
+
+// checkRegistry checks that the registry can be reached, and that probes aren't about to run out of requests. The
+// result is reused for registryHealthTTL
+func (b *beacon) checkRegistry(ctx context.Context) ComponentHealth {
+	b.registryHealth.mu.Lock()
+	defer b.registryHealth.mu.Unlock()
+
+	if time.Since(b.registryHealth.at) < registryHealthTTL {
+		return b.registryHealth.result
+	}
+
+	result := timed("registry", func() (ComponentStatus, string) {
+		rateLimit, err := b.RegistryClient.Health(ctx)
+
+		if err != nil {
+			return HealthFailing, err.Error()
+		}
+
+		return rateLimitStatus(b.RegistryClient.URL(), rateLimit)
+	})
+
+	b.registryHealth.result = result
+	b.registryHealth.at = time.Now()
+
+	return result
+}
+
+// rateLimitStatus reports a registry as degraded once less than rateLimitHeadroom of its rate limit is left
+func rateLimitStatus(url string, rateLimit registry.RateLimit) (ComponentStatus, string) {
+	if rateLimit.Limit == 0 {
+		return HealthOK, fmt.Sprintf("%s is reachable", url)
+	}
+
+	message := fmt.Sprintf("%s is reachable, with %d of %d requests left", url, rateLimit.Remaining, rateLimit.Limit)
+
+	if !rateLimit.Reset.IsZero() {
+		message += fmt.Sprintf(" until %s", rateLimit.Reset.Format(time.RFC3339))
+	}
+
+	if float64(rateLimit.Remaining) < rateLimitHeadroom*float64(rateLimit.Limit) {
+		return HealthDegraded, message
+	}
+
+	return HealthOK, message
+}
+
+// checkReconcile checks that the reconcile loop is still making passes. A pass that is deploying a new digest can
+// take up to deployTimeout for each probe, so a long running pass is only reported as degraded
+func (b *beacon) checkReconcile(ctx context.Context) ComponentHealth {
+	return timed("reconcile", func() (ComponentStatus, string) {
+		b.heartbeat.mu.Lock()
+		seen, running := b.heartbeat.seen, b.heartbeat.running
+		b.heartbeat.mu.Unlock()
+
+		switch {
+		case seen.IsZero():
+			return HealthFailing, "the reconcile loop has not started"
+		case !running.IsZero() && time.Since(running) > reconcileStaleAfter:
+			return HealthDegraded, fmt.Sprintf("the current pass has been running for %s", time.Since(running).Round(time.Second))
+		case !running.IsZero():
+			return HealthOK, "a pass is running"
+		case time.Since(seen) > reconcileStaleAfter:
+			return HealthFailing, fmt.Sprintf("the reconcile loop has not run for %s", time.Since(seen).Round(time.Second))
+		}
+
+		return HealthOK, fmt.Sprintf("waiting for work since %s", seen.Format(time.RFC3339))
+	})
+}
+
+// beat records that the reconcile loop is starting a pass or, if running is false, waiting for work
+func (h *heartbeat) beat(running bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seen = time.Now()
+	h.running = time.Time{}
+
+	if running {
+		h.running = h.seen
+	}
+}
+
+// checkStateStore checks that a file can be written next to the state file, as SaveState does to replace it
+func (b *beacon) checkStateStore(ctx context.Context) ComponentHealth {
+	return timed("state", func() (ComponentStatus, string) {
+		if b.StateFile == "" {
+			return HealthOK, "state is not saved"
+		}
+
+		dir := filepath.Dir(b.StateFile)
+
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return HealthFailing, fmt.Sprintf("error creating directory for state file %s: %s", b.StateFile, err)
+		}
+
+		f, err := os.CreateTemp(dir, ".health-*")
+
+		if err != nil {
+			return HealthFailing, fmt.Sprintf("error writing to %s: %s", dir, err)
+		}
+
+		_, err = f.WriteString("ok")
+		f.Close()
+		os.Remove(f.Name())
+
+		if err != nil {
+			return HealthFailing, fmt.Sprintf("error writing to %s: %s", dir, err)
+		}
+
+		return HealthOK, fmt.Sprintf("%s is writable", dir)
+	})
+}
+
+// timed runs a check, recording how long it took
+func timed(name string, check func() (ComponentStatus, string)) ComponentHealth {
+	start := time.Now()
+	status, message := check()
+
+	return ComponentHealth{Name: name, Status: status, Latency: time.Since(start), Message: message}
+}
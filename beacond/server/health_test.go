@@ -0,0 +1,132 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestHealthSuite(t *testing.T) {
+	suite.Run(t, new(HealthSuite))
+}
+
+func (h *HealthSuite) SetupTest() {
+	h.LogBuff = new(bytes.Buffer)
+	log.SetOutput(h.LogBuff)
+}
+
+// statuses returns the status of each component, by name
+func (h *HealthSuite) statuses(readiness Readiness) map[string]ComponentStatus {
+	statuses := map[string]ComponentStatus{}
+
+	for _, component := range readiness.Components {
+		statuses[component.Name] = component.Status
+	}
+
+	return statuses
+}
+
+func (h *HealthSuite) TestReadyChecksEveryComponent() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	registryClient := registry.NewMockRegistry(mockController)
+	beacon := newBeacon(ociClient, registryClient, nil, Config{StateFile: filepath.Join(h.T().TempDir(), "state.json")}, host.Capacity{})
+	beacon.heartbeat.beat(false)
+
+	ociClient.EXPECT().CheckExists(gomock.Any()).Return(true, nil).Times(2)
+	ociClient.EXPECT().ListContainers(gomock.Any(), map[string]string{oci.LabelBeaconID: beacon.ID}, []string{"running"}).Return([]oci.Container{{ID: "fakeContainer"}}, nil).Times(2)
+	ociClient.EXPECT().Type().Return(oci.Podman).Times(2)
+	registryClient.EXPECT().URL().Return("https://hub.docker.com")
+
+	// The registry is only checked once, as its result is reused
+	registryClient.EXPECT().Health(gomock.Any()).Return(registry.RateLimit{Limit: 180, Remaining: 12}, nil)
+
+	readiness := beacon.Ready(context.Background())
+
+	assert.Equal(h.T(), HealthDegraded, readiness.Status)
+	assert.Equal(h.T(), map[string]ComponentStatus{
+		"runtime":   HealthOK,
+		"registry":  HealthDegraded,
+		"reconcile": HealthOK,
+		"state":     HealthOK,
+	}, h.statuses(readiness))
+	assert.Equal(h.T(), "https://hub.docker.com is reachable, with 12 of 180 requests left", readiness.Components[1].Message)
+	assert.Equal(h.T(), "podman is running 1 managed container(s)", readiness.Components[0].Message)
+
+	entries, err := os.ReadDir(filepath.Dir(beacon.StateFile))
+
+	assert.NoError(h.T(), err)
+	assert.Empty(h.T(), entries)
+
+	readiness = beacon.Ready(context.Background())
+
+	assert.Equal(h.T(), HealthDegraded, readiness.Status)
+}
+
+func (h *HealthSuite) TestFailingComponentsMakeBeaconUnready() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	registryClient := registry.NewMockRegistry(mockController)
+	stateDir := filepath.Join(h.T().TempDir(), "state")
+	beacon := newBeacon(ociClient, registryClient, nil, Config{StateFile: filepath.Join(stateDir, "state.json")}, host.Capacity{})
+
+	// The state file's directory is a file, so nothing can be written to it
+	h.Require().NoError(os.WriteFile(stateDir, nil, 0600))
+
+	ociClient.EXPECT().CheckExists(gomock.Any()).Return(false, fmt.Errorf("error checking podman exists"))
+	registryClient.EXPECT().Health(gomock.Any()).Return(registry.RateLimit{}, fmt.Errorf("error reaching https://hub.docker.com"))
+
+	readiness := beacon.Ready(context.Background())
+
+	assert.Equal(h.T(), HealthFailing, readiness.Status)
+	assert.Equal(h.T(), map[string]ComponentStatus{
+		"runtime":   HealthFailing,
+		"registry":  HealthFailing,
+		"reconcile": HealthFailing,
+		"state":     HealthFailing,
+	}, h.statuses(readiness))
+	assert.Equal(h.T(), "the reconcile loop has not started", readiness.Components[2].Message)
+}
+
+func (h *HealthSuite) TestReconcileHeartbeat() {
+	beacon := newBeacon(nil, nil, nil, Config{}, host.Capacity{})
+
+	// A long pass is reported, but doesn't make the beacon unready
+	beacon.heartbeat.beat(true)
+	beacon.heartbeat.running = time.Now().Add(-time.Hour)
+
+	assert.Equal(h.T(), HealthDegraded, beacon.checkReconcile(context.Background()).Status)
+
+	beacon.heartbeat.beat(false)
+
+	assert.Equal(h.T(), HealthOK, beacon.checkReconcile(context.Background()).Status)
+
+	// The loop wakes up at least every healInterval, so it is stuck if it hasn't for much longer
+	beacon.heartbeat.seen = time.Now().Add(-time.Hour)
+
+	health := beacon.checkReconcile(context.Background())
+
+	assert.Equal(h.T(), HealthFailing, health.Status)
+	assert.Equal(h.T(), "the reconcile loop has not run for 1h0m0s", health.Message)
+}
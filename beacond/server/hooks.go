@@ -0,0 +1,263 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+type BeaconErrorInvalidHook struct{ error }
+
+type HookPhase string
+
+const (
+	// Run before a new digest is rolled out, while the old containers are still running. A failing pre-deploy hook
+	// aborts the rollout
+	PreDeploy HookPhase = "pre_deploy"
+	// Run once every replica is running the new digest
+	PostDeploy HookPhase = "post_deploy"
+)
+
+// How much of the end of a hook's output is kept in its result
+const maxHookOutput = 4096
+
+// Hook is a one-shot container run from each new digest of a probe, such as a database migration
+type Hook struct {
+	Name    string            `json:"name"`
+	Phase   HookPhase         `json:"phase"`
+	Command []string          `json:"command"`
+	Env     map[string]string `json:"env,omitempty"`
+	// Volumes mounted into the hook's container. A volume with the same name as one of the probe's is the same volume
+	Volumes []VolumeRef `json:"volumes,omitempty"`
+	// How long the hook can run before it is stopped and counted as failed. Defaults to hookTimeout
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// HookResult is the outcome of running a hook for a digest
+type HookResult struct {
+	Name  string
+	Phase HookPhase
+	// The hook's exit code, or -1 if it didn't exit on its own
+	ExitCode int
+	// The end of what the hook printed to stdout and stderr, with the values of secrets replaced by [secret <name>]
+	Output     string
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// DeployResult is the outcome of the latest attempt to roll a new digest out, including the hooks run for it
+type DeployResult struct {
+	Digest     string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Succeeded  bool
+	// Why the deploy failed or, for a deploy that succeeded, why a post-deploy hook failed
+	Error string
+	Hooks []HookResult
+}
+
+// hookState tracks the pre-deploy hooks for the digest being rolled out. It is only used by the probe's deploy
+type hookState struct {
+	digest string
+	// Whether the hooks have succeeded, so that they aren't run again when a rollout is resumed
+	ran     bool
+	results []HookResult
+	// Failing hooks are retried with the same backoff as crashing containers
+	nextAttempt time.Time
+	failures    int
+}
+
+// checkHooks returns an error if any of a new probe's hooks is invalid, or if two of them share a name
+func checkHooks(hooks []Hook) error {
+	names := map[string]bool{}
+
+	for _, hook := range hooks {
+		switch {
+		case !volumeNamePattern.MatchString(hook.Name):
+			return BeaconErrorInvalidHook{fmt.Errorf("hook names can only contain letters, digits, _, . and -, got %q", hook.Name)}
+		case names[hook.Name]:
+			return BeaconErrorInvalidHook{fmt.Errorf("hook %s is given more than once", hook.Name)}
+		case hook.Phase != PreDeploy && hook.Phase != PostDeploy:
+			return BeaconErrorInvalidHook{fmt.Errorf("hook %s must run %s or %s, got %q", hook.Name, PreDeploy, PostDeploy, hook.Phase)}
+		case len(hook.Command) == 0:
+			return BeaconErrorInvalidHook{fmt.Errorf("hook %s has no command", hook.Name)}
+		case hook.Timeout < 0:
+			return BeaconErrorInvalidHook{fmt.Errorf("hook %s has a negative timeout", hook.Name)}
+		}
+
+		if err := checkVolumeRefs(hook.Volumes); err != nil {
+			return BeaconErrorInvalidHook{fmt.Errorf("hook %s: %s", hook.Name, err)}
+		}
+
+		names[hook.Name] = true
+	}
+
+	return nil
+}
+
+// runHooks runs the probe's hooks for the phase one after the other, from the digest's image. It stops at the first
+// hook that fails, returning the results of the hooks run so far
+func (b *beacon) runHooks(ctx context.Context, probe *Probe, digest string, phase HookPhase) ([]HookResult, error) {
+	var results []HookResult
+
+	for _, hook := range probe.Hooks {
+		if hook.Phase != phase {
+			continue
+		}
+
+		result := b.runHook(ctx, probe, digest, hook)
+		results = append(results, result)
+
+		if result.Error != "" {
+			return results, fmt.Errorf("%s hook %s failed: %s", phase, hook.Name, result.Error)
+		}
+	}
+
+	return results, nil
+}
+
+// runHook runs the hook in a container of its own, which joins the same networks and is given the same secrets as
+// the probe's containers. The container is removed once it has exited, or once it has been stopped for running
+// longer than the hook's timeout
+func (b *beacon) runHook(ctx context.Context, probe *Probe, digest string, hook Hook) HookResult {
+	result := HookResult{Name: hook.Name, Phase: hook.Phase, ExitCode: -1, StartedAt: time.Now()}
+
+	timeout := hook.Timeout
+
+	if timeout == 0 {
+		timeout = hookTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	options := oci.RunOptions{
+		Resources: probe.Resources,
+		Labels:    b.hookLabels(probe, digest, hook),
+		Command:   hook.Command,
+		Env:       map[string]string{},
+	}
+
+	// The hook sees the probe's environment, with its own variables taking precedence
+	for _, env := range []map[string]string{probe.Env, hook.Env} {
+		for key, value := range env {
+			options.Env[key] = value
+		}
+	}
+
+	if b.Network != "" && !probe.NoNetwork {
+		options.Networks = []string{b.Network}
+	}
+
+	options.Networks = append(options.Networks, probe.Networks...)
+
+	containerID, injected, err := b.runHookContainer(ctx, probe, digest, hook, options)
+
+	if err != nil {
+		result.Error = err.Error()
+		result.FinishedAt = time.Now()
+
+		return result
+	}
+
+	// The container is cleaned up even if the hook timed out or the probe was stopped while it ran
+	cleanupCtx, cancelCleanup := context.WithTimeout(context.Background(), healTimeout)
+	defer cancelCleanup()
+
+	exitCode, err := b.OCIClient.WaitContainer(ctx, containerID)
+
+	switch {
+	case ctx.Err() != nil:
+		result.Error = fmt.Sprintf("did not finish within %s", timeout)
+	case err != nil:
+		result.Error = err.Error()
+	case exitCode != 0:
+		result.ExitCode = exitCode
+		result.Error = fmt.Sprintf("exited with code %d", exitCode)
+	default:
+		result.ExitCode = exitCode
+	}
+
+	if output, err := b.OCIClient.ContainerLogs(cleanupCtx, containerID); err != nil {
+		log.Errorf("error reading output of hook %s of probe %s: %s", hook.Name, probe.Ref(), err)
+	} else {
+		result.Output = tail(redactSecrets(output, injected), maxHookOutput)
+	}
+
+	if err := b.OCIClient.RemoveContainer(cleanupCtx, containerID); err != nil {
+		log.Errorf("error removing container %s of hook %s: %s", containerID, hook.Name, err)
+	}
+
+	result.FinishedAt = time.Now()
+
+	return result
+}
+
+// runHookContainer starts the hook's container, returning the values of the secrets injected into it so that they
+// can be redacted from its output
+func (b *beacon) runHookContainer(ctx context.Context, probe *Probe, digest string, hook Hook, options oci.RunOptions) (string, map[string][]byte, error) {
+	injected, err := b.injectSecrets(probe, &options)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := b.mountVolumes(ctx, probe, hook.Volumes, &options); err != nil {
+		return "", nil, err
+	}
+
+	containerID, err := b.OCIClient.RunImage(ctx, probe.imageRef(digest), options)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("error running image %s: %s", probe.imageRef(digest), err)
+	}
+
+	return containerID, injected, nil
+}
+
+// hookLabels identifies the container run for a hook. It has no replica label, so that it is never adopted as one
+func (b *beacon) hookLabels(probe *Probe, digest string, hook Hook) map[string]string {
+	return map[string]string{
+		oci.LabelBeaconID: b.ID,
+		oci.LabelProbe:    probe.Ref(),
+		oci.LabelDigest:   digest,
+		oci.LabelHook:     hook.Name,
+	}
+}
+
+// tail returns the last max bytes of output, starting at a line if one starts within them
+func tail(output []byte, max int) string {
+	if len(output) <= max {
+		return string(output)
+	}
+
+	output = output[len(output)-max:]
+
+	if i := strings.IndexByte(string(output), '\n'); i >= 0 && i < len(output)-1 {
+		output = output[i+1:]
+	}
+
+	return strings.ToValidUTF8(string(output), "")
+}
+
+// hookState returns the state of the pre-deploy hooks for the digest, starting afresh if it is a different digest
+// from the last one deployed
+func (p *Probe) hookState(digest string) *hookState {
+	if p.hooks.digest != digest {
+		p.hooks = hookState{digest: digest}
+	}
+
+	return &p.hooks
+}
+
+// recordDeploy keeps the result of the latest attempt to deploy a digest
+func (p *Probe) recordDeploy(result DeployResult) {
+	result.FinishedAt = time.Now()
+
+	p.update(func(s *ProbeState) { s.LastDeploy = &result })
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"beacon/beacond/secrets"
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HooksSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestHooksSuite(t *testing.T) {
+	suite.Run(t, new(HooksSuite))
+}
+
+func (h *HooksSuite) SetupTest() {
+	h.LogBuff = new(bytes.Buffer)
+	log.SetOutput(h.LogBuff)
+}
+
+// outdatedProbe creates a probe with the hooks whose only replica is running oldDigest, while newDigest has been
+// found in the registry
+func (h *HooksSuite) outdatedProbe(hooks ...Hook) *Probe {
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Replicas: 1, Hooks: hooks})
+	probe.setReplica(0, Replica{ContainerID: "oldContainer", Digest: "oldDigest"})
+	probe.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.CurrentDigest = "oldDigest"
+		s.LatestDigest = "newDigest"
+	})
+
+	return probe
+}
+
+func (h *HooksSuite) TestInvalidHooksAreRejected() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+	migrate := Hook{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}}
+
+	for _, hooks := range [][]Hook{
+		{{Name: "migrate/db", Phase: PreDeploy, Command: []string{"./migrate"}}},
+		{{Name: "migrate", Phase: "during_deploy", Command: []string{"./migrate"}}},
+		{{Name: "migrate", Phase: PostDeploy}},
+		{{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}, Timeout: -time.Second}},
+		{{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}, Volumes: []VolumeRef{{Name: "data", Target: "data"}}}},
+		{migrate, migrate},
+	} {
+		err := beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{Hooks: hooks}, time.Hour)
+
+		assert.IsType(h.T(), BeaconErrorInvalidHook{}, err)
+	}
+
+	assert.Empty(h.T(), beacon.ListProbes())
+}
+
+func (h *HooksSuite) TestHooksRunAroundRollout() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	imageRef := "fakeNamespace/fakeRepo@newDigest"
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	migrate := Hook{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate", "up"}, Env: map[string]string{"STEP": "1"}}
+	warm := Hook{Name: "warm", Phase: PostDeploy, Command: []string{"./warm-cache"}}
+	probe := h.outdatedProbe(migrate, warm)
+	beacon.Network = "beacon"
+
+	// The hooks run from the new digest, without a replica label so that they aren't adopted as one
+	migrateOptions := oci.RunOptions{
+		Labels:   beacon.hookLabels(probe, "newDigest", migrate),
+		Command:  []string{"./migrate", "up"},
+		Env:      map[string]string{"STEP": "1"},
+		Networks: []string{"beacon"},
+	}
+
+	gomock.InOrder(
+		ociClient.EXPECT().PullImage(gomock.Any(), imageRef, nil).Return(nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, migrateOptions).Return("migrateContainer", nil),
+		ociClient.EXPECT().WaitContainer(gomock.Any(), "migrateContainer").Return(0, nil),
+		ociClient.EXPECT().ContainerLogs(gomock.Any(), "migrateContainer").Return([]byte("migrated\n"), nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "migrateContainer").Return(nil),
+		ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), []string{"running"}).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, beacon.runOptions(probe, "newDigest", 0)).Return("newContainer", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer").Return(oci.ContainerState{Status: "running"}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer").Return(nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, gomock.Any()).Return("warmContainer", nil),
+		ociClient.EXPECT().WaitContainer(gomock.Any(), "warmContainer").Return(0, nil),
+		ociClient.EXPECT().ContainerLogs(gomock.Any(), "warmContainer").Return(nil, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "warmContainer").Return(nil),
+	)
+
+	beacon.deploy(probe, probe.State())
+
+	state := probe.State()
+
+	assert.Equal(h.T(), "newDigest", state.CurrentDigest)
+	assert.True(h.T(), state.LastDeploy.Succeeded)
+	assert.Empty(h.T(), state.LastDeploy.Error)
+	assert.Len(h.T(), state.LastDeploy.Hooks, 2)
+	assert.Equal(h.T(), "migrated\n", state.LastDeploy.Hooks[0].Output)
+	assert.Equal(h.T(), PostDeploy, state.LastDeploy.Hooks[1].Phase)
+}
+
+func (h *HooksSuite) TestFailingPreDeployHookKeepsOldContainer() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	imageRef := "fakeNamespace/fakeRepo@newDigest"
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := h.outdatedProbe(Hook{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}})
+
+	gomock.InOrder(
+		ociClient.EXPECT().PullImage(gomock.Any(), imageRef, nil).Return(nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, gomock.Any()).Return("migrateContainer", nil),
+		ociClient.EXPECT().WaitContainer(gomock.Any(), "migrateContainer").Return(1, nil),
+		ociClient.EXPECT().ContainerLogs(gomock.Any(), "migrateContainer").Return([]byte("relation \"users\" already exists\n"), nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "migrateContainer").Return(nil),
+	)
+
+	beacon.deploy(probe, probe.State())
+
+	state := probe.State()
+
+	assert.Equal(h.T(), Outdated, state.Status)
+	assert.Equal(h.T(), "oldDigest", state.CurrentDigest)
+	assert.Equal(h.T(), []Replica{{ContainerID: "oldContainer", Digest: "oldDigest"}}, state.Containers)
+	assert.Equal(h.T(), EventHookFailed, state.Events[0].Reason)
+	assert.Equal(h.T(), "not deploying newDigest: pre_deploy hook migrate failed: exited with code 1", state.Events[0].Message)
+
+	assert.False(h.T(), state.LastDeploy.Succeeded)
+	assert.Equal(h.T(), "newDigest", state.LastDeploy.Digest)
+	assert.Equal(h.T(), 1, state.LastDeploy.Hooks[0].ExitCode)
+	assert.Equal(h.T(), "relation \"users\" already exists\n", state.LastDeploy.Hooks[0].Output)
+
+	// The hook is backed off before it is retried
+	beacon.deploy(probe, probe.State())
+}
+
+func (h *HooksSuite) TestSecretsAreRedactedFromOutput() {
+	mockController := gomock.NewController(h.T())
+	defer mockController.Finish()
+
+	store, err := secrets.Open(filepath.Join(h.T().TempDir(), "secrets.json"), []byte("fakePassphrase"))
+
+	h.Require().NoError(err)
+	h.Require().NoError(store.Set("dbPassword", []byte("hunter2")))
+	h.Require().NoError(store.Set("dbUrl", []byte("postgres://app:hunter2@db/app")))
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{Secrets: store, SecretsDir: h.T().TempDir()}, host.Capacity{})
+	migrate := Hook{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}}
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Replicas: 1, Hooks: []Hook{migrate}, Secrets: []SecretRef{
+		{Name: "dbPassword", Env: "DB_PASSWORD"},
+		{Name: "dbUrl", File: "/run/secrets/db-url"},
+	}})
+
+	gomock.InOrder(
+		ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/fakeRepo@newDigest", gomock.Any()).Return("migrateContainer", nil),
+		ociClient.EXPECT().WaitContainer(gomock.Any(), "migrateContainer").Return(1, nil),
+		ociClient.EXPECT().ContainerLogs(gomock.Any(), "migrateContainer").Return([]byte("connecting to postgres://app:hunter2@db/app\nauthentication failed for password hunter2\n"), nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "migrateContainer").Return(nil),
+	)
+
+	result := beacon.runHook(context.Background(), probe, "newDigest", migrate)
+
+	assert.Equal(h.T(), "connecting to [secret dbUrl]\nauthentication failed for password [secret dbPassword]\n", result.Output)
+	assert.Equal(h.T(), "bare [secret a]", string(redactSecrets([]byte("bare 1"), map[string][]byte{"a": []byte("1"), "empty": {}})))
+}
+
+func (h *HooksSuite) TestOutputIsTruncatedToLastLines() {
+	output := bytes.Repeat([]byte("0123456789\n"), 1000)
+
+	tailed := tail(output, maxHookOutput)
+
+	assert.LessOrEqual(h.T(), len(tailed), maxHookOutput)
+	assert.Equal(h.T(), "0123456789\n", tailed[:11])
+	assert.Equal(h.T(), "short", tail([]byte("short"), maxHookOutput))
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// createNetwork creates the network managed containers join, if beacond was given one and it doesn't exist yet
+func (b *beacon) createNetwork(ctx context.Context) error {
+	if b.Network == "" {
+		return nil
+	}
+
+	if err := b.OCIClient.CreateNetwork(ctx, b.Network, map[string]string{oci.LabelBeaconID: b.ID}); err != nil {
+		return fmt.Errorf("error creating network for managed containers: %s", err)
+	}
+
+	return nil
+}
+
+// removeNetwork removes the network managed containers join, once CleanUpManagedContainers has removed them. It is
+// kept if any are left, since the runtime refuses to remove a network that containers are still attached to, even if
+// they are stopped. Like CleanUpManagedContainers, it is called on shutdown so it has a context of its own
+func (b *beacon) removeNetwork(delay time.Duration) error {
+	if b.Network == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), delay)
+	defer cancel()
+
+	containers, err := b.OCIClient.ListContainers(ctx, map[string]string{oci.LabelBeaconID: b.ID}, nil)
+
+	if err != nil {
+		return fmt.Errorf("error listing managed containers: %s", err)
+	}
+
+	if len(containers) > 0 {
+		log.Infof("keeping network %s, which %d managed containers that couldn't be removed are still attached to", b.Network, len(containers))
+		return nil
+	}
+
+	return b.OCIClient.RemoveNetwork(ctx, b.Network)
+}
+
+// networkAlias is the name the probe's containers can be reached by on beacon's network, as <repo>.<namespace>.
+// Every replica shares it, so lookups are spread across them
+func (p *Probe) networkAlias() string {
+	return dnsLabel(p.Repo) + "." + dnsLabel(p.Namespace)
+}
+
+// dnsLabel lowercases s and replaces the characters that aren't valid in a DNS label with dashes
+func dnsLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+
+		return '-'
+	}, strings.ToLower(s))
+}
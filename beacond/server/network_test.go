@@ -0,0 +1,118 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type NetworkSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestNetworkSuite(t *testing.T) {
+	suite.Run(t, new(NetworkSuite))
+}
+
+func (n *NetworkSuite) SetupTest() {
+	n.LogBuff = new(bytes.Buffer)
+	log.SetOutput(n.LogBuff)
+}
+
+func (n *NetworkSuite) TestContainersJoinNetwork() {
+	beacon := newBeacon(nil, nil, nil, Config{Network: "beacon"}, host.Capacity{})
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "Fake_Repo", ProbeOptions{Networks: []string{"fakeNetwork"}})
+	options := beacon.runOptions(probe, "fakeDigest", 0)
+
+	assert.Equal(n.T(), []string{"beacon", "fakeNetwork"}, options.Networks)
+	assert.Equal(n.T(), []string{"fake-repo.fakenamespace"}, options.NetworkAliases)
+
+	// Opting out only leaves beacon's network
+	probe = NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{NoNetwork: true, Networks: []string{"fakeNetwork"}})
+	options = beacon.runOptions(probe, "fakeDigest", 0)
+
+	assert.Equal(n.T(), []string{"fakeNetwork"}, options.Networks)
+	assert.Empty(n.T(), options.NetworkAliases)
+
+	// Without a network, containers are left on the runtime's default network
+	beacon = newBeacon(nil, nil, nil, Config{}, host.Capacity{})
+	options = beacon.runOptions(probe, "fakeDigest", 0)
+
+	assert.Equal(n.T(), []string{"fakeNetwork"}, options.Networks)
+}
+
+func (n *NetworkSuite) TestStartFailsWithoutNetwork() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{Network: "beacon"}, host.Capacity{})
+
+	ociClient.EXPECT().CreateNetwork(gomock.Any(), "beacon", map[string]string{oci.LabelBeaconID: beacon.ID}).Return(fmt.Errorf("fake error"))
+
+	err := beacon.Start(context.Background())
+
+	assert.EqualError(n.T(), err, "error creating network for managed containers: fake error")
+}
+
+func (n *NetworkSuite) TestShutdownRemovesNetwork() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{CleanOnExit: true, Network: "beacon"}, host.Capacity{})
+	labels := map[string]string{oci.LabelBeaconID: beacon.ID}
+
+	// The running container is stopped, and both are removed, so none are left on the network
+	gomock.InOrder(
+		ociClient.EXPECT().ListContainers(gomock.Any(), labels, nil).Return([]oci.Container{
+			{ID: "fakeRunningId", Status: "running"},
+			{ID: "fakeExitedId", Status: "exited"},
+		}, nil),
+		ociClient.EXPECT().StopContainer(gomock.Any(), "fakeRunningId").Return(nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeRunningId").Return(nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeExitedId").Return(oci.ErrNoSuchContainer),
+		ociClient.EXPECT().ListContainers(gomock.Any(), labels, nil).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RemoveNetwork(gomock.Any(), "beacon").Return(nil),
+	)
+
+	assert.NoError(n.T(), beacon.Shutdown(time.Now().Add(time.Second)))
+}
+
+func (n *NetworkSuite) TestShutdownKeepsNetworkInUse() {
+	mockController := gomock.NewController(n.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{CleanOnExit: true, Network: "beacon"}, host.Capacity{})
+	labels := map[string]string{oci.LabelBeaconID: beacon.ID}
+
+	// One container fails to stop and the other to be removed, so both are still attached to the network
+	gomock.InOrder(
+		ociClient.EXPECT().ListContainers(gomock.Any(), labels, nil).Return([]oci.Container{
+			{ID: "fakeRunningId", Status: "running"},
+			{ID: "fakeExitedId", Status: "exited"},
+		}, nil),
+		ociClient.EXPECT().StopContainer(gomock.Any(), "fakeRunningId").Return(fmt.Errorf("fake error")),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeExitedId").Return(fmt.Errorf("fake error")),
+		ociClient.EXPECT().ListContainers(gomock.Any(), labels, nil).Return([]oci.Container{
+			{ID: "fakeRunningId", Status: "running"},
+			{ID: "fakeExitedId", Status: "exited"},
+		}, nil),
+	)
+
+	assert.NoError(n.T(), beacon.Shutdown(time.Now().Add(time.Second)))
+	assert.Contains(n.T(), n.LogBuff.String(), "error removing container fakeExitedId: fake error")
+	assert.Contains(n.T(), n.LogBuff.String(), "keeping network beacon, which 2 managed containers that couldn't be removed are still attached to")
+}
@@ -2,21 +2,26 @@ package server
 
 import (
 	"context"
+	"sync"
 )
 
 type OrGroup struct {
 	Ctx    context.Context
 	Cancel context.CancelFunc
 	Error  error
+	wg     sync.WaitGroup
+	once   sync.Once
 }
 
 type OrGroupManager interface {
-	Go(func() error)
+	Go(func(context.Context) error)
 	Wait() error
 }
 
-func NewOrGroup() OrGroupManager {
-	ctx, cancel := context.WithCancel(context.Background())
+// NewOrGroup creates a group whose routines are cancelled together, either when ctx is cancelled or as soon as any
+// one of them returns
+func NewOrGroup(ctx context.Context) OrGroupManager {
+	ctx, cancel := context.WithCancel(ctx)
 
 	return &OrGroup{
 		Ctx:    ctx,
@@ -24,20 +29,24 @@ func NewOrGroup() OrGroupManager {
 	}
 }
 
-// Error if any one of the goroutines in an or group error
-func (o *OrGroup) Go(routine func() error) {
+// Go runs the routine in its own goroutine. Routines are expected to return once the context they are given is done
+func (o *OrGroup) Go(routine func(context.Context) error) {
+	o.wg.Add(1)
+
 	go func() {
+		defer o.wg.Done()
 		defer o.Cancel()
 
-		err := routine()
+		err := routine(o.Ctx)
 
 		if err != nil {
-			o.Error = err
+			o.once.Do(func() { o.Error = err })
 		}
 	}()
 }
 
+// Wait blocks until every routine in the group has returned, returning the first error any of them returned
 func (o *OrGroup) Wait() error {
-	<-o.Ctx.Done()
+	o.wg.Wait()
 	return o.Error
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrGroupCancelsOthersWhenOneReturns(t *testing.T) {
+	org := NewOrGroup(context.Background())
+	cancelled := make(chan struct{})
+
+	org.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		close(cancelled)
+
+		return nil
+	})
+	org.Go(func(ctx context.Context) error { return fmt.Errorf("fake error") })
+
+	assert.EqualError(t, org.Wait(), "fake error")
+
+	select {
+	case <-cancelled:
+	default:
+		t.Error("Wait returned before every routine had returned")
+	}
+}
+
+func TestOrGroupCancelledByParent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	org := NewOrGroup(ctx)
+
+	org.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	assert.NoError(t, org.Wait())
+}
@@ -0,0 +1,540 @@
+package server
+
+import (
+	"beacon/beacond/notify"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"beacon/beacond/registry"
+	"beacon/beacond/signature"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	Probing    ProbeStatus = "probing"
+	Outdated   ProbeStatus = "outdated"
+	Starting   ProbeStatus = "starting"
+	Exited     ProbeStatus = "exited"
+	Unverified ProbeStatus = "unverified"
+	// A new digest was found, but won't be deployed until it has been the latest for the probe's soak period
+	Soaking ProbeStatus = "soaking"
+	// A new digest was found, but won't be deployed until it is approved
+	PendingApproval ProbeStatus = "pending_approval"
+)
+
+type ProbeStatus string
+
+// ProbeOptions configures how the containers for a probe are run
+type ProbeOptions struct {
+	Resources oci.Resources `json:"resources"`
+	// How many containers to run for the probe. Each of them is given the same resource limits
+	Replicas int `json:"replicas"`
+	// Where the proxy sends requests for the probe, if it serves any
+	Route *proxy.Route `json:"route,omitempty"`
+	// Environment variables set in the probe's containers. A secret injected as the same variable takes its place
+	Env map[string]string `json:"env,omitempty"`
+	// Secrets injected into the probe's containers. Only their names are kept with the probe
+	Secrets []SecretRef `json:"secrets,omitempty"`
+	// The notification sinks told about the probe, on top of those told about every probe
+	Notify []string `json:"notify,omitempty"`
+	// Whether new digests wait to be approved before they are deployed
+	RequireApproval bool `json:"require_approval,omitempty"`
+	// How long a new digest has to stay the latest before it is deployed, so that images pushed in quick succession
+	// are only deployed once
+	Soak time.Duration `json:"soak,omitempty"`
+	// The probes (as namespace/repo) that have to be running before the probe's containers are started
+	DependsOn []string `json:"depends_on,omitempty"`
+	// Whether the probe's containers are restarted when one of its dependencies is redeployed
+	RestartWithDependencies bool `json:"restart_with_dependencies,omitempty"`
+	// Whether the probe's containers stay off the network beacon creates for the containers it manages
+	NoNetwork bool `json:"no_network,omitempty"`
+	// Networks the probe's containers join on top of beacon's network. They have to exist already
+	Networks []string `json:"networks,omitempty"`
+	// Named volumes mounted into the probe's containers, which are kept across digests
+	Volumes []VolumeRef `json:"volumes,omitempty"`
+	// One-shot containers run from each new digest before it is rolled out or after it has been
+	Hooks []Hook `json:"hooks,omitempty"`
+}
+
+// ProbeState is a copy of a probe's state at a point in time, which can be read without holding the probe's lock
+type ProbeState struct {
+	Status        ProbeStatus
+	CurrentDigest string
+	LatestDigest  string
+	LastChecked   time.Time
+	LastUpdated   time.Time
+	// The number of replicas the probe should run, which can be changed while it is running
+	Replicas int
+	// The containers running for the probe, indexed by replica. During a rolling update, some of them may still be
+	// running an older digest
+	Containers []Replica
+	Restarts   int
+	Events     []Event
+	// The image waiting out the probe's soak period, and when it was first found
+	Soaking      *registry.ImageDetails
+	SoakingSince time.Time
+	// The image waiting to be approved while the probe is pending approval
+	Candidate *registry.ImageDetails
+	// The last digest rejected for the probe. It isn't offered for approval again, but a newer digest is
+	RejectedDigest string
+	// The last digest approved for the probe. It is deployed without being offered for approval, or soaking, again if
+	// it is found after a restart that happened before it was rolled out
+	ApprovedDigest string
+	// How the latest attempt to deploy a new digest went
+	LastDeploy *DeployResult
+	// The last digest found to be signed by a trusted key, so that it isn't verified again on every check while it
+	// soaks. Digests that fail verification are checked again, in case they are signed after being pushed
+	VerifiedDigest string
+}
+
+// known reports whether the digest has already been deployed, offered for approval or rejected, so that it isn't
+// acted on again
+func (s ProbeState) known(digest string) bool {
+	return digest == s.CurrentDigest || digest == s.RejectedDigest || (s.Candidate != nil && s.Candidate.Digest == digest)
+}
+
+// Replica is one of the containers run for a probe
+type Replica struct {
+	ContainerID string
+	Digest      string
+	// Where the proxy sends requests for the replica. It is empty until the replica is ready to serve them
+	Address string
+}
+
+// Probe checks a repo for new image digests in its own goroutine, while the beacon's reconcile loop deploys them.
+// Its state is shared between the two (and the API handlers), so it must only be accessed through its methods
+type Probe struct {
+	ProbeOptions
+	Namespace string
+	Repo      string
+
+	mu    sync.Mutex
+	state ProbeState
+	// Whether a deploy, or the addition of new replicas, is running for the probe. While it is, the deploy has the heal
+	// and hook states to itself
+	deploying bool
+	// Why the probe's containers have to be restarted for its dependencies, left for the reconcile loop to act on
+	restartRequests []string
+	// Indexed by replica. Only accessed by the reconcile loop or the probe's deploy, so not guarded by mu
+	heal []healState
+	// Only accessed by the reconcile loop or the probe's deploy, so not guarded by mu
+	hooks hookState
+	// Set before the probe is started, and not changed after
+	notifier *notify.Notifier
+
+	// ctx is cancelled when the probe is closed, which also cancels any work in flight for it
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	resume chan struct{}
+}
+
+// NewProbe creates a probe that is closed when ctx is cancelled
+func NewProbe(ctx context.Context, namespace string, repo string, options ProbeOptions) *Probe {
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Probes saved before replicas were introduced run a single container
+	if options.Replicas < 1 {
+		options.Replicas = 1
+	}
+
+	return &Probe{
+		ProbeOptions: options,
+		Namespace:    namespace,
+		Repo:         repo,
+		state:        ProbeState{Status: Starting, Replicas: options.Replicas},
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		resume:       make(chan struct{}, 1),
+	}
+}
+
+func (p *Probe) Ref() string {
+	return fmt.Sprintf("%s/%s", p.Namespace, p.Repo)
+}
+
+func (p *Probe) imageRef(digest string) string {
+	return fmt.Sprintf("%s/%s@%s", p.Namespace, p.Repo, digest)
+}
+
+// State returns a copy of the probe's current state
+func (p *Probe) State() ProbeState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state := p.state
+	state.Events = append([]Event(nil), p.state.Events...)
+	state.Containers = append([]Replica(nil), p.state.Containers...)
+
+	if p.state.Candidate != nil {
+		candidate := *p.state.Candidate
+		state.Candidate = &candidate
+	}
+
+	if p.state.Soaking != nil {
+		soaking := *p.state.Soaking
+		state.Soaking = &soaking
+	}
+
+	if p.state.LastDeploy != nil {
+		lastDeploy := *p.state.LastDeploy
+		lastDeploy.Hooks = append([]HookResult(nil), p.state.LastDeploy.Hooks...)
+		state.LastDeploy = &lastDeploy
+	}
+
+	return state
+}
+
+// setReplica records the container running for the replica, growing the probe's containers if it is a new replica
+func (p *Probe) setReplica(replica int, container Replica) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.state.Containers) <= replica {
+		p.state.Containers = append(p.state.Containers, Replica{})
+	}
+
+	p.state.Containers[replica] = container
+}
+
+// removeReplica forgets the replica and any after it, once their containers have been removed
+func (p *Probe) removeReplica(replica int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if replica < len(p.state.Containers) {
+		p.state.Containers = p.state.Containers[:replica]
+	}
+
+	if replica < len(p.heal) {
+		p.heal = p.heal[:replica]
+	}
+}
+
+// Options returns the options the probe is currently running with, including any change to its replicas
+func (p *Probe) Options() ProbeOptions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	options := p.ProbeOptions
+	options.Replicas = p.state.Replicas
+
+	return options
+}
+
+// healState returns the heal state for the replica, growing the heal states as replicas are added
+func (p *Probe) healState(replica int) *healState {
+	for len(p.heal) <= replica {
+		p.heal = append(p.heal, healState{})
+	}
+
+	return &p.heal[replica]
+}
+
+// sendNotification tells the probe's notification sinks what happened to it
+func (p *Probe) sendNotification(kind notify.Kind, digest string, message string) {
+	p.notifier.Notify(p.Notify, notify.Notification{Kind: kind, Probe: p.Ref(), Digest: digest, Message: message})
+}
+
+// isDeploying reports whether a deploy is running for the probe
+func (p *Probe) isDeploying() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.deploying
+}
+
+func (p *Probe) setDeploying(deploying bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deploying = deploying
+}
+
+// requestRestart asks the reconcile loop to restart the probe's containers for the reason
+func (p *Probe) requestRestart(reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.restartRequests = append(p.restartRequests, reason)
+}
+
+// takeRestartRequests returns the reasons the probe's containers have to be restarted for, clearing them
+func (p *Probe) takeRestartRequests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	reasons := p.restartRequests
+	p.restartRequests = nil
+
+	return reasons
+}
+
+// update changes the probe's state while holding its lock
+func (p *Probe) update(f func(*ProbeState)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f(&p.state)
+}
+
+// Close stops the probe and cancels anything being done for it. It can be called more than once
+func (p *Probe) Close() {
+	p.cancel()
+}
+
+// Done is closed once the probe has stopped after being closed
+func (p *Probe) Done() <-chan struct{} {
+	return p.done
+}
+
+// Resume probes again straight away, rather than waiting for the next check
+func (p *Probe) Resume() {
+	select {
+	case p.resume <- struct{}{}:
+	default:
+	}
+}
+
+// run probes the registry every delay until the probe is closed, calling outdated whenever a new digest is found.
+// Probing pauses while the probe is outdated, until the new digest is deployed
+func (p *Probe) run(registryClient registry.Registry, verifier signature.Verifier, delay time.Duration, outdated func()) {
+	defer close(p.done)
+
+	p.update(func(s *ProbeState) { s.Status = Probing })
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.resume:
+		case <-timer.C:
+		}
+
+		if p.probe(registryClient, verifier) {
+			outdated()
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+
+		// A digest soaking is checked again as soon as its soak period is over, rather than at the next check
+		next := delay
+
+		if soak := p.soakRemaining(); soak > 0 && soak < next {
+			next = soak
+		}
+
+		timer.Reset(next)
+	}
+}
+
+// probe checks the registry for a new digest, reporting whether one was found that can be deployed
+func (p *Probe) probe(registryClient registry.Registry, verifier signature.Verifier) bool {
+	state := p.State()
+
+	// Unverified probes keep probing so that a newly pushed (and signed) digest can still be deployed, and soaking
+	// and pending approval probes so that a newer digest replaces the one waiting
+	if state.Status != Probing && state.Status != Unverified && state.Status != Soaking && state.Status != PendingApproval {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(p.ctx, probeTimeout)
+	defer cancel()
+
+	image, err := registryClient.LatestImage(ctx, p.Namespace, p.Repo)
+	digest := image.Digest
+
+	// Closing the probe cancels the check, which isn't a failure of the probe
+	if p.ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		log.Errorf("failed to get latest digest while probing: %s", err)
+		p.update(func(s *ProbeState) { s.Status = Exited })
+		p.sendNotification(notify.ProbeExited, "", fmt.Sprintf("stopped probing after failing to get the latest digest: %s", err))
+
+		return false
+	}
+
+	now := time.Now()
+	verifyErr := error(nil)
+	verified := false
+
+	if !state.known(digest) && verifier != nil && digest != state.VerifiedDigest {
+		verifyErr = verifier.Verify(ctx, p.Namespace, p.Repo, digest)
+		verified = verifyErr == nil
+	}
+
+	if p.ctx.Err() != nil {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.state.LastChecked = now
+
+	// A digest that stops being the latest before its soak period is over is never deployed
+	if p.state.Soaking != nil && p.state.Soaking.Digest != digest {
+		p.recordEvent(EventSuperseded, fmt.Sprintf("%s was superseded by %s before it finished soaking", p.state.Soaking.Digest, digest))
+		p.state.Soaking = nil
+
+		if p.state.Status == Soaking {
+			p.state.Status = Probing
+		}
+	}
+
+	if p.state.known(digest) {
+		// The digest that failed verification is no longer the latest, so the probe goes back to what it was doing
+		if p.state.Status == Unverified {
+			p.state.Status = Probing
+
+			if p.state.Candidate != nil {
+				p.state.Status = PendingApproval
+			}
+		}
+
+		return false
+	}
+
+	if verifyErr != nil {
+		p.state.Status = Unverified
+		p.recordEvent(EventVerificationFailed, fmt.Sprintf("refusing to deploy %s: %s", digest, verifyErr))
+
+		return false
+	}
+
+	if verified {
+		p.state.VerifiedDigest = digest
+		p.recordEvent(EventVerified, fmt.Sprintf("%s is signed by a trusted key", digest))
+	}
+
+	approved := digest == p.state.ApprovedDigest
+
+	if p.Soak > 0 && !approved {
+		if p.state.Soaking == nil {
+			p.state.Soaking = &image
+			p.state.SoakingSince = now
+			p.recordEvent(EventSoaking, fmt.Sprintf("%s will be deployed if it is still the latest digest in %s", digest, p.Soak))
+		}
+
+		if now.Sub(p.state.SoakingSince) < p.Soak {
+			// A digest waiting to be approved can still be approved while a newer one soaks
+			if p.state.Status != PendingApproval {
+				p.state.Status = Soaking
+			}
+
+			return false
+		}
+
+		p.state.Soaking = nil
+	}
+
+	p.state.LastUpdated = now
+
+	message := fmt.Sprintf("found %s", digest)
+
+	if p.state.CurrentDigest != "" {
+		message += fmt.Sprintf(", replacing %s", p.state.CurrentDigest)
+	}
+
+	if p.RequireApproval && !approved {
+		if p.state.Candidate != nil {
+			p.recordEvent(EventSuperseded, fmt.Sprintf("%s was superseded by %s before it was approved", p.state.Candidate.Digest, digest))
+		}
+
+		p.state.Status = PendingApproval
+		p.state.Candidate = &image
+		p.recordEvent(EventPendingApproval, fmt.Sprintf("%s (tag %s) is waiting to be approved", digest, image.Tag))
+		p.sendNotification(notify.DigestDetected, digest, message+", waiting to be approved")
+
+		return false
+	}
+
+	p.state.LatestDigest = digest
+	p.state.Status = Outdated
+	p.sendNotification(notify.DigestDetected, digest, message)
+
+	return true
+}
+
+// soakRemaining is how long is left of the soak period of the digest soaking, if there is one
+func (p *Probe) soakRemaining() time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state.Soaking == nil {
+		return 0
+	}
+
+	return p.Soak - time.Since(p.state.SoakingSince)
+}
+
+// approve lets the digest waiting to be approved be deployed, returning it. If digest isn't empty, it has to be the
+// one waiting, so that a digest found after the caller last looked isn't approved by mistake
+func (p *Probe) approve(digest string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	candidate, err := p.candidate(digest)
+
+	if err != nil {
+		return "", err
+	}
+
+	p.state.LatestDigest = candidate.Digest
+	p.state.ApprovedDigest = candidate.Digest
+	p.state.Status = Outdated
+	p.state.Candidate = nil
+	p.recordEvent(EventApproved, fmt.Sprintf("%s was approved", candidate.Digest))
+
+	return candidate.Digest, nil
+}
+
+// reject skips the digest waiting to be approved, returning it. The probe carries on running its current digest, and
+// offers the next digest pushed for approval
+func (p *Probe) reject(digest string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	candidate, err := p.candidate(digest)
+
+	if err != nil {
+		return "", err
+	}
+
+	p.state.RejectedDigest = candidate.Digest
+	p.state.Status = Probing
+	p.state.Candidate = nil
+	p.recordEvent(EventRejected, fmt.Sprintf("%s was rejected", candidate.Digest))
+
+	return candidate.Digest, nil
+}
+
+// candidate returns the image waiting to be approved, checking it is the digest expected. The caller must hold the
+// probe's lock
+func (p *Probe) candidate(digest string) (*registry.ImageDetails, error) {
+	if p.state.Status != PendingApproval || p.state.Candidate == nil {
+		return nil, BeaconErrorNotPendingApproval{fmt.Errorf("probe %s has no digest waiting to be approved", p.Ref())}
+	}
+
+	if digest != "" && digest != p.state.Candidate.Digest {
+		return nil, BeaconErrorNotPendingApproval{fmt.Errorf("digest %s is not waiting to be approved, %s is", digest, p.state.Candidate.Digest)}
+	}
+
+	return p.state.Candidate, nil
+}
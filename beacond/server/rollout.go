@@ -0,0 +1,388 @@
+package server
+
+import (
+	"beacon/beacond/notify"
+	"beacon/beacond/oci"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// How often a new container is checked while waiting for it to be ready
+const readyPollInterval = time.Second
+
+// deploy rolls the latest digest found by the probe out to its replicas one at a time. Each new container has to be
+// ready before the old container it replaces is removed, so the probe keeps serving throughout. A rollout that fails
+// part way stops there, and is picked up from the same replica by a later pass of the reconcile loop. The probe's
+// pre-deploy hooks run before the first replica is replaced, and a failing one stops the rollout before it starts
+func (b *beacon) deploy(probe *Probe, state ProbeState) {
+	ctx, cancel := context.WithTimeout(probe.ctx, deployTimeout)
+	defer cancel()
+
+	digest := state.LatestDigest
+	imageRef := probe.imageRef(digest)
+	hooks := probe.hookState(digest)
+	result := DeployResult{Digest: digest, StartedAt: time.Now()}
+	pulled := false
+
+	for replica := 0; replica < state.Replicas; replica++ {
+		var old Replica
+
+		if replica < len(state.Containers) {
+			old = state.Containers[replica]
+		}
+
+		if old.ContainerID != "" && old.Digest == digest {
+			continue
+		}
+
+		// A replica whose new container failed to become ready is backed off in the same way as a crashing one
+		heal := probe.healState(replica)
+
+		if time.Now().Before(heal.nextRestart) || (!hooks.ran && time.Now().Before(hooks.nextAttempt)) {
+			return
+		}
+
+		if !pulled {
+			if err := b.pullImage(ctx, imageRef); err != nil {
+				log.Errorf("error pulling image %s: %s", imageRef, err)
+
+				if probe.ctx.Err() == nil {
+					result.Error = fmt.Sprintf("error pulling %s: %s", imageRef, err)
+					probe.recordDeploy(result)
+					probe.sendNotification(notify.DeployFailed, digest, result.Error)
+				}
+
+				return
+			}
+
+			pulled = true
+		}
+
+		if !hooks.ran {
+			results, err := b.runHooks(ctx, probe, digest, PreDeploy)
+
+			if probe.ctx.Err() != nil {
+				return
+			}
+
+			hooks.results = results
+
+			// The old containers are left running the old digest, and the hooks are retried later
+			if err != nil {
+				hooks.nextAttempt = time.Now().Add(restartBackoff(hooks.failures))
+				hooks.failures++
+				probe.RecordEvent(EventHookFailed, fmt.Sprintf("not deploying %s: %s", digest, err))
+
+				result.Error = err.Error()
+				result.Hooks = results
+				probe.recordDeploy(result)
+				probe.sendNotification(notify.DeployFailed, digest, result.Error)
+
+				return
+			}
+
+			hooks.ran = true
+		}
+
+		err := b.replaceReplica(ctx, probe, replica, old, digest)
+
+		// Stopping the probe cancels the rollout, which isn't a failure of it
+		if probe.ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			heal.nextRestart = time.Now().Add(restartBackoff(heal.crashes))
+			heal.crashes++
+			probe.RecordEvent(EventRolloutFailed, fmt.Sprintf("error rolling out %s to replica %d: %s", digest, replica, err))
+
+			result.Error = fmt.Sprintf("error rolling out to replica %d: %s", replica, err)
+			result.Hooks = hooks.results
+			probe.recordDeploy(result)
+
+			// A replica whose old container is still there keeps running the old digest
+			if old.ContainerID != "" {
+				probe.sendNotification(notify.Rollback, digest, fmt.Sprintf("replica %d failed to start %s and is still running %s: %s", replica, digest, old.Digest, err))
+			} else {
+				probe.sendNotification(notify.DeployFailed, digest, fmt.Sprintf("replica %d failed to start %s: %s", replica, digest, err))
+			}
+
+			return
+		}
+	}
+
+	// The new digest is already serving, so a failing post-deploy hook is reported without undoing the deploy
+	results, err := b.runHooks(ctx, probe, digest, PostDeploy)
+
+	if err != nil {
+		probe.RecordEvent(EventHookFailed, fmt.Sprintf("deployed %s, but %s", digest, err))
+		result.Error = err.Error()
+	}
+
+	result.Succeeded = true
+	result.Hooks = append(hooks.results, results...)
+	*hooks = hookState{}
+
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.CurrentDigest = digest
+	})
+	probe.recordDeploy(result)
+	probe.sendNotification(notify.DeploySucceeded, digest, fmt.Sprintf("deployed %s to %d replica(s)", digest, state.Replicas))
+	probe.Resume()
+
+	// The first deploy of a probe isn't a redeploy, as its dependents can't have been started before it was running
+	if state.CurrentDigest != "" && state.CurrentDigest != digest {
+		b.restartDependents(probe, digest)
+	}
+}
+
+// replaceReplica runs the digest for the replica and waits for it to be ready. Requests are then switched over to it
+// from the replica's old container, which is removed once they have been
+func (b *beacon) replaceReplica(ctx context.Context, probe *Probe, replica int, old Replica, digest string) error {
+	imageRef := probe.imageRef(digest)
+
+	// Check that a container for this digest isn't already running - this can happen if the OCI runtime fails
+	// to clear the containers requested by Beacon on exit
+	running, err := b.OCIClient.ListContainers(ctx, b.labels(probe, digest, replica), []string{"running"})
+
+	if err != nil {
+		return fmt.Errorf("error checking if image %s is already running: %s", imageRef, err)
+	}
+
+	var containerID string
+	var address string
+
+	if len(running) > 0 {
+		containerID = running[0].ID
+		address, err = b.waitReady(ctx, probe, containerID)
+
+		if err != nil {
+			return err
+		}
+	} else {
+		containerID, err = b.runImage(ctx, probe, digest, replica)
+
+		if err != nil {
+			return fmt.Errorf("error running image %s: %s", imageRef, err)
+		}
+
+		address, err = b.waitReady(ctx, probe, containerID)
+
+		if err != nil {
+			// The old container is left to carry on serving in place of the new one
+			if removeErr := b.OCIClient.RemoveContainer(ctx, containerID); removeErr != nil {
+				log.Errorf("error removing container %s: %s", containerID, removeErr)
+			}
+
+			return err
+		}
+	}
+
+	probe.setReplica(replica, Replica{ContainerID: containerID, Digest: digest, Address: address})
+	*probe.healState(replica) = healState{}
+	b.route(probe)
+
+	if old.ContainerID != "" && old.ContainerID != containerID {
+		err = b.OCIClient.RemoveContainer(ctx, old.ContainerID)
+
+		if err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+			log.Errorf("error removing previous container %s: %s", old.ContainerID, err)
+		}
+	}
+
+	return nil
+}
+
+// waitReady waits until the container is ready, by the criteria of readiness, returning the address the proxy can
+// reach it at for a probe with a route
+func (b *beacon) waitReady(ctx context.Context, probe *Probe, containerID string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err := b.OCIClient.InspectContainer(ctx, containerID)
+
+		if err != nil {
+			return "", fmt.Errorf("error checking container %s: %s", containerID, err)
+		}
+
+		address, ready, err := readiness(ctx, probe, containerID, state)
+
+		if err != nil || ready {
+			return address, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("container %s was not ready after %s", containerID, readyTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// readiness reports whether the container is ready to serve: running and, if its image has a health check, healthy.
+// For a probe with a route, it also returns the address the proxy can reach the container at, which has to pass the
+// route's health check if it has one. It returns an error if the container won't become ready without being
+// restarted or recreated
+func readiness(ctx context.Context, probe *Probe, containerID string, state oci.ContainerState) (string, bool, error) {
+	switch {
+	case state.Status == "running" && (state.Health == "" || state.Health == "healthy"):
+		if probe.Route == nil {
+			return "", true, nil
+		}
+
+		address, ok := state.Ports[probe.Route.Port]
+
+		if !ok {
+			return "", false, fmt.Errorf("port %d of container %s is not published", probe.Route.Port, containerID)
+		}
+
+		if probe.Route.HealthPath == "" || httpReady(ctx, address, probe.Route.HealthPath) {
+			return address, true, nil
+		}
+	case state.Status == "running" && state.Health == "unhealthy":
+		return "", false, fmt.Errorf("container %s is unhealthy", containerID)
+	case state.Status == "exited" || state.Status == "stopped" || state.Status == "dead":
+		return "", false, fmt.Errorf("container %s %s with code %d", containerID, state.Status, state.ExitCode)
+	}
+
+	return "", false, nil
+}
+
+// httpReady reports whether a GET of the path at address returns a 2xx or 3xx response
+func httpReady(ctx context.Context, address string, path string) bool {
+	ctx, cancel := context.WithTimeout(ctx, readyPollInterval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", address, path), nil)
+
+	if err != nil {
+		return false
+	}
+
+	// Redirects are not followed, as they are likely to point at the route's public hostname
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return false
+	}
+
+	res.Body.Close()
+
+	return res.StatusCode >= 200 && res.StatusCode < 400
+}
+
+// scale removes the replicas above the number the probe should run, leaving the others as they are. Missing replicas
+// are added by addReplicas, which waits for them to be ready and so runs in the background
+func (b *beacon) scale(probe *Probe, state ProbeState) {
+	if state.CurrentDigest == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(probe.ctx, deployTimeout)
+	defer cancel()
+
+	b.route(probe)
+
+	// Replicas are removed from the highest down, so that the remaining ones keep their numbers
+	for replica := len(state.Containers) - 1; replica >= state.Replicas; replica-- {
+		containerID := state.Containers[replica].ContainerID
+
+		if containerID != "" {
+			err := b.OCIClient.RemoveContainer(ctx, containerID)
+
+			if err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+				probe.RecordEvent(EventScaleFailed, fmt.Sprintf("error removing container %s of replica %d: %s", containerID, replica, err))
+				return
+			}
+		}
+
+		probe.removeReplica(replica)
+	}
+}
+
+// missingReplicas returns the replicas the probe should run that don't have a container, leaving out any backing off
+// from a failed attempt to add it. New replicas run the probe's current digest, so none are missing for a probe that
+// hasn't been deployed yet
+func (p *Probe) missingReplicas(state ProbeState) []int {
+	if state.CurrentDigest == "" {
+		return nil
+	}
+
+	var replicas []int
+
+	for replica := 0; replica < state.Replicas; replica++ {
+		if replica < len(state.Containers) && state.Containers[replica].ContainerID != "" {
+			continue
+		}
+
+		if time.Now().Before(p.healState(replica).nextRestart) {
+			continue
+		}
+
+		replicas = append(replicas, replica)
+	}
+
+	return replicas
+}
+
+// addReplicas runs the probe's current digest for each of its missing replicas, waiting for each new container to be
+// ready before it is routed to. A replica that fails to start is backed off in the same way as a crashing one
+func (b *beacon) addReplicas(probe *Probe, state ProbeState) {
+	ctx, cancel := context.WithTimeout(probe.ctx, deployTimeout)
+	defer cancel()
+
+	digest := state.CurrentDigest
+	imageRef := probe.imageRef(digest)
+
+	for _, replica := range probe.missingReplicas(state) {
+		containerID, err := b.runImage(ctx, probe, digest, replica)
+
+		if err != nil {
+			err = fmt.Errorf("error running image %s for replica %d: %s", imageRef, replica, err)
+		}
+
+		var address string
+
+		if err == nil {
+			address, err = b.waitReady(ctx, probe, containerID)
+
+			if err != nil {
+				if removeErr := b.OCIClient.RemoveContainer(ctx, containerID); removeErr != nil {
+					log.Errorf("error removing container %s: %s", containerID, removeErr)
+				}
+
+				err = fmt.Errorf("replica %d running %s was not ready: %s", replica, imageRef, err)
+			}
+		}
+
+		// Stopping the probe cancels the scaling, which isn't a failure of it
+		if probe.ctx.Err() != nil {
+			return
+		}
+
+		heal := probe.healState(replica)
+
+		if err != nil {
+			heal.nextRestart = time.Now().Add(restartBackoff(heal.crashes))
+			heal.crashes++
+			probe.RecordEvent(EventScaleFailed, err.Error())
+
+			continue
+		}
+
+		probe.setReplica(replica, Replica{ContainerID: containerID, Digest: digest, Address: address})
+		*heal = healState{}
+		b.route(probe)
+	}
+}
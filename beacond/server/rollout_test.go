@@ -0,0 +1,361 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/notify"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"beacon/beacond/registry"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RolloutSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestRolloutSuite(t *testing.T) {
+	suite.Run(t, new(RolloutSuite))
+}
+
+func (r *RolloutSuite) SetupTest() {
+	r.LogBuff = new(bytes.Buffer)
+	log.SetOutput(r.LogBuff)
+}
+
+// anonymousRegistry is a registry without credentials, which images are pulled from anonymously
+func anonymousRegistry(mockController *gomock.Controller) *registry.MockRegistry {
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().Credentials(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	return registryClient
+}
+
+// outdatedProbe creates a probe whose replicas are running oldDigest, while newDigest has been found in the registry
+func (r *RolloutSuite) outdatedProbe(replicas ...Replica) *Probe {
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Replicas: len(replicas)})
+
+	for replica, container := range replicas {
+		probe.setReplica(replica, container)
+	}
+
+	probe.update(func(s *ProbeState) {
+		s.Status = Outdated
+		s.CurrentDigest = "oldDigest"
+		s.LatestDigest = "newDigest"
+	})
+
+	return probe
+}
+
+func (r *RolloutSuite) TestRollingUpdateReplacesOneReplicaAtATime() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	imageRef := "fakeNamespace/fakeRepo@newDigest"
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := r.outdatedProbe(Replica{ContainerID: "oldContainer0", Digest: "oldDigest"}, Replica{ContainerID: "oldContainer1", Digest: "oldDigest"})
+
+	// The second replica has a health check, so it isn't replaced until it reports itself as healthy
+	gomock.InOrder(
+		ociClient.EXPECT().PullImage(gomock.Any(), imageRef, nil).Return(nil),
+		ociClient.EXPECT().ListContainers(gomock.Any(), beacon.labels(probe, "newDigest", 0), []string{"running"}).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, beacon.runOptions(probe, "newDigest", 0)).Return("newContainer0", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "running"}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer0").Return(nil),
+		ociClient.EXPECT().ListContainers(gomock.Any(), beacon.labels(probe, "newDigest", 1), []string{"running"}).Return([]oci.Container{}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, beacon.runOptions(probe, "newDigest", 1)).Return("newContainer1", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer1").Return(oci.ContainerState{Status: "running", Health: "starting"}, nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer1").Return(oci.ContainerState{Status: "running", Health: "healthy"}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer1").Return(nil),
+	)
+
+	beacon.deploy(probe, probe.State())
+
+	state := probe.State()
+
+	assert.Equal(r.T(), Probing, state.Status)
+	assert.Equal(r.T(), "newDigest", state.CurrentDigest)
+	assert.Equal(r.T(), []Replica{{ContainerID: "newContainer0", Digest: "newDigest"}, {ContainerID: "newContainer1", Digest: "newDigest"}}, state.Containers)
+}
+
+func (r *RolloutSuite) TestRouteIsSwitchedBeforeOldContainerIsRemoved() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	// The new container fails its route's health check once before it is ready
+	checks := 0
+	container := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if checks++; req.URL.Path != "/health" || checks == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer container.Close()
+
+	u, _ := url.Parse(container.URL)
+	newAddress := u.Host
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+
+	probe := r.outdatedProbe(Replica{ContainerID: "oldContainer0", Digest: "oldDigest", Address: "127.0.0.1:1"})
+	probe.Route = &proxy.Route{Host: "example.com", Port: 8080, HealthPath: "/health"}
+
+	assert.NoError(r.T(), beacon.ReverseProxy.Add(probe.Ref(), *probe.Route))
+	beacon.route(probe)
+
+	ociClient.EXPECT().PullImage(gomock.Any(), gomock.Any(), nil).Return(nil)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), oci.RunOptions{Labels: beacon.labels(probe, "newDigest", 0), Ports: []int{8080}}).Return("newContainer0", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "running", Ports: map[int]string{8080: newAddress}}, nil).Times(2)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer0").DoAndReturn(func(ctx context.Context, containerID string) error {
+		assert.Equal(r.T(), []string{newAddress}, beacon.ReverseProxy.Backends(probe.Ref()))
+		return nil
+	})
+
+	beacon.deploy(probe, probe.State())
+
+	assert.Equal(r.T(), []Replica{{ContainerID: "newContainer0", Digest: "newDigest", Address: newAddress}}, probe.State().Containers)
+}
+
+func (r *RolloutSuite) TestRolloutPullsWithRegistryCredentials() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().Credentials(gomock.Any()).Return(&registry.Credentials{ServerAddress: "docker.io", Username: "fakeUser", Password: "fakePassword"}, nil)
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, registryClient, nil, Config{}, host.Capacity{})
+	probe := r.outdatedProbe(Replica{ContainerID: "oldContainer0", Digest: "oldDigest"})
+
+	auth := &oci.RegistryAuth{Server: "docker.io", Username: "fakeUser", Password: "fakePassword"}
+
+	ociClient.EXPECT().PullImage(gomock.Any(), "fakeNamespace/fakeRepo@newDigest", auth).Return(nil)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("newContainer0", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "running"}, nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer0").Return(nil)
+
+	beacon.deploy(probe, probe.State())
+
+	assert.Equal(r.T(), "newDigest", probe.State().CurrentDigest)
+}
+
+// notifier sends notifications to a webhook, returning the kinds it received once the notifier is closed
+func (r *RolloutSuite) notifier() (*notify.Notifier, func() []notify.Kind) {
+	var mu sync.Mutex
+	var kinds []notify.Kind
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var notification notify.Notification
+
+		json.NewDecoder(req.Body).Decode(&notification)
+
+		mu.Lock()
+		kinds = append(kinds, notification.Kind)
+		mu.Unlock()
+	}))
+
+	notifier, err := notify.New(notify.Config{Sinks: map[string]notify.SinkConfig{"hook": {Type: notify.WebhookSink, URL: server.URL}}})
+
+	r.Require().NoError(err)
+
+	return notifier, func() []notify.Kind {
+		notifier.Close()
+		server.Close()
+
+		return kinds
+	}
+}
+
+func (r *RolloutSuite) TestRolloutNotifies() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	notifier, received := r.notifier()
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{Notifier: notifier}, host.Capacity{})
+	probe := r.outdatedProbe(Replica{ContainerID: "oldContainer0", Digest: "oldDigest"})
+	probe.Notify = []string{"hook"}
+	probe.notifier = notifier
+
+	// The first attempt fails and leaves the old container running, while the retry succeeds
+	ociClient.EXPECT().PullImage(gomock.Any(), gomock.Any(), nil).Return(nil).Times(2)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil).Times(2)
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("newContainer0", nil).Times(2)
+	gomock.InOrder(
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "running"}, nil),
+	)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "newContainer0").Return(nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer0").Return(nil)
+
+	beacon.deploy(probe, probe.State())
+
+	probe.healState(0).nextRestart = time.Time{}
+	beacon.deploy(probe, probe.State())
+
+	assert.Equal(r.T(), []notify.Kind{notify.Rollback, notify.DeploySucceeded}, received())
+}
+
+func (r *RolloutSuite) TestRolloutStopsWhenReplicaIsNotReady() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := r.outdatedProbe(Replica{ContainerID: "oldContainer0", Digest: "oldDigest"}, Replica{ContainerID: "oldContainer1", Digest: "oldDigest"})
+
+	// The new container exits, so it is removed and the old containers are left running
+	ociClient.EXPECT().PullImage(gomock.Any(), gomock.Any(), nil).Return(nil)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("newContainer0", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer0").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "newContainer0").Return(nil)
+
+	beacon.deploy(probe, probe.State())
+
+	state := probe.State()
+
+	assert.Equal(r.T(), Outdated, state.Status)
+	assert.Equal(r.T(), "oldDigest", state.CurrentDigest)
+	assert.Equal(r.T(), []Replica{{ContainerID: "oldContainer0", Digest: "oldDigest"}, {ContainerID: "oldContainer1", Digest: "oldDigest"}}, state.Containers)
+	assert.Equal(r.T(), EventRolloutFailed, state.Events[len(state.Events)-1].Reason)
+
+	// The replica is backed off before its rollout is retried
+	beacon.deploy(probe, probe.State())
+}
+
+func (r *RolloutSuite) TestRolloutResumesFromReplicaThatFailed() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+	probe := r.outdatedProbe(Replica{ContainerID: "newContainer0", Digest: "newDigest"}, Replica{ContainerID: "oldContainer1", Digest: "oldDigest"})
+
+	ociClient.EXPECT().PullImage(gomock.Any(), gomock.Any(), nil).Return(nil)
+	ociClient.EXPECT().ListContainers(gomock.Any(), beacon.labels(probe, "newDigest", 1), []string{"running"}).Return([]oci.Container{}, nil)
+	ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), beacon.runOptions(probe, "newDigest", 1)).Return("newContainer1", nil)
+	ociClient.EXPECT().InspectContainer(gomock.Any(), "newContainer1").Return(oci.ContainerState{Status: "running"}, nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "oldContainer1").Return(nil)
+
+	beacon.deploy(probe, probe.State())
+
+	assert.Equal(r.T(), []Replica{{ContainerID: "newContainer0", Digest: "newDigest"}, {ContainerID: "newContainer1", Digest: "newDigest"}}, probe.State().Containers)
+}
+
+func (r *RolloutSuite) TestScaleWithoutRedeploying() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	imageRef := "fakeNamespace/fakeRepo@fakeDigest"
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{})
+	probe.setReplica(0, Replica{ContainerID: "fakeContainer0", Digest: "fakeDigest"})
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.CurrentDigest = "fakeDigest"
+		s.Replicas = 3
+	})
+
+	// Each new replica is waited on until it is ready
+	gomock.InOrder(
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, beacon.runOptions(probe, "fakeDigest", 1)).Return("fakeContainer1", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainer1").Return(oci.ContainerState{Status: "running"}, nil),
+		ociClient.EXPECT().RunImage(gomock.Any(), imageRef, beacon.runOptions(probe, "fakeDigest", 2)).Return("fakeContainer2", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainer2").Return(oci.ContainerState{Status: "running"}, nil),
+	)
+
+	beacon.scale(probe, probe.State())
+
+	assert.Equal(r.T(), []int{1, 2}, probe.missingReplicas(probe.State()))
+
+	beacon.addReplicas(probe, probe.State())
+
+	assert.Equal(r.T(), []Replica{{ContainerID: "fakeContainer0", Digest: "fakeDigest"}, {ContainerID: "fakeContainer1", Digest: "fakeDigest"}, {ContainerID: "fakeContainer2", Digest: "fakeDigest"}}, probe.State().Containers)
+
+	probe.update(func(s *ProbeState) { s.Replicas = 1 })
+
+	gomock.InOrder(
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainer2").Return(nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainer1").Return(nil),
+	)
+
+	beacon.scale(probe, probe.State())
+
+	assert.Equal(r.T(), []Replica{{ContainerID: "fakeContainer0", Digest: "fakeDigest"}}, probe.State().Containers)
+}
+
+func (r *RolloutSuite) TestNewReplicaThatIsNotReadyIsBackedOff() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, anonymousRegistry(mockController), nil, Config{}, host.Capacity{})
+
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{})
+	probe.setReplica(0, Replica{ContainerID: "fakeContainer0", Digest: "fakeDigest"})
+	probe.update(func(s *ProbeState) {
+		s.Status = Probing
+		s.CurrentDigest = "fakeDigest"
+		s.Replicas = 2
+	})
+
+	gomock.InOrder(
+		ociClient.EXPECT().RunImage(gomock.Any(), gomock.Any(), gomock.Any()).Return("fakeContainer1", nil),
+		ociClient.EXPECT().InspectContainer(gomock.Any(), "fakeContainer1").Return(oci.ContainerState{Status: "exited", ExitCode: 1}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeContainer1").Return(nil),
+	)
+
+	beacon.addReplicas(probe, probe.State())
+
+	state := probe.State()
+
+	assert.Len(r.T(), state.Containers, 1)
+	assert.Equal(r.T(), EventScaleFailed, state.Events[0].Reason)
+	assert.Equal(r.T(), "replica 1 running fakeNamespace/fakeRepo@fakeDigest was not ready: container fakeContainer1 exited with code 1", state.Events[0].Message)
+
+	// The replica isn't added again until its backoff has passed
+	assert.Empty(r.T(), probe.missingReplicas(state))
+}
+
+func (r *RolloutSuite) TestScaleProbeChecksCapacity() {
+	mockController := gomock.NewController(r.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, context.Canceled).AnyTimes()
+
+	beacon := newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{Memory: 1024})
+
+	assert.NoError(r.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{Resources: oci.Resources{Memory: 512}}, time.Hour))
+
+	err := beacon.ScaleProbe("fakeNamespace", "fakeRepo", 3)
+
+	assert.IsType(r.T(), BeaconErrorInsufficientCapacity{}, err)
+	assert.NoError(r.T(), beacon.ScaleProbe("fakeNamespace", "fakeRepo", 2))
+	assert.Equal(r.T(), int64(1024), beacon.Allocated().Memory)
+
+	err = beacon.ScaleProbe("fakeNamespace", "fakeOtherRepo", 2)
+
+	assert.IsType(r.T(), BeaconErrorProbeDoesNotExist{}, err)
+	assert.NoError(r.T(), beacon.StopProbes(time.Second))
+}
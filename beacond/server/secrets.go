@@ -0,0 +1,302 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"beacon/beacond/secrets"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/gommon/log"
+)
+
+type BeaconErrorSecretsDisabled struct{ error }
+type BeaconErrorInvalidSecretRef struct{ error }
+type BeaconErrorSecretInUse struct{ error }
+type BeaconErrorInvalidSecret struct{ error }
+
+// SecretRef injects a secret from the beacon's secret store into a probe's containers, either as an environment
+// variable or as a read only file
+type SecretRef struct {
+	Name string `json:"name"`
+	Env  string `json:"env,omitempty"`
+	// An absolute path in the container
+	File string `json:"file,omitempty"`
+}
+
+// SecretDetails describes a secret without its value, which is never returned by the API
+type SecretDetails struct {
+	secrets.Secret
+	// The probes that reference the secret
+	UsedBy []string
+}
+
+// DefaultSecretsFile is where beacond keeps its secrets if no other path is given
+func DefaultSecretsFile() string {
+	return filepath.Join(filepath.Dir(DefaultStateFile()), "secrets.json")
+}
+
+// DefaultSecretsDir is where the files for secrets mounted into containers are written. On Linux this is a tmpfs, so
+// that the secrets are never written to disk unencrypted
+func DefaultSecretsDir() string {
+	if runtime.GOOS == "linux" {
+		return "/dev/shm/beacon"
+	}
+
+	return filepath.Join(os.TempDir(), "beacon")
+}
+
+func (b *beacon) secretStore() (*secrets.Store, error) {
+	if b.Secrets == nil {
+		return nil, BeaconErrorSecretsDisabled{fmt.Errorf("beacond was started without a secrets key")}
+	}
+
+	return b.Secrets, nil
+}
+
+// SetSecret creates or replaces the secret. A value spanning lines can only be mounted as a file, so it is refused
+// while a probe injects the secret as an environment variable
+func (b *beacon) SetSecret(name string, value []byte) error {
+	store, err := b.secretStore()
+
+	if err != nil {
+		return err
+	}
+
+	if multiline(value) {
+		if usedBy := b.probesUsingSecretAsEnv(name); len(usedBy) > 0 {
+			return BeaconErrorInvalidSecret{fmt.Errorf("secret %s is injected as an environment variable by %s, so its value can't contain line breaks", name, strings.Join(usedBy, ", "))}
+		}
+	}
+
+	return store.Set(name, value)
+}
+
+// multiline reports whether the value contains a line break, which environment variables can't hold as they are
+// passed to the container runtime one per line
+func multiline(value []byte) bool {
+	return bytes.ContainsAny(value, "\r\n")
+}
+
+func (b *beacon) GetSecret(name string) (SecretDetails, error) {
+	store, err := b.secretStore()
+
+	if err != nil {
+		return SecretDetails{}, err
+	}
+
+	secret, err := store.Get(name)
+
+	if err != nil {
+		return SecretDetails{}, err
+	}
+
+	return SecretDetails{Secret: secret, UsedBy: b.probesUsingSecret(name)}, nil
+}
+
+func (b *beacon) ListSecrets() ([]string, error) {
+	store, err := b.secretStore()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return store.List(), nil
+}
+
+// DeleteSecret removes the secret, unless a probe still references it
+func (b *beacon) DeleteSecret(name string) error {
+	store, err := b.secretStore()
+
+	if err != nil {
+		return err
+	}
+
+	if usedBy := b.probesUsingSecret(name); len(usedBy) > 0 {
+		return BeaconErrorSecretInUse{fmt.Errorf("secret %s is used by %s", name, strings.Join(usedBy, ", "))}
+	}
+
+	return store.Delete(name)
+}
+
+func (b *beacon) probesUsingSecret(name string) []string {
+	return b.probesWithSecretRef(func(ref SecretRef) bool { return ref.Name == name })
+}
+
+func (b *beacon) probesUsingSecretAsEnv(name string) []string {
+	return b.probesWithSecretRef(func(ref SecretRef) bool { return ref.Name == name && ref.Env != "" })
+}
+
+// probesWithSecretRef returns the probes with a secret reference that matches, sorted
+func (b *beacon) probesWithSecretRef(matches func(SecretRef) bool) []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	usedBy := []string{}
+
+	for probeRef, probe := range b.probes {
+		for _, ref := range probe.Secrets {
+			if matches(ref) {
+				usedBy = append(usedBy, probeRef)
+				break
+			}
+		}
+	}
+
+	sort.Strings(usedBy)
+
+	return usedBy
+}
+
+// checkSecretRefs returns an error if any of the secrets referenced by a new probe don't exist, or can't be injected
+// the way they were asked to be
+func (b *beacon) checkSecretRefs(refs []SecretRef) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	store, err := b.secretStore()
+
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		switch {
+		case (ref.Env == "") == (ref.File == ""):
+			return BeaconErrorInvalidSecretRef{fmt.Errorf("secret %s must be injected as either an environment variable or a file", ref.Name)}
+		case ref.File != "" && !strings.HasPrefix(ref.File, "/"):
+			return BeaconErrorInvalidSecretRef{fmt.Errorf("secret %s must be mounted at an absolute path, got %q", ref.Name, ref.File)}
+		}
+
+		value, err := store.Value(ref.Name)
+
+		if err != nil {
+			return BeaconErrorInvalidSecretRef{err}
+		}
+
+		if ref.Env != "" && multiline(value) {
+			return BeaconErrorInvalidSecretRef{fmt.Errorf("secret %s contains line breaks, so it can only be mounted as a file rather than set as %s", ref.Name, ref.Env)}
+		}
+	}
+
+	return nil
+}
+
+// secretsDir is where the files for the probe's secrets are written
+func (b *beacon) secretsDir(probe *Probe) string {
+	return filepath.Join(b.SecretsDir, b.ID, probe.Namespace, probe.Repo)
+}
+
+// injectSecrets decrypts the secrets referenced by the probe into the options its containers are run with, returning
+// their values by name. Files are written to the secrets directory and mounted into the container, rather than copied
+// into it
+func (b *beacon) injectSecrets(probe *Probe, options *oci.RunOptions) (map[string][]byte, error) {
+	if len(probe.Secrets) == 0 {
+		return nil, nil
+	}
+
+	store, err := b.secretStore()
+
+	if err != nil {
+		return nil, err
+	}
+
+	dir := b.secretsDir(probe)
+
+	// The directory keeps other users on the host out, while the files in it can be read by any user in the container
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("error creating secrets directory %s: %s", dir, err)
+	}
+
+	injected := make(map[string][]byte, len(probe.Secrets))
+
+	for i, ref := range probe.Secrets {
+		value, err := store.Value(ref.Name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		injected[ref.Name] = value
+
+		if ref.Env != "" {
+			if options.Env == nil {
+				options.Env = map[string]string{}
+			}
+
+			options.Env[ref.Env] = string(value)
+			continue
+		}
+
+		source := filepath.Join(dir, strconv.Itoa(i)+"-"+ref.Name)
+
+		if err := writeSecretFile(source, value); err != nil {
+			return nil, err
+		}
+
+		options.Mounts = append(options.Mounts, oci.Mount{Source: source, Target: ref.File})
+	}
+
+	return injected, nil
+}
+
+// redactSecrets replaces the values of the injected secrets in output with placeholders naming them, so that what a
+// container printed can be shown without revealing them. Longer values are replaced first, so that a value holding
+// another is replaced whole
+func redactSecrets(output []byte, injected map[string][]byte) []byte {
+	names := make([]string, 0, len(injected))
+
+	for name, value := range injected {
+		if len(value) > 0 {
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if len(injected[names[i]]) != len(injected[names[j]]) {
+			return len(injected[names[i]]) > len(injected[names[j]])
+		}
+
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		output = bytes.ReplaceAll(output, injected[name], []byte("[secret "+name+"]"))
+	}
+
+	return output
+}
+
+// writeSecretFile replaces the file in one go, so that a running container never sees it half written
+func writeSecretFile(path string, value []byte) error {
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, value, 0644); err != nil {
+		return fmt.Errorf("error writing secret file %s: %s", path, err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("error writing secret file %s: %s", path, err)
+	}
+
+	return nil
+}
+
+// removeSecrets removes the files written for the probe's secrets once the probe is stopped
+func (b *beacon) removeSecrets(probe *Probe) {
+	if len(probe.Secrets) == 0 {
+		return
+	}
+
+	if err := os.RemoveAll(b.secretsDir(probe)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Errorf("error removing secrets for probe %s: %s", probe.Ref(), err)
+	}
+}
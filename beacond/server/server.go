@@ -1,49 +1,194 @@
 package server
 
 import (
+	"beacon/beacond/notify"
 	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
 	"beacon/beacond/registry"
+	"beacon/beacond/secrets"
+	"beacon/beacond/signature"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"beacon/beacond/models"
 
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 )
 
+// Config holds the settings beacond was started with
+type Config struct {
+	Port            int
+	CleanOnExit     bool
+	AllowOvercommit bool
+	// Where the probes are saved between runs. Nothing is saved if it is empty
+	StateFile string
+	// How long to wait for the API server, probes and (with CleanOnExit) managed containers to stop on shutdown
+	GracePeriod time.Duration
+	// Where the reverse proxy for probes with a route listens. Without an address or TLS, it is only run once a probe
+	// has a route, listening on proxy.DefaultAddr
+	Proxy proxy.Listen
+	// The secrets that probes can reference. Secrets are disabled if it is nil
+	Secrets *secrets.Store
+	// Where the files for secrets mounted into containers are written
+	SecretsDir string
+	// Sends notifications about deploys and probes. Nothing is sent if it is nil
+	Notifier *notify.Notifier
+	// The network beacon creates for the containers it manages to reach each other on. Containers are left on the
+	// runtime's default network if it is empty
+	Network string
+}
+
+// Run serves the API and runs the beacon until ctx is cancelled or either of them fails, then shuts both down.
+// The error returned covers both the reason beacond stopped and anything that went wrong shutting down
+//
 // @Title				beacond API
 // @Version			0.1
 // @Description	API for beacond server
-func Run(ociClient oci.OCIRuntime, registryClient registry.Registry, port int, cleanOnExit bool) {
-	NewBeacon(ociClient, registryClient, cleanOnExit)
-	defer Beacon.Close()
+func Run(ctx context.Context, ociClient oci.OCIRuntime, registryClient registry.Registry, verifier signature.Verifier, config Config) error {
+	// Without a working runtime, no containers can be adopted or run
+	if _, err := ociClient.CheckExists(ctx); err != nil {
+		return fmt.Errorf("container runtime %s is not available: %s", ociClient.Type(), err)
+	}
+
+	NewBeacon(ociClient, registryClient, verifier, config)
+
+	err := Beacon.RestoreState(time.Second * 20)
+
+	if err != nil {
+		return err
+	}
+
+	e := newAPI()
+
+	org := NewOrGroup(ctx)
+	shutdown := &shutdownDeadline{gracePeriod: config.GracePeriod}
+
+	org.Go(Beacon.Start)
+	org.Go(func(ctx context.Context) error { return serve(ctx, e, config.Port, shutdown.Time) })
+
+	org.Go(func(ctx context.Context) error { return serveProxy(ctx, Beacon.Proxy(), config.Proxy, shutdown.Time) })
+
+	err = org.Wait()
+
+	if err != nil {
+		log.Errorf("shutting down: %s", err)
+	} else {
+		log.Info("shutting down")
+	}
+
+	return errors.Join(err, Beacon.Shutdown(shutdown.Time()))
+}
+
+// serveProxy runs the reverse proxy until ctx is cancelled. If it wasn't told where to listen, it waits for a probe
+// to get a route before listening on proxy.DefaultAddr, so that beacond doesn't take a port it has no use for
+func serveProxy(ctx context.Context, p *proxy.Proxy, listen proxy.Listen, shutdownDeadline func() time.Time) error {
+	if listen.Addr == "" && !listen.ServesTLS() {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.Routed():
+		}
+
+		listen.Addr = proxy.DefaultAddr
+		log.Infof("starting the reverse proxy on %s for probes with a route", listen.Addr)
+	}
+
+	return p.Serve(ctx, listen, shutdownDeadline)
+}
+
+// shutdownDeadline is when beacond has to have finished shutting down. It is fixed the first time it is asked for,
+// as shutting down starts, so that stopping the API, the proxy and the beacon share one grace period between them
+// rather than getting one each
+type shutdownDeadline struct {
+	gracePeriod time.Duration
+	once        sync.Once
+	deadline    time.Time
+}
+
+func (s *shutdownDeadline) Time() time.Time {
+	s.once.Do(func() { s.deadline = time.Now().Add(s.gracePeriod) })
+
+	return s.deadline
+}
 
+// newAPI routes each of the API's endpoints to its handler. The unversioned routes came before the v1 API, and are
+// kept so that older clients keep working
+func newAPI() *echo.Echo {
 	e := echo.New()
 
 	e.GET("/health", health)
+	e.GET("/health/live", health)
+	e.GET("/health/ready", ready)
 
-	e.GET("/beacon", getBeaconDetails)
+	e.GET("/beacon", getBeaconDetails, deprecated("/v1/beacon"))
 
-	e.GET("/probes", listProbes)
-	e.POST("/probe", createProbe)
-	e.DELETE("/probe", deleteProbe)
+	e.GET("/probes", listProbes, deprecated("/v1/probes"))
+	e.POST("/probe", createProbe, deprecated("/v1/probes"))
+	e.DELETE("/probe", deleteProbe, deprecated("/v1/probes/{namespace}/{repo}"))
+	e.PATCH("/probe", scaleProbe, deprecated("/v1/probes/{namespace}/{repo}"))
+	e.POST("/probe/approve", approveProbe, deprecated("/v1/probes/{namespace}/{repo}/approve"))
+	e.POST("/probe/reject", rejectProbe, deprecated("/v1/probes/{namespace}/{repo}/reject"))
 
-	org := NewOrGroup()
+	e.GET("/approvals", listApprovals, deprecated("/v1/approvals"))
 
-	org.Go(Beacon.Start)
-	org.Go(func() error { return e.Start(fmt.Sprintf(":%d", port)) })
+	e.GET("/secrets", listSecrets, deprecated("/v1/secrets"))
+	e.GET("/secret", getSecret, deprecated("/v1/secrets/{name}"))
+	e.PUT("/secret", setSecret, deprecated("/v1/secrets/{name}"))
+	e.DELETE("/secret", deleteSecret, deprecated("/v1/secrets/{name}"))
+
+	e.GET("/volumes", listVolumes, deprecated("/v1/volumes"))
+	e.GET("/volume", getVolume, deprecated("/v1/volumes/{name}"))
+	e.DELETE("/volume", deleteVolume, deprecated("/v1/volumes/{name}"))
+
+	registerV1(e)
 
-	e.Logger.Fatal(org.Wait())
+	return e
 }
 
-// health handles the GET /health method for beacond
+// serve runs the API server until ctx is cancelled. It then stops accepting requests, and waits until the shutdown
+// deadline for the requests in flight to finish
+func serve(ctx context.Context, e *echo.Echo, port int, deadline func() time.Time) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline())
+		defer cancel()
+
+		if err := e.Shutdown(shutdownCtx); err != nil {
+			log.Errorf("error shutting down API server: %s", err)
+		}
+	}()
+
+	err := e.Start(fmt.Sprintf(":%d", port))
+
+	if errors.Is(err, http.ErrServerClosed) {
+		<-stopped
+		return nil
+	}
+
+	return err
+}
+
+// health handles the GET /health and GET /health/live methods for beacond
 //
-//	@Summary		Health check
-//	@Description	reports the health of the beacond server
+//	@Summary		Liveness check
+//	@Description	reports that the beacond server is running and answering requests, without checking what it depends on
 //	@Produce		json
 //	@Success		200	{object}	BaseResponse
 //	@Router			/health [get]
+//	@Router			/health/live [get]
 func health(c echo.Context) error {
 	var r models.ServerBaseResponse
 
@@ -52,6 +197,39 @@ func health(c echo.Context) error {
 	return c.JSON(http.StatusOK, r)
 }
 
+// ready handles the GET /health/ready method for beacond
+//
+//	@Summary		Readiness check
+//	@Description	checks the container runtime, the registry and its rate limit, the reconcile loop and the state file, reporting the status and latency of each. The status is ok, degraded or failing, and beacond is only unready while a component is failing
+//	@Produce		json
+//	@Success		200	{object}	ReadinessResponse
+//	@Failure		503	{object}	ReadinessResponse
+//	@Router			/health/ready [get]
+func ready(c echo.Context) error {
+	readiness := Beacon.Ready(c.Request().Context())
+
+	r := models.ServerReadinessResponse{
+		Status:     string(readiness.Status),
+		CheckedAt:  readiness.CheckedAt.Format(time.RFC3339),
+		Components: []*models.ServerComponentResponse{},
+	}
+
+	for _, component := range readiness.Components {
+		r.Components = append(r.Components, &models.ServerComponentResponse{
+			Name:      component.Name,
+			Status:    string(component.Status),
+			LatencyMs: component.Latency.Milliseconds(),
+			Message:   component.Message,
+		})
+	}
+
+	if readiness.Status == HealthFailing {
+		return c.JSON(http.StatusServiceUnavailable, r)
+	}
+
+	return c.JSON(http.StatusOK, r)
+}
+
 // deleteProbe handles the DELETE /probe method for beacond
 //
 //	@Summary		Delete a probe
@@ -59,10 +237,12 @@ func health(c echo.Context) error {
 //	@Produce		json
 //	@Param			namespace	query		string	true	"the repo namespace the probe should check for image updates"
 //	@Param			repo		query		string	true	"the repo name which the probe should check for image updates"
-//	@Success		201			{object}	BaseResponse
+//	@Param			purge		query		boolean	false	"whether to also remove the probe's containers and volumes"
+//	@Success		200			{object}	BaseResponse
 //	@Failure		404			{object}	BaseResponse
 //	@Failure		400			{object}	BaseResponse
 //	@Failure		500			{object}	BaseResponse
+//	@Deprecated
 //	@Router			/probe [delete]
 func deleteProbe(c echo.Context) error {
 	var r models.ServerBaseResponse
@@ -76,24 +256,34 @@ func deleteProbe(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, r)
 	}
 
-	err := Beacon.StopProbe(namespace, repo, time.Second*20)
+	purge, err := boolFromQuery(c, "purge")
 
-	if _, ok := err.(BeaconErrorProbeDoesNotExist); ok {
+	if err != nil {
+		r.Message = "Invalid purge"
 		r.Error = err.Error()
-		r.Message = fmt.Sprintf("Probe not found for repo %s at namespace %s", repo, namespace)
 
-		return c.JSON(http.StatusNotFound, r)
+		return c.JSON(http.StatusBadRequest, r)
 	}
 
+	err = Beacon.StopProbe(namespace, repo, time.Second*20)
+
 	if err != nil {
-		r.Error = err.Error()
-		r.Message = fmt.Sprintf("Failed to create probe for repo %s at namespace %s", repo, namespace)
+		return legacyErrorResponse(c, err, fmt.Sprintf("Failed to delete probe for repo %s at namespace %s", repo, namespace), map[ErrorCode]string{
+			CodeProbeNotFound: fmt.Sprintf("Probe not found for repo %s at namespace %s", repo, namespace),
+		})
+	}
+
+	if purge {
+		if err := Beacon.PurgeProbe(namespace, repo); err != nil {
+			r.Error = err.Error()
+			r.Message = fmt.Sprintf("Probe deleted for repo %s at namespace %s, but its containers and volumes could not all be removed", repo, namespace)
 
-		return c.JSON(http.StatusInternalServerError, r)
+			return c.JSON(http.StatusInternalServerError, r)
+		}
 	}
 
 	r.Message = fmt.Sprintf("Probe successfully deleted for repo %s at namespace %s", repo, namespace)
-	return c.JSON(http.StatusCreated, r)
+	return c.JSON(http.StatusOK, r)
 }
 
 // createProbe handles the POST /probe method for beacond
@@ -103,11 +293,36 @@ func deleteProbe(c echo.Context) error {
 //	@Produce		json
 //	@Param			namespace	query		string	true	"the repo namespace the probe should check for image updates"
 //	@Param			repo		query		string	true	"the repo name which the probe should check for image updates"
+//	@Param			cpu_shares	query		integer	false	"the relative CPU weight of the probe's containers (1024 by default)"
+//	@Param			cpus		query		number	false	"the number of CPUs the probe's containers can use, enforced as a CPU quota"
+//	@Param			memory		query		string	false	"the memory limit of the probe's containers, in bytes or with a k, m or g suffix"
+//	@Param			pids_limit	query		integer	false	"the maximum number of processes in each of the probe's containers"
+//	@Param			replicas	query		integer	false	"the number of containers to run for the probe (1 by default)"
+//	@Param			host		query		string	false	"the hostname the proxy routes to the probe's containers (any hostname if left out)"
+//	@Param			path_prefix	query		string	false	"the path prefix the proxy routes to the probe's containers (any path if left out)"
+//	@Param			port		query		integer	false	"the container port the proxy sends requests to, which gives the probe a route"
+//	@Param			health_path	query		string	false	"a path that has to return a 2xx or 3xx response before a new container is routed to"
+//	@Param			env			query		[]string	false	"an environment variable to set in the probe's containers, as VARIABLE=value. Can be repeated"	collectionFormat(multi)
+//	@Param			secret_env	query		[]string	false	"a secret to set as an environment variable, as VARIABLE=secret-name. Can be repeated"	collectionFormat(multi)
+//	@Param			secret_file	query		[]string	false	"a secret to mount as a read only file, as /path/in/container=secret-name. Can be repeated"	collectionFormat(multi)
+//	@Param			notify		query		[]string	false	"a notification sink to tell about the probe, on top of those told about every probe. Can be repeated"	collectionFormat(multi)
+//	@Param			require_approval	query		boolean	false	"whether new digests wait to be approved before they are deployed"
+//	@Param			soak		query		string	false	"how long a new digest has to stay the latest before it is deployed, such as 10m"
+//	@Param			depends_on	query		[]string	false	"a probe, as namespace/repo, that has to be running before the probe's containers are started. Can be repeated"	collectionFormat(multi)
+//	@Param			restart_with_dependencies	query		boolean	false	"whether the probe's containers are restarted when one of its dependencies is redeployed"
+//	@Param			no_network	query		boolean	false	"whether the probe's containers stay off the network beacon creates for managed containers"
+//	@Param			network		query		[]string	false	"an existing network the probe's containers join on top of beacon's network. Can be repeated"	collectionFormat(multi)
+//	@Param			volume		query		[]string	false	"a named volume owned by the probe, as name:/path/in/container. Can be repeated"	collectionFormat(multi)
+//	@Param			hook		query		[]string	false	"a hook run from each new digest, as JSON such as {\"name\":\"migrate\",\"phase\":\"pre_deploy\",\"command\":[\"./migrate\"],\"env\":{},\"volumes\":[\"data:/data\"],\"timeout\":\"10m\"}. Can be repeated"	collectionFormat(multi)
 //	@Success		201			{object}	BaseResponse
 //	@Failure		409			{object}	BaseResponse
 //	@Failure		404			{object}	BaseResponse
 //	@Failure		400			{object}	BaseResponse
+//	@Failure		422			{object}	BaseResponse
 //	@Failure		500			{object}	BaseResponse
+//	@Failure		502			{object}	BaseResponse
+//	@Failure		503			{object}	BaseResponse
+//	@Deprecated
 //	@Router			/probe [post]
 func createProbe(c echo.Context) error {
 	var r models.ServerBaseResponse
@@ -122,49 +337,315 @@ func createProbe(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, r)
 	}
 
-	err := Beacon.Registry().TestRepo(namespace, repo)
+	resources, err := resourcesFromQuery(c)
 
 	if err != nil {
-		r.Message = fmt.Sprintf("Could not fetch repo %s in namespace %s", repo, namespace)
+		r.Message = "Invalid resource limits"
+		r.Error = err.Error()
 
-		if _, ok := err.(registry.GeneralServerError); ok {
-			r.Error = err.Error()
+		return c.JSON(http.StatusBadRequest, r)
+	}
 
-			return c.JSON(http.StatusInternalServerError, r)
-		}
+	replicas := 1
 
-		if _, ok := err.(registry.GeneralClientError); ok {
+	if v := c.QueryParam("replicas"); v != "" {
+		if replicas, err = parseReplicas(v); err != nil {
+			r.Message = "Invalid replicas"
 			r.Error = err.Error()
 
 			return c.JSON(http.StatusBadRequest, r)
 		}
+	}
 
-		if _, ok := err.(registry.NotFoundError); ok {
-			r.Error = err.Error()
+	route, err := routeFromQuery(c)
 
-			return c.JSON(http.StatusNotFound, r)
-		}
+	if err != nil {
+		r.Message = "Invalid route"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
 	}
 
-	err = Beacon.StartProbe(namespace, repo, time.Second*20)
+	env, err := envFromQuery(c)
 
-	if _, ok := err.(BeaconErrorProbeAlreadyExists); ok {
+	if err != nil {
+		r.Message = "Invalid environment variables"
 		r.Error = err.Error()
-		r.Message = fmt.Sprintf("Probe already exists for repo %s at namespace %s", repo, namespace)
 
-		return c.JSON(http.StatusConflict, r)
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	secretRefs, err := secretRefsFromQuery(c)
+
+	if err != nil {
+		r.Message = "Invalid secrets"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	volumeRefs, err := volumeRefsFromQuery(c)
+
+	if err != nil {
+		r.Message = "Invalid volumes"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	hooks, err := hooksFromQuery(c)
+
+	if err != nil {
+		r.Message = "Invalid hooks"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	requireApproval, err := boolFromQuery(c, "require_approval")
+
+	if err != nil {
+		r.Message = "Invalid require_approval"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	restartWithDependencies, err := boolFromQuery(c, "restart_with_dependencies")
+
+	if err != nil {
+		r.Message = "Invalid restart_with_dependencies"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	noNetwork, err := boolFromQuery(c, "no_network")
+
+	if err != nil {
+		r.Message = "Invalid no_network"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	var soak time.Duration
+
+	if v := c.QueryParam("soak"); v != "" {
+		if soak, err = time.ParseDuration(v); err != nil || soak < 0 {
+			r.Message = "Invalid soak"
+			r.Error = fmt.Sprintf("soak must be a positive duration such as 10m, got %q", v)
+
+			return c.JSON(http.StatusBadRequest, r)
+		}
+	}
+
+	err = Beacon.Registry().TestRepo(c.Request().Context(), namespace, repo)
+
+	if err != nil {
+		return legacyErrorResponse(c, err, fmt.Sprintf("Could not fetch repo %s in namespace %s", repo, namespace), nil)
+	}
+
+	options := ProbeOptions{
+		Resources:               resources,
+		Replicas:                replicas,
+		Route:                   route,
+		Env:                     env,
+		Secrets:                 secretRefs,
+		Notify:                  c.QueryParams()["notify"],
+		RequireApproval:         requireApproval,
+		Soak:                    soak,
+		DependsOn:               c.QueryParams()["depends_on"],
+		RestartWithDependencies: restartWithDependencies,
+		NoNetwork:               noNetwork,
+		Networks:                c.QueryParams()["network"],
+		Volumes:                 volumeRefs,
+		Hooks:                   hooks,
+	}
+
+	err = Beacon.StartProbe(namespace, repo, options, time.Second*20)
+
+	if err != nil {
+		return legacyErrorResponse(c, err, fmt.Sprintf("Failed to create probe for repo %s at namespace %s", repo, namespace), map[ErrorCode]string{
+			CodeProbeExists:          fmt.Sprintf("Probe already exists for repo %s at namespace %s", repo, namespace),
+			CodeInvalidSecretRef:     fmt.Sprintf("Invalid secrets for repo %s at namespace %s", repo, namespace),
+			CodeSecretsDisabled:      "Secrets are not enabled on this beacon",
+			CodeInvalidVolume:        fmt.Sprintf("Invalid volumes for repo %s at namespace %s", repo, namespace),
+			CodeInvalidHook:          fmt.Sprintf("Invalid hooks for repo %s at namespace %s", repo, namespace),
+			CodeUnknownSink:          fmt.Sprintf("Invalid notification sinks for repo %s at namespace %s", repo, namespace),
+			CodeInvalidDependency:    fmt.Sprintf("Invalid dependencies for repo %s at namespace %s", repo, namespace),
+			CodeRouteConflict:        fmt.Sprintf("Route for repo %s at namespace %s is already used by another probe", repo, namespace),
+			CodeInsufficientCapacity: fmt.Sprintf("Not enough capacity left on this host for repo %s at namespace %s", repo, namespace),
+		})
 	}
 
 	r.Message = fmt.Sprintf("Probe successfully created for repo %s at namespace %s", repo, namespace)
 	return c.JSON(http.StatusCreated, r)
 }
 
+// scaleProbe handles the PATCH /probe method for beacond
+//
+//	@Summary		Scale a probe
+//	@Description	changes the number of containers run for the probe, without redeploying the ones that are kept
+//	@Produce		json
+//	@Param			namespace	query		string	true	"the repo namespace the probe should check for image updates"
+//	@Param			repo		query		string	true	"the repo name which the probe should check for image updates"
+//	@Param			replicas	query		integer	true	"the number of containers to run for the probe"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		404			{object}	BaseResponse
+//	@Failure		400			{object}	BaseResponse
+//	@Failure		422			{object}	BaseResponse
+//	@Failure		500			{object}	BaseResponse
+//	@Deprecated
+//	@Router			/probe [patch]
+func scaleProbe(c echo.Context) error {
+	var r models.ServerBaseResponse
+
+	namespace := c.QueryParam("namespace")
+	repo := c.QueryParam("repo")
+
+	if namespace == "" || repo == "" || c.QueryParam("replicas") == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect namespace, repo and replicas query params to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	replicas, err := parseReplicas(c.QueryParam("replicas"))
+
+	if err != nil {
+		r.Message = "Invalid replicas"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	err = Beacon.ScaleProbe(namespace, repo, replicas)
+
+	if err != nil {
+		return legacyErrorResponse(c, err, fmt.Sprintf("Failed to scale probe for repo %s at namespace %s", repo, namespace), map[ErrorCode]string{
+			CodeProbeNotFound:        fmt.Sprintf("Probe not found for repo %s at namespace %s", repo, namespace),
+			CodeInsufficientCapacity: fmt.Sprintf("Not enough capacity left on this host to scale repo %s at namespace %s", repo, namespace),
+		})
+	}
+
+	r.Message = fmt.Sprintf("Probe for repo %s at namespace %s scaled to %d replicas", repo, namespace, replicas)
+	return c.JSON(http.StatusOK, r)
+}
+
+// approveProbe handles the POST /probe/approve method for beacond
+//
+//	@Summary		Approve a digest
+//	@Description	deploys the digest waiting to be approved for the probe
+//	@Produce		json
+//	@Param			namespace	query		string	true	"the repo namespace the probe should check for image updates"
+//	@Param			repo		query		string	true	"the repo name which the probe should check for image updates"
+//	@Param			digest		query		string	false	"the digest expected to be waiting, which is refused if another one is"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	BaseResponse
+//	@Failure		404			{object}	BaseResponse
+//	@Failure		409			{object}	BaseResponse
+//	@Deprecated
+//	@Router			/probe/approve [post]
+func approveProbe(c echo.Context) error {
+	return decideProbe(c, "approve", Beacon.ApproveProbe)
+}
+
+// rejectProbe handles the POST /probe/reject method for beacond
+//
+//	@Summary		Reject a digest
+//	@Description	skips the digest waiting to be approved for the probe, which keeps running its current digest
+//	@Produce		json
+//	@Param			namespace	query		string	true	"the repo namespace the probe should check for image updates"
+//	@Param			repo		query		string	true	"the repo name which the probe should check for image updates"
+//	@Param			digest		query		string	false	"the digest expected to be waiting, which is refused if another one is"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	BaseResponse
+//	@Failure		404			{object}	BaseResponse
+//	@Failure		409			{object}	BaseResponse
+//	@Deprecated
+//	@Router			/probe/reject [post]
+func rejectProbe(c echo.Context) error {
+	return decideProbe(c, "reject", Beacon.RejectProbe)
+}
+
+// decideProbe approves or rejects the digest waiting to be approved for the probe in the URL query parameters
+func decideProbe(c echo.Context, action string, decide func(string, string, string) (string, error)) error {
+	var r models.ServerBaseResponse
+
+	namespace := c.QueryParam("namespace")
+	repo := c.QueryParam("repo")
+
+	if namespace == "" || repo == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect namespace and repo query params to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	digest, err := decide(namespace, repo, c.QueryParam("digest"))
+
+	if err != nil {
+		return legacyErrorResponse(c, err, fmt.Sprintf("Failed to %s digest for repo %s at namespace %s", action, repo, namespace), map[ErrorCode]string{
+			CodeProbeNotFound:      fmt.Sprintf("Probe not found for repo %s at namespace %s", repo, namespace),
+			CodeNotPendingApproval: fmt.Sprintf("Nothing to %s for repo %s at namespace %s", action, repo, namespace),
+		})
+	}
+
+	r.Message = fmt.Sprintf("Digest %s %sd for repo %s at namespace %s", digest, action, repo, namespace)
+	return c.JSON(http.StatusOK, r)
+}
+
+// listApprovals handles the GET /approvals method for beacond
+//
+//	@Summary		Lists digests waiting to be approved
+//	@Description	lists the probes waiting for a new digest to be approved, with the digest, tag and when it was pushed
+//	@Produce		json
+//	@Success		200	{object}	ListApprovalsResponse
+//	@Deprecated
+//	@Router			/approvals [get]
+func listApprovals(c echo.Context) error {
+	r := models.ServerListApprovalsResponse{Approvals: []*models.ServerApproval{}}
+
+	for _, probeRef := range Beacon.ListProbes() {
+		namespace, repo, _ := strings.Cut(probeRef, "/")
+		probe, ok := Beacon.GetProbe(namespace, repo)
+
+		if !ok {
+			continue
+		}
+
+		state := probe.State()
+
+		if state.Status != PendingApproval || state.Candidate == nil {
+			continue
+		}
+
+		approval := &models.ServerApproval{
+			Probe:         probeRef,
+			Digest:        state.Candidate.Digest,
+			Tag:           state.Candidate.Tag,
+			CurrentDigest: state.CurrentDigest,
+			FoundAt:       state.LastUpdated.Format(time.RFC3339),
+		}
+
+		if !state.Candidate.Pushed.IsZero() {
+			approval.PushedAt = state.Candidate.Pushed.Format(time.RFC3339)
+		}
+
+		r.Approvals = append(r.Approvals, approval)
+	}
+
+	return c.JSON(http.StatusOK, r)
+}
+
 // listProbes handles the GET /probes method for beacond
 //
 //	@Summary		Lists all probes
 //	@Description	lists probes that are running for beacond
 //	@Produce		json
 //	@Success		200	{object}	ListProbesResponse
+//	@Deprecated
 //	@Router			/probes [get]
 func listProbes(c echo.Context) error {
 	var r models.ServerListProbesResponse
@@ -180,13 +661,567 @@ func listProbes(c echo.Context) error {
 //	@Description	describes the current status of beacond
 //	@Produce		json
 //	@Success		200	{object}	BeaconDescribeResponse
+//	@Deprecated
 //	@Router			/beacon [get]
 func getBeaconDetails(c echo.Context) error {
 	var r models.ServerBeaconDescribeResponse
 
+	capacity := Beacon.Capacity()
+	allocated := Beacon.Allocated()
+
 	r.Registry = Beacon.Registry().URL()
 	r.Probes = Beacon.ListProbes()
 	r.Runtime = string(Beacon.Runtime().Type())
+	r.Capacity = &models.ServerHostResources{Cpus: capacity.CPUs, Memory: capacity.Memory}
+	r.Allocated = &models.ServerHostResources{Cpus: allocated.CPUs(), Memory: allocated.Memory}
 
 	return c.JSON(http.StatusOK, r)
 }
+
+// boolFromQuery reads a true or false URL query parameter, which is false if it is left out
+func boolFromQuery(c echo.Context, name string) (bool, error) {
+	v := c.QueryParam(name)
+
+	if v == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false, got %q", name, v)
+	}
+
+	return b, nil
+}
+
+// resourcesFromQuery reads the resource limits for a probe from the URL query parameters
+func resourcesFromQuery(c echo.Context) (oci.Resources, error) {
+	var resources oci.Resources
+	var err error
+
+	if v := c.QueryParam("cpu_shares"); v != "" {
+		if resources.CPUShares, err = strconv.ParseInt(v, 10, 64); err != nil || resources.CPUShares < 0 {
+			return resources, fmt.Errorf("cpu_shares must be a positive integer, got %q", v)
+		}
+	}
+
+	if v := c.QueryParam("cpus"); v != "" {
+		cpus, err := strconv.ParseFloat(v, 64)
+
+		if err != nil || cpus < 0 {
+			return resources, fmt.Errorf("cpus must be a positive number, got %q", v)
+		}
+
+		resources.CPUQuota = int64(cpus * oci.CPUPeriod)
+	}
+
+	if v := c.QueryParam("memory"); v != "" {
+		if resources.Memory, err = parseBytes(v); err != nil {
+			return resources, err
+		}
+	}
+
+	if v := c.QueryParam("pids_limit"); v != "" {
+		if resources.PidsLimit, err = strconv.ParseInt(v, 10, 64); err != nil || resources.PidsLimit < 0 {
+			return resources, fmt.Errorf("pids_limit must be a positive integer, got %q", v)
+		}
+	}
+
+	return resources, nil
+}
+
+// envFromQuery reads the environment variables to set in a probe's containers from the URL query parameters
+func envFromQuery(c echo.Context) (map[string]string, error) {
+	var env map[string]string
+
+	for _, v := range c.QueryParams()["env"] {
+		// Values can contain =, but variable names can't
+		name, value, ok := strings.Cut(v, "=")
+
+		if !ok || name == "" {
+			return nil, fmt.Errorf("env must be given as <variable>=<value>, got %q", v)
+		}
+
+		if env == nil {
+			env = map[string]string{}
+		}
+
+		env[name] = value
+	}
+
+	return env, nil
+}
+
+// secretRefsFromQuery reads the secrets to inject into a probe's containers from the URL query parameters
+func secretRefsFromQuery(c echo.Context) ([]SecretRef, error) {
+	var refs []SecretRef
+
+	for param, file := range map[string]bool{"secret_env": false, "secret_file": true} {
+		for _, v := range c.QueryParams()[param] {
+			// Secret names can't contain =, so the last one separates the name from where it is injected
+			i := strings.LastIndex(v, "=")
+
+			if i <= 0 || i == len(v)-1 {
+				return nil, fmt.Errorf("%s must be given as <target>=<secret name>, got %q", param, v)
+			}
+
+			ref := SecretRef{Name: v[i+1:]}
+
+			if file {
+				ref.File = v[:i]
+			} else {
+				ref.Env = v[:i]
+			}
+
+			refs = append(refs, ref)
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Env+refs[i].File < refs[j].Env+refs[j].File })
+
+	return refs, nil
+}
+
+// volumeRefsFromQuery reads the volumes to mount into a probe's containers from the URL query parameters
+func volumeRefsFromQuery(c echo.Context) ([]VolumeRef, error) {
+	var refs []VolumeRef
+
+	for _, v := range c.QueryParams()["volume"] {
+		ref, err := parseVolumeRef(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// parseVolumeRef reads a volume given as <name>:<path in container>
+func parseVolumeRef(v string) (VolumeRef, error) {
+	name, target, ok := strings.Cut(v, ":")
+
+	if !ok || name == "" || target == "" {
+		return VolumeRef{}, fmt.Errorf("volume must be given as <name>:<path in container>, got %q", v)
+	}
+
+	return VolumeRef{Name: name, Target: target}, nil
+}
+
+// hooksFromQuery reads a probe's hooks from the URL query parameters, where each is given as a JSON object
+func hooksFromQuery(c echo.Context) ([]Hook, error) {
+	var hooks []Hook
+
+	for _, v := range c.QueryParams()["hook"] {
+		var param struct {
+			Name    string            `json:"name"`
+			Phase   HookPhase         `json:"phase"`
+			Command []string          `json:"command"`
+			Env     map[string]string `json:"env"`
+			Volumes []string          `json:"volumes"`
+			Timeout string            `json:"timeout"`
+		}
+
+		if err := json.Unmarshal([]byte(v), &param); err != nil {
+			return nil, fmt.Errorf("hook must be a JSON object with a name, phase and command, got %q: %s", v, err)
+		}
+
+		hook := Hook{Name: param.Name, Phase: param.Phase, Command: param.Command, Env: param.Env}
+
+		for _, volume := range param.Volumes {
+			ref, err := parseVolumeRef(volume)
+
+			if err != nil {
+				return nil, fmt.Errorf("hook %s: %s", param.Name, err)
+			}
+
+			hook.Volumes = append(hook.Volumes, ref)
+		}
+
+		if param.Timeout != "" {
+			timeout, err := time.ParseDuration(param.Timeout)
+
+			if err != nil || timeout <= 0 {
+				return nil, fmt.Errorf("hook %s: timeout must be a positive duration such as 10m, got %q", param.Name, param.Timeout)
+			}
+
+			hook.Timeout = timeout
+		}
+
+		hooks = append(hooks, hook)
+	}
+
+	return hooks, nil
+}
+
+// routeFromQuery reads the route for a probe from the URL query parameters. A probe without a port has no route
+func routeFromQuery(c echo.Context) (*proxy.Route, error) {
+	route := proxy.Route{
+		Host:       c.QueryParam("host"),
+		PathPrefix: c.QueryParam("path_prefix"),
+		HealthPath: c.QueryParam("health_path"),
+	}
+
+	v := c.QueryParam("port")
+
+	if v == "" {
+		if route != (proxy.Route{}) {
+			return nil, fmt.Errorf("port must be provided with host, path_prefix or health_path")
+		}
+
+		return nil, nil
+	}
+
+	port, err := strconv.Atoi(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("port must be between 1 and 65535, got %q", v)
+	}
+
+	route.Port = port
+
+	if err := checkRoute(route); err != nil {
+		return nil, err
+	}
+
+	return &route, nil
+}
+
+// checkRoute checks that the proxy can route to a probe's containers with the route
+func checkRoute(route proxy.Route) error {
+	if route.Port < 1 || route.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535, got %d", route.Port)
+	}
+
+	if route.PathPrefix != "" && !strings.HasPrefix(route.PathPrefix, "/") {
+		return fmt.Errorf("path_prefix must start with /, got %q", route.PathPrefix)
+	}
+
+	if route.HealthPath != "" && !strings.HasPrefix(route.HealthPath, "/") {
+		return fmt.Errorf("health_path must start with /, got %q", route.HealthPath)
+	}
+
+	return nil
+}
+
+// parseReplicas parses the number of replicas for a probe, which has to run at least one
+func parseReplicas(value string) (int, error) {
+	replicas, err := strconv.Atoi(value)
+
+	if err != nil || replicas < 1 {
+		return 0, fmt.Errorf("replicas must be a positive integer, got %q", value)
+	}
+
+	return replicas, nil
+}
+
+// parseBytes parses sizes such as 512m or 1g (in the same units as `podman run --memory`) into bytes
+func parseBytes(size string) (int64, error) {
+	units := map[string]int64{"b": 1, "k": 1 << 10, "m": 1 << 20, "g": 1 << 30}
+	value := strings.ToLower(strings.TrimSpace(size))
+	multiplier := int64(1)
+
+	if value == "" {
+		return 0, fmt.Errorf("invalid size %q, expected a number of bytes optionally followed by k, m or g", size)
+	}
+
+	if unit, ok := units[value[len(value)-1:]]; ok {
+		multiplier = unit
+		value = value[:len(value)-1]
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q, expected a number of bytes optionally followed by k, m or g", size)
+	}
+
+	return n * multiplier, nil
+}
+
+// listSecrets handles the GET /secrets method for beacond
+//
+//	@Summary		Lists all secrets
+//	@Description	lists the names of the secrets in the beacon's secret store
+//	@Produce		json
+//	@Success		200	{object}	ListSecretsResponse
+//	@Failure		503	{object}	BaseResponse
+//	@Deprecated
+//	@Router			/secrets [get]
+func listSecrets(c echo.Context) error {
+	names, err := Beacon.ListSecrets()
+
+	if err != nil {
+		return secretError(c, err, "Failed to list secrets")
+	}
+
+	return c.JSON(http.StatusOK, models.ServerListSecretsResponse{Secrets: names})
+}
+
+// getSecret handles the GET /secret method for beacond
+//
+//	@Summary		Describe a secret
+//	@Description	describes the secret named in the URL query parameters. Its value is never returned
+//	@Produce		json
+//	@Param			name	query		string	true	"the name of the secret"
+//	@Success		200		{object}	SecretResponse
+//	@Failure		400		{object}	BaseResponse
+//	@Failure		404		{object}	BaseResponse
+//	@Failure		503		{object}	BaseResponse
+//	@Deprecated
+//	@Router			/secret [get]
+func getSecret(c echo.Context) error {
+	var r models.ServerBaseResponse
+
+	name := c.QueryParam("name")
+
+	if name == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect name query param to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	secret, err := Beacon.GetSecret(name)
+
+	if err != nil {
+		return secretError(c, err, fmt.Sprintf("Failed to get secret %s", name))
+	}
+
+	return c.JSON(http.StatusOK, models.ServerSecretResponse{
+		Name:      secret.Name,
+		UpdatedAt: secret.UpdatedAt.Format(time.RFC3339),
+		UsedBy:    secret.UsedBy,
+	})
+}
+
+// setSecret handles the PUT /secret method for beacond
+//
+//	@Summary		Set a secret
+//	@Description	creates or replaces the secret named in the URL query parameters. The value is sent in the body, so that it doesn't end up in access logs
+//	@Accept			json
+//	@Produce		json
+//	@Param			name	query		string				true	"the name of the secret"
+//	@Param			secret	body		SetSecretRequest	true	"the value of the secret"
+//	@Success		201		{object}	BaseResponse
+//	@Failure		400		{object}	BaseResponse
+//	@Failure		500		{object}	BaseResponse
+//	@Failure		503		{object}	BaseResponse
+//	@Deprecated
+//	@Router			/secret [put]
+func setSecret(c echo.Context) error {
+	var r models.ServerBaseResponse
+	var body models.ServerSetSecretRequest
+
+	name := c.QueryParam("name")
+
+	if name == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect name query param to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	if err := secrets.ValidateName(name); err != nil {
+		r.Message = "Invalid secret name"
+		r.Error = err.Error()
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	// The body isn't included in the error, as it holds the secret
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil || body.Value == nil {
+		r.Message = "Invalid body"
+		r.Error = "Expect the body to be a JSON object with the secret's value"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	err := Beacon.SetSecret(name, []byte(*body.Value))
+
+	if err != nil {
+		return secretError(c, err, fmt.Sprintf("Failed to set secret %s", name))
+	}
+
+	r.Message = fmt.Sprintf("Secret %s successfully set", name)
+	return c.JSON(http.StatusCreated, r)
+}
+
+// deleteSecret handles the DELETE /secret method for beacond
+//
+//	@Summary		Delete a secret
+//	@Description	deletes the secret named in the URL query parameters, unless a probe still references it
+//	@Produce		json
+//	@Param			name	query		string	true	"the name of the secret"
+//	@Success		200		{object}	BaseResponse
+//	@Failure		400		{object}	BaseResponse
+//	@Failure		404		{object}	BaseResponse
+//	@Failure		409		{object}	BaseResponse
+//	@Failure		500		{object}	BaseResponse
+//	@Failure		503		{object}	BaseResponse
+//	@Deprecated
+//	@Router			/secret [delete]
+func deleteSecret(c echo.Context) error {
+	var r models.ServerBaseResponse
+
+	name := c.QueryParam("name")
+
+	if name == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect name query param to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	err := Beacon.DeleteSecret(name)
+
+	if err != nil {
+		return secretError(c, err, fmt.Sprintf("Failed to delete secret %s", name))
+	}
+
+	r.Message = fmt.Sprintf("Secret %s successfully deleted", name)
+	return c.JSON(http.StatusOK, r)
+}
+
+// secretError responds with the status code for an error from the secret store. Errors from the store never
+// include secret values, so they can be returned as they are
+func secretError(c echo.Context, err error, message string) error {
+	return legacyErrorResponse(c, err, message, map[ErrorCode]string{
+		CodeSecretsDisabled: "Secrets are not enabled on this beacon",
+	})
+}
+
+// legacyErrorResponse responds to an error returned by the beacon on one of the unversioned routes. The status code
+// is the one the v1 API responds with, while the message is the one for the error's code in messages, or message
+// if there isn't one
+func legacyErrorResponse(c echo.Context, err error, message string, messages map[ErrorCode]string) error {
+	status, code := classifyError(err)
+
+	if m, ok := messages[code]; ok {
+		message = m
+	}
+
+	return c.JSON(status, models.ServerBaseResponse{Message: message, Error: err.Error()})
+}
+
+// listVolumes handles the GET /volumes method for beacond
+//
+//	@Summary		Lists all volumes
+//	@Description	lists the volumes created for the beacon's probes, including those of probes that have been deleted
+//	@Produce		json
+//	@Success		200	{object}	ListVolumesResponse
+//	@Failure		500	{object}	BaseResponse
+//	@Deprecated
+//	@Router			/volumes [get]
+func listVolumes(c echo.Context) error {
+	volumes, err := Beacon.ListVolumes()
+
+	if err != nil {
+		return volumeError(c, err, "Failed to list volumes")
+	}
+
+	return c.JSON(http.StatusOK, listVolumesResponse(volumes))
+}
+
+// getVolume handles the GET /volume method for beacond
+//
+//	@Summary		Describe a volume
+//	@Description	describes the volume named in the URL query parameters, with its size and the probe that owns it
+//	@Produce		json
+//	@Param			name	query		string	true	"the name of the volume"
+//	@Success		200		{object}	VolumeResponse
+//	@Failure		400		{object}	BaseResponse
+//	@Failure		404		{object}	BaseResponse
+//	@Failure		500		{object}	BaseResponse
+//	@Deprecated
+//	@Router			/volume [get]
+func getVolume(c echo.Context) error {
+	var r models.ServerBaseResponse
+
+	name := c.QueryParam("name")
+
+	if name == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect name query param to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	volume, err := Beacon.GetVolume(name)
+
+	if err != nil {
+		return volumeError(c, err, fmt.Sprintf("Failed to get volume %s", name))
+	}
+
+	return c.JSON(http.StatusOK, volumeResponse(volume))
+}
+
+// deleteVolume handles the DELETE /volume method for beacond
+//
+//	@Summary		Delete a volume
+//	@Description	deletes the volume named in the URL query parameters, unless the probe that owns it still exists
+//	@Produce		json
+//	@Param			name	query		string	true	"the name of the volume"
+//	@Success		200		{object}	BaseResponse
+//	@Failure		400		{object}	BaseResponse
+//	@Failure		404		{object}	BaseResponse
+//	@Failure		409		{object}	BaseResponse
+//	@Failure		500		{object}	BaseResponse
+//	@Deprecated
+//	@Router			/volume [delete]
+func deleteVolume(c echo.Context) error {
+	var r models.ServerBaseResponse
+
+	name := c.QueryParam("name")
+
+	if name == "" {
+		r.Message = "Missing query parameters"
+		r.Error = "Expect name query param to be provided"
+
+		return c.JSON(http.StatusBadRequest, r)
+	}
+
+	err := Beacon.RemoveVolume(name)
+
+	if err != nil {
+		return volumeError(c, err, fmt.Sprintf("Failed to delete volume %s", name))
+	}
+
+	r.Message = fmt.Sprintf("Volume %s successfully deleted", name)
+	return c.JSON(http.StatusOK, r)
+}
+
+func listVolumesResponse(volumes []VolumeDetails) models.ServerListVolumesResponse {
+	r := models.ServerListVolumesResponse{Volumes: []*models.ServerVolumeResponse{}}
+
+	for _, volume := range volumes {
+		r.Volumes = append(r.Volumes, volumeResponse(volume))
+	}
+
+	return r
+}
+
+func volumeResponse(volume VolumeDetails) *models.ServerVolumeResponse {
+	r := &models.ServerVolumeResponse{
+		Name:        volume.Name,
+		Probe:       volume.Probe,
+		Volume:      volume.VolumeName,
+		ProbeExists: volume.ProbeExists,
+		Mountpoint:  volume.Mountpoint,
+		Size:        volume.Size,
+	}
+
+	if !volume.CreatedAt.IsZero() {
+		r.CreatedAt = volume.CreatedAt.Format(time.RFC3339)
+	}
+
+	return r
+}
+
+// volumeError responds with the status code for an error from managing volumes
+func volumeError(c echo.Context, err error, message string) error {
+	return legacyErrorResponse(c, err, message, nil)
+}
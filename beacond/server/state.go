@@ -0,0 +1,271 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// The version of the state file written by beacond, so that older state files can be migrated if its format changes
+const stateVersion = 1
+
+// savedState is what beacond persists between runs, so that probes created through the API survive a restart
+type savedState struct {
+	Version  int          `json:"version"`
+	BeaconID string       `json:"beacon_id"`
+	Probes   []savedProbe `json:"probes"`
+}
+
+type savedProbe struct {
+	Namespace string       `json:"namespace"`
+	Repo      string       `json:"repo"`
+	Options   ProbeOptions `json:"options"`
+	// Kept so that a rejected digest isn't offered for approval again after a restart
+	RejectedDigest string `json:"rejected_digest,omitempty"`
+	// Kept so that an approved digest that hadn't been deployed yet isn't offered for approval again after a restart
+	ApprovedDigest string `json:"approved_digest,omitempty"`
+}
+
+// newBeaconID generates the ID of a beacon, which is kept for as long as its state file is
+func newBeaconID() string {
+	id := make([]byte, 8)
+
+	if _, err := rand.Read(id); err != nil {
+		panic(fmt.Sprintf("error generating beacon ID: %s", err))
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// DefaultStateFile is where beacond keeps its state if no other path is given
+func DefaultStateFile() string {
+	home, err := os.UserHomeDir()
+
+	if err != nil {
+		return filepath.Join(".beacon", "state.json")
+	}
+
+	return filepath.Join(home, ".beacon", "state.json")
+}
+
+// DefaultConfigFile is where beacond's config file is read from if no other path is given
+func DefaultConfigFile() string {
+	return filepath.Join(filepath.Dir(DefaultStateFile()), "beacond.yaml")
+}
+
+// SaveState writes the probes to the state file. The file is replaced in one go, so that beacond being killed
+// while saving doesn't leave it half written
+func (b *beacon) SaveState() error {
+	if b.StateFile == "" {
+		return nil
+	}
+
+	b.mu.RLock()
+	state := savedState{Version: stateVersion, BeaconID: b.ID, Probes: []savedProbe{}}
+
+	for _, probe := range b.probes {
+		probeState := probe.State()
+
+		state.Probes = append(state.Probes, savedProbe{
+			Namespace:      probe.Namespace,
+			Repo:           probe.Repo,
+			Options:        probe.Options(),
+			RejectedDigest: probeState.RejectedDigest,
+			ApprovedDigest: probeState.ApprovedDigest,
+		})
+	}
+	b.mu.RUnlock()
+
+	sort.Slice(state.Probes, func(i, j int) bool {
+		return fmt.Sprintf("%s/%s", state.Probes[i].Namespace, state.Probes[i].Repo) < fmt.Sprintf("%s/%s", state.Probes[j].Namespace, state.Probes[j].Repo)
+	})
+
+	content, err := json.MarshalIndent(state, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding state: %s", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(b.StateFile), 0700)
+
+	if err != nil {
+		return fmt.Errorf("error creating directory for state file %s: %s", b.StateFile, err)
+	}
+
+	tmp := b.StateFile + ".tmp"
+	err = os.WriteFile(tmp, content, 0600)
+
+	if err != nil {
+		return fmt.Errorf("error writing state file %s: %s", tmp, err)
+	}
+
+	err = os.Rename(tmp, b.StateFile)
+
+	if err != nil {
+		return fmt.Errorf("error replacing state file %s: %s", b.StateFile, err)
+	}
+
+	return nil
+}
+
+// RestoreState starts the probes saved in the state file, adopting the containers that were running for them. A
+// missing state file is not an error, as there is nothing to restore the first time beacond runs
+func (b *beacon) RestoreState(delay time.Duration) error {
+	if b.StateFile == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(b.StateFile)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading state file %s: %s", b.StateFile, err)
+	}
+
+	var state savedState
+
+	if err := json.Unmarshal(content, &state); err != nil {
+		return fmt.Errorf("error decoding state file %s: %s", b.StateFile, err)
+	}
+
+	if state.Version > stateVersion {
+		return fmt.Errorf("state file %s was written by a newer version of beacond (version %d)", b.StateFile, state.Version)
+	}
+
+	if state.BeaconID != "" {
+		b.ID = state.BeaconID
+	}
+
+	adopted := b.adoptableContainers()
+
+	for _, saved := range state.Probes {
+		probe := NewProbe(b.ctx, saved.Namespace, saved.Repo, saved.Options)
+		probe.update(func(s *ProbeState) {
+			s.RejectedDigest = saved.RejectedDigest
+			s.ApprovedDigest = saved.ApprovedDigest
+		})
+
+		// The containers are adopted before the probe starts, so that its first check doesn't redeploy the digest
+		// that is already running
+		adopt(probe, adopted[probe.Ref()])
+
+		// Probes were checked against the host's capacity when they were created, so they are restored even if they
+		// no longer fit (e.g. if memory was taken out of the host) rather than being dropped
+		err := b.addProbe(probe, delay, false)
+
+		if err != nil {
+			return fmt.Errorf("error restoring probe %s/%s: %s", saved.Namespace, saved.Repo, err)
+		}
+	}
+
+	// Adopted containers are checked straight away, so that the proxy can route to them as soon as possible
+	if len(adopted) > 0 {
+		b.notify()
+	}
+
+	return nil
+}
+
+// adopt makes the containers the probe's replicas. If they were all running the same digest, it becomes the
+// probe's current digest. Otherwise the probe is left to roll the latest digest out to all of them
+func adopt(probe *Probe, containers map[int]oci.Container) {
+	digests := map[string]bool{}
+
+	for replica, container := range containers {
+		digest := container.Labels[oci.LabelDigest]
+		digests[digest] = true
+
+		probe.setReplica(replica, Replica{ContainerID: container.ID, Digest: digest})
+		probe.RecordEvent(EventContainerAdopted, fmt.Sprintf("adopted container %s running %s as replica %d", container.ID, digest, replica))
+	}
+
+	if len(digests) != 1 {
+		return
+	}
+
+	for digest := range digests {
+		probe.update(func(s *ProbeState) {
+			s.CurrentDigest = digest
+			s.LatestDigest = digest
+		})
+	}
+}
+
+// adoptableContainers finds the containers labelled as created by this beacon, keyed by the ref of the probe and then
+// the replica they were created for. Running containers are preferred over stopped ones, which are restarted by the
+// reconcile loop once adopted. Only one container is adopted for each replica: any others would be left running
+// untracked, holding on to capacity, so they are removed. Failing to list containers only means that they are
+// redeployed, so it is logged rather than returned
+func (b *beacon) adoptableContainers() map[string]map[int]oci.Container {
+	ctx, cancel := context.WithTimeout(b.ctx, adoptTimeout)
+	defer cancel()
+
+	adoptable := map[string]map[int]oci.Container{}
+	containers, err := b.OCIClient.ListContainers(ctx, map[string]string{oci.LabelBeaconID: b.ID}, nil)
+
+	if err != nil {
+		log.Warnf("could not find containers to adopt, so they will be redeployed: %s", err)
+		return adoptable
+	}
+
+	surplus := []oci.Container{}
+
+	for _, container := range containers {
+		probeRef := container.Labels[oci.LabelProbe]
+
+		// Hook containers are removed once they exit, but one may be left behind if beacond stopped while it ran
+		if probeRef == "" || container.Labels[oci.LabelDigest] == "" || container.Labels[oci.LabelHook] != "" {
+			continue
+		}
+
+		// Containers created before replicas were introduced don't have a replica label, and were the only replica
+		replica := 0
+
+		if label, ok := container.Labels[oci.LabelReplica]; ok {
+			if replica, err = strconv.Atoi(label); err != nil || replica < 0 {
+				continue
+			}
+		}
+
+		if adoptable[probeRef] == nil {
+			adoptable[probeRef] = map[int]oci.Container{}
+		}
+
+		current, ok := adoptable[probeRef][replica]
+
+		if ok && current.Status == "running" {
+			surplus = append(surplus, container)
+			continue
+		}
+
+		if ok {
+			surplus = append(surplus, current)
+		}
+
+		adoptable[probeRef][replica] = container
+	}
+
+	for _, container := range surplus {
+		log.Warnf("removing container %s of probe %s, as another container was adopted as the same replica", container.ID, container.Labels[oci.LabelProbe])
+
+		if err := b.OCIClient.RemoveContainer(ctx, container.ID); err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+			log.Errorf("error removing container %s: %s", container.ID, err)
+		}
+	}
+
+	return adoptable
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type StateSuite struct {
+	suite.Suite
+	StateFile string
+}
+
+func TestStateSuite(t *testing.T) {
+	suite.Run(t, new(StateSuite))
+}
+
+func (s *StateSuite) SetupTest() {
+	s.StateFile = filepath.Join(s.T().TempDir(), "beacon", "state.json")
+}
+
+// newBeacon creates a beacon with no containers to adopt, unless it is given a runtime that has some
+func (s *StateSuite) newBeacon(mockController *gomock.Controller, ociClient ...*oci.MockOCIRuntime) *beacon {
+	if len(ociClient) == 0 {
+		runtime := oci.NewMockOCIRuntime(mockController)
+		runtime.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]oci.Container{}, nil).AnyTimes()
+		ociClient = append(ociClient, runtime)
+	}
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	return newBeacon(ociClient[0], registryClient, nil, Config{StateFile: s.StateFile}, host.Capacity{Memory: 1024})
+}
+
+func (s *StateSuite) TestSaveAndRestore() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	saved := s.newBeacon(mockController)
+	options := ProbeOptions{Resources: oci.Resources{Memory: 256}, Replicas: 2}
+
+	assert.NoError(s.T(), saved.StartProbe("fakeNamespace", "fakeRepoA", options, time.Hour))
+	assert.NoError(s.T(), saved.StartProbe("fakeNamespace", "fakeRepoB", ProbeOptions{}, time.Hour))
+	assert.NoError(s.T(), saved.StopProbe("fakeNamespace", "fakeRepoB", time.Second))
+	assert.NoError(s.T(), saved.StopProbes(time.Second))
+
+	restored := s.newBeacon(mockController)
+
+	assert.NoError(s.T(), restored.RestoreState(time.Hour))
+	assert.Equal(s.T(), []string{"fakeNamespace/fakeRepoA"}, restored.ListProbes())
+
+	probe, _ := restored.GetProbe("fakeNamespace", "fakeRepoA")
+	assert.Equal(s.T(), options, probe.ProbeOptions)
+	assert.Equal(s.T(), saved.ID, restored.ID)
+	assert.NoError(s.T(), restored.StopProbes(time.Second))
+}
+
+func (s *StateSuite) TestRestoreAdoptsContainers() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	os.MkdirAll(filepath.Dir(s.StateFile), 0700)
+	os.WriteFile(s.StateFile, []byte(`{"version": 1, "beacon_id": "fakeBeaconId", "probes": [
+		{"namespace": "fakeNamespace", "repo": "fakeRepoA", "options": {"replicas": 2}},
+		{"namespace": "fakeNamespace", "repo": "fakeRepoB", "options": {}}
+	]}`), 0600)
+
+	labels := func(repo string, digest string, replica string) map[string]string {
+		return map[string]string{
+			oci.LabelBeaconID: "fakeBeaconId",
+			oci.LabelProbe:    "fakeNamespace/" + repo,
+			oci.LabelDigest:   digest,
+			oci.LabelReplica:  replica,
+		}
+	}
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), map[string]string{oci.LabelBeaconID: "fakeBeaconId"}, nil).Return([]oci.Container{
+		{ID: "fakeRunningId", Status: "running", Labels: labels("fakeRepoA", "fakeDigestA", "0")},
+		{ID: "fakeExitedId", Status: "exited", Labels: labels("fakeRepoA", "fakeOldDigestA", "0")},
+		{ID: "fakeSecondId", Status: "running", Labels: labels("fakeRepoA", "fakeDigestA", "1")},
+	}, nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeExitedId").Return(nil)
+
+	beacon := s.newBeacon(mockController, ociClient)
+
+	assert.NoError(s.T(), beacon.RestoreState(time.Hour))
+	assert.Equal(s.T(), "fakeBeaconId", beacon.ID)
+
+	adopted, _ := beacon.GetProbe("fakeNamespace", "fakeRepoA")
+	state := adopted.State()
+
+	assert.Equal(s.T(), []Replica{
+		{ContainerID: "fakeRunningId", Digest: "fakeDigestA"},
+		{ContainerID: "fakeSecondId", Digest: "fakeDigestA"},
+	}, state.Containers)
+	assert.Equal(s.T(), "fakeDigestA", state.CurrentDigest)
+
+	// Probes without a container are deployed as usual
+	notAdopted, _ := beacon.GetProbe("fakeNamespace", "fakeRepoB")
+
+	assert.Empty(s.T(), notAdopted.State().Containers)
+	assert.NoError(s.T(), beacon.StopProbes(time.Second))
+}
+
+func (s *StateSuite) TestRestoreRemovesDuplicateReplicas() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	os.MkdirAll(filepath.Dir(s.StateFile), 0700)
+	os.WriteFile(s.StateFile, []byte(`{"version": 1, "beacon_id": "fakeBeaconId", "probes": [
+		{"namespace": "fakeNamespace", "repo": "fakeRepo", "options": {}}
+	]}`), 0600)
+
+	labels := map[string]string{
+		oci.LabelBeaconID: "fakeBeaconId",
+		oci.LabelProbe:    "fakeNamespace/fakeRepo",
+		oci.LabelDigest:   "fakeDigest",
+		oci.LabelReplica:  "0",
+	}
+
+	// Every container is labelled as replica 0, so only the first running one is adopted
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), map[string]string{oci.LabelBeaconID: "fakeBeaconId"}, nil).Return([]oci.Container{
+		{ID: "fakeExitedId", Status: "exited", Labels: labels},
+		{ID: "fakeRunningId", Status: "running", Labels: labels},
+		{ID: "fakeDuplicateId", Status: "running", Labels: labels},
+	}, nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeExitedId").Return(nil)
+	ociClient.EXPECT().RemoveContainer(gomock.Any(), "fakeDuplicateId").Return(fmt.Errorf("fake error"))
+
+	beacon := s.newBeacon(mockController, ociClient)
+
+	assert.NoError(s.T(), beacon.RestoreState(time.Hour))
+
+	probe, _ := beacon.GetProbe("fakeNamespace", "fakeRepo")
+
+	assert.Equal(s.T(), []Replica{{ContainerID: "fakeRunningId", Digest: "fakeDigest"}}, probe.State().Containers)
+	assert.NoError(s.T(), beacon.StopProbes(time.Second))
+}
+
+func (s *StateSuite) TestRestoreWhenContainersCannotBeListed() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	os.MkdirAll(filepath.Dir(s.StateFile), 0700)
+	os.WriteFile(s.StateFile, []byte(`{"version": 1, "probes": [
+		{"namespace": "fakeNamespace", "repo": "fakeRepoA", "options": {}}
+	]}`), 0600)
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	ociClient.EXPECT().ListContainers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("fake error"))
+
+	beacon := s.newBeacon(mockController, ociClient)
+
+	assert.NoError(s.T(), beacon.RestoreState(time.Hour))
+	assert.Equal(s.T(), []string{"fakeNamespace/fakeRepoA"}, beacon.ListProbes())
+	assert.NoError(s.T(), beacon.StopProbes(time.Second))
+}
+
+func (s *StateSuite) TestRestoreIgnoresCapacity() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	os.MkdirAll(filepath.Dir(s.StateFile), 0700)
+	os.WriteFile(s.StateFile, []byte(`{"version": 1, "probes": [
+		{"namespace": "fakeNamespace", "repo": "fakeRepoA", "options": {"resources": {"memory": 1024}}},
+		{"namespace": "fakeNamespace", "repo": "fakeRepoB", "options": {"resources": {"memory": 1024}}}
+	]}`), 0600)
+
+	beacon := s.newBeacon(mockController)
+
+	assert.NoError(s.T(), beacon.RestoreState(time.Hour))
+	assert.Equal(s.T(), []string{"fakeNamespace/fakeRepoA", "fakeNamespace/fakeRepoB"}, beacon.ListProbes())
+	assert.NoError(s.T(), beacon.StopProbes(time.Second))
+}
+
+func (s *StateSuite) TestRestoreWithoutStateFile() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	beacon := s.newBeacon(mockController)
+
+	assert.NoError(s.T(), beacon.RestoreState(time.Hour))
+	assert.Empty(s.T(), beacon.ListProbes())
+}
+
+func (s *StateSuite) TestRestoreFromNewerVersionErrors() {
+	mockController := gomock.NewController(s.T())
+	defer mockController.Finish()
+
+	os.MkdirAll(filepath.Dir(s.StateFile), 0700)
+	os.WriteFile(s.StateFile, []byte(`{"version": 2, "probes": []}`), 0600)
+
+	err := s.newBeacon(mockController).RestoreState(time.Hour)
+
+	assert.ErrorContains(s.T(), err, "written by a newer version of beacond")
+}
@@ -0,0 +1,934 @@
+package server
+
+import (
+	"beacon/beacond/models"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"beacon/beacond/registry"
+	"beacon/beacond/secrets"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+// Codes returned in the error responses of the v1 API, which tooling can rely on staying the same
+const (
+	CodeInvalidRequest       ErrorCode = "invalid_request"
+	CodeProbeNotFound        ErrorCode = "probe_not_found"
+	CodeProbeExists          ErrorCode = "probe_exists"
+	CodeRepoNotFound         ErrorCode = "repo_not_found"
+	CodeRegistryError        ErrorCode = "registry_error"
+	CodeInsufficientCapacity ErrorCode = "insufficient_capacity"
+	CodeRouteConflict        ErrorCode = "route_conflict"
+	CodeUnknownSink          ErrorCode = "unknown_sink"
+	CodeInvalidDependency    ErrorCode = "invalid_dependency"
+	CodeInvalidHook          ErrorCode = "invalid_hook"
+	CodeInvalidVolume        ErrorCode = "invalid_volume"
+	CodeInvalidSecretRef     ErrorCode = "invalid_secret_ref"
+	CodeNotPendingApproval   ErrorCode = "not_pending_approval"
+	CodeSecretsDisabled      ErrorCode = "secrets_disabled"
+	CodeSecretNotFound       ErrorCode = "secret_not_found"
+	CodeSecretInUse          ErrorCode = "secret_in_use"
+	CodeInvalidSecret        ErrorCode = "invalid_secret"
+	CodeVolumeNotFound       ErrorCode = "volume_not_found"
+	CodeVolumeInUse          ErrorCode = "volume_in_use"
+	CodeInternal             ErrorCode = "internal_error"
+)
+
+type ErrorCode string
+
+// registerV1 adds the v1 API's routes, which identify resources by path and take JSON bodies
+func registerV1(e *echo.Echo) {
+	v1 := e.Group("/v1")
+
+	v1.GET("/beacon", getBeaconV1)
+
+	v1.GET("/probes", listProbesV1)
+	v1.POST("/probes", createProbeV1)
+	v1.GET("/probes/:namespace/:repo", getProbeV1)
+	v1.PATCH("/probes/:namespace/:repo", updateProbeV1)
+	v1.DELETE("/probes/:namespace/:repo", deleteProbeV1)
+	v1.POST("/probes/:namespace/:repo/approve", approveProbeV1)
+	v1.POST("/probes/:namespace/:repo/reject", rejectProbeV1)
+
+	v1.GET("/approvals", listApprovalsV1)
+
+	v1.GET("/secrets", listSecretsV1)
+	v1.GET("/secrets/:name", getSecretV1)
+	v1.PUT("/secrets/:name", setSecretV1)
+	v1.DELETE("/secrets/:name", deleteSecretV1)
+
+	v1.GET("/volumes", listVolumesV1)
+	v1.GET("/volumes/:name", getVolumeV1)
+	v1.DELETE("/volumes/:name", deleteVolumeV1)
+}
+
+// deprecated marks the responses of an unversioned route as deprecated, pointing at the v1 route replacing it
+func deprecated(successor string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Response().Header().Set("Deprecation", "true")
+			c.Response().Header().Set("Link", fmt.Sprintf("<%s>; rel=\"successor-version\"", successor))
+
+			return next(c)
+		}
+	}
+}
+
+// errorResponse responds with the status code and error code for an error returned by the beacon
+func errorResponse(c echo.Context, err error) error {
+	status, code := classifyError(err)
+
+	return c.JSON(status, models.ServerErrorResponse{Code: string(code), Message: err.Error()})
+}
+
+// classifyError returns the status code and error code for an error returned by the beacon, which are the same
+// whichever version of the API it is returned by
+func classifyError(err error) (int, ErrorCode) {
+	switch err.(type) {
+	case BeaconErrorProbeDoesNotExist:
+		return http.StatusNotFound, CodeProbeNotFound
+	case BeaconErrorProbeAlreadyExists:
+		return http.StatusConflict, CodeProbeExists
+	case BeaconErrorInsufficientCapacity:
+		return http.StatusUnprocessableEntity, CodeInsufficientCapacity
+	case BeaconErrorRouteConflict:
+		return http.StatusConflict, CodeRouteConflict
+	case BeaconErrorUnknownSink:
+		return http.StatusBadRequest, CodeUnknownSink
+	case BeaconErrorInvalidDependency:
+		return http.StatusBadRequest, CodeInvalidDependency
+	case BeaconErrorInvalidHook:
+		return http.StatusBadRequest, CodeInvalidHook
+	case BeaconErrorInvalidVolume:
+		return http.StatusBadRequest, CodeInvalidVolume
+	case BeaconErrorInvalidSecretRef:
+		return http.StatusBadRequest, CodeInvalidSecretRef
+	case BeaconErrorNotPendingApproval:
+		return http.StatusConflict, CodeNotPendingApproval
+	case BeaconErrorSecretsDisabled:
+		return http.StatusServiceUnavailable, CodeSecretsDisabled
+	case BeaconErrorSecretInUse:
+		return http.StatusConflict, CodeSecretInUse
+	case BeaconErrorInvalidSecret:
+		return http.StatusBadRequest, CodeInvalidSecret
+	case BeaconErrorVolumeDoesNotExist:
+		return http.StatusNotFound, CodeVolumeNotFound
+	case BeaconErrorVolumeInUse:
+		return http.StatusConflict, CodeVolumeInUse
+	case registry.NotFoundError:
+		return http.StatusNotFound, CodeRepoNotFound
+	case registry.GeneralClientError:
+		return http.StatusBadRequest, CodeRegistryError
+	case registry.GeneralServerError:
+		return http.StatusBadGateway, CodeRegistryError
+	}
+
+	if errors.Is(err, secrets.ErrNotFound) {
+		return http.StatusNotFound, CodeSecretNotFound
+	}
+
+	return http.StatusInternalServerError, CodeInternal
+}
+
+// invalidRequest responds to a request that can't be acted on as it was given
+func invalidRequest(c echo.Context, format string, args ...interface{}) error {
+	return c.JSON(http.StatusBadRequest, models.ServerErrorResponse{Code: string(CodeInvalidRequest), Message: fmt.Sprintf(format, args...)})
+}
+
+// getBeaconV1 handles the GET /v1/beacon method for beacond
+//
+//	@Summary		Get beacon details
+//	@Description	describes the current status of beacond
+//	@ID				getBeacon
+//	@Tags			v1
+//	@Produce		json
+//	@Success		200	{object}	BeaconDescribeResponse
+//	@Router			/v1/beacon [get]
+func getBeaconV1(c echo.Context) error {
+	return getBeaconDetails(c)
+}
+
+// listProbesV1 handles the GET /v1/probes method for beacond
+//
+//	@Summary		Lists probes
+//	@Description	lists the probes with the status filter, in the sort order and on the page asked for, summing up the state of each
+//	@ID				listProbes
+//	@Tags			v1
+//	@Produce		json
+//	@Param			status	query		[]string	false	"only list probes with this status, such as exited. Can be repeated"	collectionFormat(multi)
+//	@Param			sort	query		string		false	"what to sort by: name (the default), status, last_checked, last_updated or restarts, prefixed with - to reverse the order"
+//	@Param			limit	query		integer		false	"the most probes to list (all of them if left out)"
+//	@Param			offset	query		integer		false	"the number of probes to skip"
+//	@Success		200		{object}	ProbeListResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Router			/v1/probes [get]
+func listProbesV1(c echo.Context) error {
+	query, err := probeQueryFromQuery(c)
+
+	if err != nil {
+		return invalidRequest(c, "%s", err)
+	}
+
+	summaries, total := Beacon.QueryProbes(query)
+	r := models.ServerProbeListResponse{
+		Probes: []*models.ServerProbeSummaryResponse{},
+		Total:  int64(total),
+		Offset: int64(query.Offset),
+		Limit:  int64(query.Limit),
+	}
+
+	for _, summary := range summaries {
+		r.Probes = append(r.Probes, probeSummaryResponse(summary))
+	}
+
+	return c.JSON(http.StatusOK, r)
+}
+
+// getProbeV1 handles the GET /v1/probes/{namespace}/{repo} method for beacond
+//
+//	@Summary		Describe a probe
+//	@Description	describes the probe: how it was created, its state, its containers as the runtime sees them, its events and how the latest deploy went
+//	@ID				getProbe
+//	@Tags			v1
+//	@Produce		json
+//	@Param			namespace	path		string	true	"the namespace of the probe's repo"
+//	@Param			repo		path		string	true	"the name of the probe's repo"
+//	@Success		200			{object}	ProbeResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/v1/probes/{namespace}/{repo} [get]
+func getProbeV1(c echo.Context) error {
+	details, err := Beacon.DescribeProbe(c.Param("namespace"), c.Param("repo"))
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, probeResponse(details))
+}
+
+// listApprovalsV1 handles the GET /v1/approvals method for beacond
+//
+//	@Summary		Lists digests waiting to be approved
+//	@Description	lists the probes waiting for a new digest to be approved, with the digest, tag and when it was pushed
+//	@ID				listApprovals
+//	@Tags			v1
+//	@Produce		json
+//	@Success		200	{object}	ListApprovalsResponse
+//	@Router			/v1/approvals [get]
+func listApprovalsV1(c echo.Context) error {
+	return listApprovals(c)
+}
+
+// createProbeV1 handles the POST /v1/probes method for beacond
+//
+//	@Summary		Create a probe
+//	@Description	creates a probe for the namespace and repo in the body, which starts checking the repo for new digests to deploy
+//	@ID				createProbe
+//	@Tags			v1
+//	@Accept			json
+//	@Produce		json
+//	@Param			probe	body		CreateProbeRequest	true	"the probe to create"
+//	@Success		201		{object}	BaseResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		409		{object}	ErrorResponse
+//	@Failure		422		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Failure		502		{object}	ErrorResponse
+//	@Failure		503		{object}	ErrorResponse
+//	@Router			/v1/probes [post]
+func createProbeV1(c echo.Context) error {
+	var body models.ServerCreateProbeRequest
+
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+		return invalidRequest(c, "expect the body to be a JSON object describing the probe: %s", err)
+	}
+
+	if body.Namespace == nil || *body.Namespace == "" || body.Repo == nil || *body.Repo == "" {
+		return invalidRequest(c, "expect namespace and repo to be provided")
+	}
+
+	namespace, repo := *body.Namespace, *body.Repo
+	options, err := probeOptionsFromRequest(body)
+
+	if err != nil {
+		return invalidRequest(c, "%s", err)
+	}
+
+	if err := Beacon.Registry().TestRepo(c.Request().Context(), namespace, repo); err != nil {
+		return errorResponse(c, err)
+	}
+
+	if err := Beacon.StartProbe(namespace, repo, options, time.Second*20); err != nil {
+		return errorResponse(c, err)
+	}
+
+	c.Response().Header().Set("Location", fmt.Sprintf("/v1/probes/%s/%s", namespace, repo))
+
+	return c.JSON(http.StatusCreated, models.ServerBaseResponse{Message: fmt.Sprintf("Probe successfully created for repo %s at namespace %s", repo, namespace)})
+}
+
+// updateProbeV1 handles the PATCH /v1/probes/{namespace}/{repo} method for beacond
+//
+//	@Summary		Update a probe
+//	@Description	changes the number of containers run for the probe, without redeploying the ones that are kept
+//	@ID				updateProbe
+//	@Tags			v1
+//	@Accept			json
+//	@Produce		json
+//	@Param			namespace	path		string				true	"the namespace of the probe's repo"
+//	@Param			repo		path		string				true	"the name of the probe's repo"
+//	@Param			update		body		UpdateProbeRequest	true	"the changes to make to the probe"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		422			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/v1/probes/{namespace}/{repo} [patch]
+func updateProbeV1(c echo.Context) error {
+	var body models.ServerUpdateProbeRequest
+
+	namespace, repo := c.Param("namespace"), c.Param("repo")
+
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil {
+		return invalidRequest(c, "expect the body to be a JSON object with the changes to make: %s", err)
+	}
+
+	if body.Replicas == nil {
+		return invalidRequest(c, "expect replicas to be provided")
+	}
+
+	if *body.Replicas < 1 {
+		return invalidRequest(c, "replicas must be a positive integer, got %d", *body.Replicas)
+	}
+
+	if err := Beacon.ScaleProbe(namespace, repo, int(*body.Replicas)); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Probe for repo %s at namespace %s scaled to %d replicas", repo, namespace, *body.Replicas)})
+}
+
+// deleteProbeV1 handles the DELETE /v1/probes/{namespace}/{repo} method for beacond
+//
+//	@Summary		Delete a probe
+//	@Description	deletes the probe, keeping its containers and volumes unless purge is set
+//	@ID				deleteProbe
+//	@Tags			v1
+//	@Produce		json
+//	@Param			namespace	path		string	true	"the namespace of the probe's repo"
+//	@Param			repo		path		string	true	"the name of the probe's repo"
+//	@Param			purge		query		boolean	false	"whether to also remove the probe's containers and volumes"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/v1/probes/{namespace}/{repo} [delete]
+func deleteProbeV1(c echo.Context) error {
+	namespace, repo := c.Param("namespace"), c.Param("repo")
+
+	purge, err := boolFromQuery(c, "purge")
+
+	if err != nil {
+		return invalidRequest(c, "%s", err)
+	}
+
+	if err := Beacon.StopProbe(namespace, repo, time.Second*20); err != nil {
+		return errorResponse(c, err)
+	}
+
+	if purge {
+		if err := Beacon.PurgeProbe(namespace, repo); err != nil {
+			return errorResponse(c, fmt.Errorf("probe deleted, but its containers and volumes could not all be removed: %s", err))
+		}
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Probe successfully deleted for repo %s at namespace %s", repo, namespace)})
+}
+
+// approveProbeV1 handles the POST /v1/probes/{namespace}/{repo}/approve method for beacond
+//
+//	@Summary		Approve a digest
+//	@Description	deploys the digest waiting to be approved for the probe
+//	@ID				approveProbe
+//	@Tags			v1
+//	@Accept			json
+//	@Produce		json
+//	@Param			namespace	path		string			true	"the namespace of the probe's repo"
+//	@Param			repo		path		string			true	"the name of the probe's repo"
+//	@Param			decision	body		DecisionRequest	false	"the digest expected to be waiting, which is refused if another one is"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		409			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/v1/probes/{namespace}/{repo}/approve [post]
+func approveProbeV1(c echo.Context) error {
+	return decideProbeV1(c, "approve", Beacon.ApproveProbe)
+}
+
+// rejectProbeV1 handles the POST /v1/probes/{namespace}/{repo}/reject method for beacond
+//
+//	@Summary		Reject a digest
+//	@Description	skips the digest waiting to be approved for the probe, which keeps running its current digest
+//	@ID				rejectProbe
+//	@Tags			v1
+//	@Accept			json
+//	@Produce		json
+//	@Param			namespace	path		string			true	"the namespace of the probe's repo"
+//	@Param			repo		path		string			true	"the name of the probe's repo"
+//	@Param			decision	body		DecisionRequest	false	"the digest expected to be waiting, which is refused if another one is"
+//	@Success		200			{object}	BaseResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		409			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/v1/probes/{namespace}/{repo}/reject [post]
+func rejectProbeV1(c echo.Context) error {
+	return decideProbeV1(c, "reject", Beacon.RejectProbe)
+}
+
+// decideProbeV1 approves or rejects the digest waiting to be approved for the probe in the path. The body is optional
+func decideProbeV1(c echo.Context, action string, decide func(string, string, string) (string, error)) error {
+	var body models.ServerDecisionRequest
+
+	namespace, repo := c.Param("namespace"), c.Param("repo")
+
+	// The body is optional. Its length isn't known up front if it is sent chunked, so an empty body is only found by
+	// reading it
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		return invalidRequest(c, "expect the body to be a JSON object with the digest to %s: %s", action, err)
+	}
+
+	digest, err := decide(namespace, repo, body.Digest)
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Digest %s %sd for repo %s at namespace %s", digest, action, repo, namespace)})
+}
+
+// listSecretsV1 handles the GET /v1/secrets method for beacond
+//
+//	@Summary		Lists all secrets
+//	@Description	lists the names of the secrets in the beacon's secret store
+//	@ID				listSecrets
+//	@Tags			v1
+//	@Produce		json
+//	@Success		200	{object}	ListSecretsResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Failure		503	{object}	ErrorResponse
+//	@Router			/v1/secrets [get]
+func listSecretsV1(c echo.Context) error {
+	names, err := Beacon.ListSecrets()
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerListSecretsResponse{Secrets: names})
+}
+
+// getSecretV1 handles the GET /v1/secrets/{name} method for beacond
+//
+//	@Summary		Describe a secret
+//	@Description	describes the secret. Its value is never returned
+//	@ID				getSecret
+//	@Tags			v1
+//	@Produce		json
+//	@Param			name	path		string	true	"the name of the secret"
+//	@Success		200		{object}	SecretResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Failure		503		{object}	ErrorResponse
+//	@Router			/v1/secrets/{name} [get]
+func getSecretV1(c echo.Context) error {
+	secret, err := Beacon.GetSecret(c.Param("name"))
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerSecretResponse{
+		Name:      secret.Name,
+		UpdatedAt: secret.UpdatedAt.Format(time.RFC3339),
+		UsedBy:    secret.UsedBy,
+	})
+}
+
+// setSecretV1 handles the PUT /v1/secrets/{name} method for beacond
+//
+//	@Summary		Set a secret
+//	@Description	creates or replaces the secret. The value is sent in the body, so that it doesn't end up in access logs
+//	@ID				setSecret
+//	@Tags			v1
+//	@Accept			json
+//	@Produce		json
+//	@Param			name	path		string				true	"the name of the secret"
+//	@Param			secret	body		SetSecretRequest	true	"the value of the secret"
+//	@Success		200		{object}	BaseResponse
+//	@Success		201		{object}	BaseResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Failure		503		{object}	ErrorResponse
+//	@Router			/v1/secrets/{name} [put]
+func setSecretV1(c echo.Context) error {
+	var body models.ServerSetSecretRequest
+
+	name := c.Param("name")
+
+	if err := secrets.ValidateName(name); err != nil {
+		return invalidRequest(c, "%s", err)
+	}
+
+	// The body isn't included in the error, as it holds the secret
+	if err := json.NewDecoder(c.Request().Body).Decode(&body); err != nil || body.Value == nil {
+		return invalidRequest(c, "expect the body to be a JSON object with the secret's value")
+	}
+
+	_, err := Beacon.GetSecret(name)
+	created := errors.Is(err, secrets.ErrNotFound)
+
+	if err != nil && !created {
+		return errorResponse(c, err)
+	}
+
+	if err := Beacon.SetSecret(name, []byte(*body.Value)); err != nil {
+		return errorResponse(c, err)
+	}
+
+	if created {
+		return c.JSON(http.StatusCreated, models.ServerBaseResponse{Message: fmt.Sprintf("Secret %s successfully created", name)})
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Secret %s successfully replaced", name)})
+}
+
+// deleteSecretV1 handles the DELETE /v1/secrets/{name} method for beacond
+//
+//	@Summary		Delete a secret
+//	@Description	deletes the secret, unless a probe still references it
+//	@ID				deleteSecret
+//	@Tags			v1
+//	@Produce		json
+//	@Param			name	path		string	true	"the name of the secret"
+//	@Success		200		{object}	BaseResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		409		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Failure		503		{object}	ErrorResponse
+//	@Router			/v1/secrets/{name} [delete]
+func deleteSecretV1(c echo.Context) error {
+	name := c.Param("name")
+
+	if err := Beacon.DeleteSecret(name); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Secret %s successfully deleted", name)})
+}
+
+// listVolumesV1 handles the GET /v1/volumes method for beacond
+//
+//	@Summary		Lists all volumes
+//	@Description	lists the volumes created for the beacon's probes, including those of probes that have been deleted
+//	@ID				listVolumes
+//	@Tags			v1
+//	@Produce		json
+//	@Success		200	{object}	ListVolumesResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Router			/v1/volumes [get]
+func listVolumesV1(c echo.Context) error {
+	volumes, err := Beacon.ListVolumes()
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, listVolumesResponse(volumes))
+}
+
+// getVolumeV1 handles the GET /v1/volumes/{name} method for beacond
+//
+//	@Summary		Describe a volume
+//	@Description	describes the volume, with its size and the probe that owns it
+//	@ID				getVolume
+//	@Tags			v1
+//	@Produce		json
+//	@Param			name	path		string	true	"the name of the volume"
+//	@Success		200		{object}	VolumeResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/v1/volumes/{name} [get]
+func getVolumeV1(c echo.Context) error {
+	volume, err := Beacon.GetVolume(c.Param("name"))
+
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, volumeResponse(volume))
+}
+
+// deleteVolumeV1 handles the DELETE /v1/volumes/{name} method for beacond
+//
+//	@Summary		Delete a volume
+//	@Description	deletes the volume, unless the probe that owns it still exists
+//	@ID				deleteVolume
+//	@Tags			v1
+//	@Produce		json
+//	@Param			name	path		string	true	"the name of the volume"
+//	@Success		200		{object}	BaseResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		409		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/v1/volumes/{name} [delete]
+func deleteVolumeV1(c echo.Context) error {
+	name := c.Param("name")
+
+	if err := Beacon.RemoveVolume(name); err != nil {
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, models.ServerBaseResponse{Message: fmt.Sprintf("Volume %s successfully deleted", name)})
+}
+
+// probeOptionsFromRequest checks the body of a request to create a probe, turning it into the probe's options. It is
+// checked in the same way as the query parameters of POST /probe
+func probeOptionsFromRequest(body models.ServerCreateProbeRequest) (ProbeOptions, error) {
+	options := ProbeOptions{
+		Replicas:                1,
+		Env:                     body.Env,
+		Notify:                  body.Notify,
+		RequireApproval:         body.RequireApproval,
+		DependsOn:               body.DependsOn,
+		RestartWithDependencies: body.RestartWithDependencies,
+		NoNetwork:               body.NoNetwork,
+		Networks:                body.Networks,
+	}
+
+	if body.Replicas != 0 {
+		if body.Replicas < 1 {
+			return options, fmt.Errorf("replicas must be a positive integer, got %d", body.Replicas)
+		}
+
+		options.Replicas = int(body.Replicas)
+	}
+
+	if resources := body.Resources; resources != nil {
+		if resources.CPUShares < 0 || resources.Cpus < 0 || resources.PidsLimit < 0 {
+			return options, fmt.Errorf("cpu_shares, cpus and pids_limit must be positive")
+		}
+
+		options.Resources = oci.Resources{
+			CPUShares: resources.CPUShares,
+			CPUQuota:  int64(resources.Cpus * oci.CPUPeriod),
+			PidsLimit: resources.PidsLimit,
+		}
+
+		if resources.Memory != "" {
+			memory, err := parseBytes(resources.Memory)
+
+			if err != nil {
+				return options, err
+			}
+
+			options.Resources.Memory = memory
+		}
+	}
+
+	if body.Route != nil {
+		route := proxy.Route{
+			Host:       body.Route.Host,
+			PathPrefix: body.Route.PathPrefix,
+			Port:       int(body.Route.Port),
+			HealthPath: body.Route.HealthPath,
+		}
+
+		if err := checkRoute(route); err != nil {
+			return options, err
+		}
+
+		options.Route = &route
+	}
+
+	for name := range body.Env {
+		if name == "" {
+			return options, fmt.Errorf("env can't set a variable without a name")
+		}
+	}
+
+	for _, secret := range body.Secrets {
+		options.Secrets = append(options.Secrets, SecretRef{Name: secret.Name, Env: secret.Env, File: secret.File})
+	}
+
+	if body.Soak != "" {
+		soak, err := time.ParseDuration(body.Soak)
+
+		if err != nil || soak < 0 {
+			return options, fmt.Errorf("soak must be a positive duration such as 10m, got %q", body.Soak)
+		}
+
+		options.Soak = soak
+	}
+
+	for _, volume := range body.Volumes {
+		options.Volumes = append(options.Volumes, VolumeRef{Name: volume.Name, Target: volume.Target})
+	}
+
+	for _, h := range body.Hooks {
+		hook := Hook{Name: h.Name, Phase: HookPhase(h.Phase), Command: h.Command, Env: h.Env}
+
+		for _, volume := range h.Volumes {
+			hook.Volumes = append(hook.Volumes, VolumeRef{Name: volume.Name, Target: volume.Target})
+		}
+
+		if h.Timeout != "" {
+			timeout, err := time.ParseDuration(h.Timeout)
+
+			if err != nil || timeout <= 0 {
+				return options, fmt.Errorf("hook %s: timeout must be a positive duration such as 10m, got %q", h.Name, h.Timeout)
+			}
+
+			hook.Timeout = timeout
+		}
+
+		options.Hooks = append(options.Hooks, hook)
+	}
+
+	return options, nil
+}
+
+// probeQueryFromQuery reads which probes to list, and in what order, from the URL query parameters
+func probeQueryFromQuery(c echo.Context) (ProbeQuery, error) {
+	query := ProbeQuery{SortBy: SortByName}
+
+	for _, status := range c.QueryParams()["status"] {
+		if !containsStatus(ProbeStatuses, ProbeStatus(status)) {
+			return query, fmt.Errorf("status must be one of %v, got %q", ProbeStatuses, status)
+		}
+
+		query.Statuses = append(query.Statuses, ProbeStatus(status))
+	}
+
+	if v := c.QueryParam("sort"); v != "" {
+		query.Descending = strings.HasPrefix(v, "-")
+		query.SortBy = ProbeSortKey(strings.TrimPrefix(v, "-"))
+
+		if !ValidSortKey(query.SortBy) {
+			return query, fmt.Errorf("sort must be one of name, status, last_checked, last_updated or restarts, optionally prefixed with -, got %q", v)
+		}
+	}
+
+	for name, value := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+		v := c.QueryParam(name)
+
+		if v == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 0 {
+			return query, fmt.Errorf("%s must be a non-negative integer, got %q", name, v)
+		}
+
+		*value = n
+	}
+
+	return query, nil
+}
+
+func probeSummaryResponse(summary ProbeSummary) *models.ServerProbeSummaryResponse {
+	return &models.ServerProbeSummaryResponse{
+		Probe:         summary.Namespace + "/" + summary.Repo,
+		Namespace:     summary.Namespace,
+		Repo:          summary.Repo,
+		Status:        string(summary.Status),
+		CurrentDigest: summary.CurrentDigest,
+		LatestDigest:  summary.LatestDigest,
+		Replicas:      int64(summary.Replicas),
+		Running:       int64(summary.Running),
+		Restarts:      int64(summary.Restarts),
+		LastChecked:   timestamp(summary.LastChecked),
+		LastUpdated:   timestamp(summary.LastUpdated),
+		LastError:     eventResponse(summary.LastError),
+	}
+}
+
+func probeResponse(details ProbeDetails) *models.ServerProbeResponse {
+	state := details.State
+	summary := probeSummaryResponse(summarise(details.Namespace, details.Repo, state))
+	r := &models.ServerProbeResponse{
+		Probe:          summary.Probe,
+		Namespace:      summary.Namespace,
+		Repo:           summary.Repo,
+		Status:         summary.Status,
+		CurrentDigest:  summary.CurrentDigest,
+		LatestDigest:   summary.LatestDigest,
+		Replicas:       summary.Replicas,
+		Running:        summary.Running,
+		Restarts:       summary.Restarts,
+		LastChecked:    summary.LastChecked,
+		LastUpdated:    summary.LastUpdated,
+		LastError:      summary.LastError,
+		Containers:     []*models.ServerContainerResponse{},
+		Events:         []*models.ServerEventResponse{},
+		LastDeploy:     deployResponse(state.LastDeploy),
+		Soaking:        imageResponse(state.Soaking),
+		SoakingSince:   timestamp(state.SoakingSince),
+		Candidate:      imageResponse(state.Candidate),
+		RejectedDigest: state.RejectedDigest,
+		Spec:           probeSpecResponse(details.Namespace, details.Repo, details.Options),
+	}
+
+	for _, container := range details.Containers {
+		r.Containers = append(r.Containers, &models.ServerContainerResponse{
+			Replica:     int64(container.Index),
+			ContainerID: container.ContainerID,
+			Digest:      container.Digest,
+			Address:     container.Address,
+			Status:      container.Status,
+			Health:      container.Health,
+			ExitCode:    int64(container.ExitCode),
+			StartedAt:   timestamp(container.StartedAt),
+			Error:       container.Error,
+		})
+	}
+
+	for i := range state.Events {
+		r.Events = append(r.Events, eventResponse(&state.Events[i]))
+	}
+
+	return r
+}
+
+// probeSpecResponse describes how a probe was created, in the form of a request that would create it again
+func probeSpecResponse(namespace string, repo string, options ProbeOptions) *models.ServerCreateProbeRequest {
+	spec := &models.ServerCreateProbeRequest{
+		Namespace:               &namespace,
+		Repo:                    &repo,
+		Replicas:                int64(options.Replicas),
+		Env:                     options.Env,
+		Notify:                  options.Notify,
+		RequireApproval:         options.RequireApproval,
+		DependsOn:               options.DependsOn,
+		RestartWithDependencies: options.RestartWithDependencies,
+		NoNetwork:               options.NoNetwork,
+		Networks:                options.Networks,
+	}
+
+	if options.Resources != (oci.Resources{}) {
+		spec.Resources = &models.ServerResourcesRequest{
+			CPUShares: options.Resources.CPUShares,
+			Cpus:      options.Resources.CPUs(),
+			PidsLimit: options.Resources.PidsLimit,
+		}
+
+		if options.Resources.Memory != 0 {
+			spec.Resources.Memory = strconv.FormatInt(options.Resources.Memory, 10)
+		}
+	}
+
+	if route := options.Route; route != nil {
+		spec.Route = &models.ServerRouteRequest{Host: route.Host, PathPrefix: route.PathPrefix, Port: int64(route.Port), HealthPath: route.HealthPath}
+	}
+
+	for _, secret := range options.Secrets {
+		spec.Secrets = append(spec.Secrets, &models.ServerSecretRefRequest{Name: secret.Name, Env: secret.Env, File: secret.File})
+	}
+
+	if options.Soak != 0 {
+		spec.Soak = options.Soak.String()
+	}
+
+	for _, volume := range options.Volumes {
+		spec.Volumes = append(spec.Volumes, &models.ServerVolumeRefRequest{Name: volume.Name, Target: volume.Target})
+	}
+
+	for _, hook := range options.Hooks {
+		h := &models.ServerHookRequest{Name: hook.Name, Phase: string(hook.Phase), Command: hook.Command, Env: hook.Env}
+
+		for _, volume := range hook.Volumes {
+			h.Volumes = append(h.Volumes, &models.ServerVolumeRefRequest{Name: volume.Name, Target: volume.Target})
+		}
+
+		if hook.Timeout != 0 {
+			h.Timeout = hook.Timeout.String()
+		}
+
+		spec.Hooks = append(spec.Hooks, h)
+	}
+
+	return spec
+}
+
+func eventResponse(event *Event) *models.ServerEventResponse {
+	if event == nil {
+		return nil
+	}
+
+	return &models.ServerEventResponse{
+		Reason:    string(event.Reason),
+		Message:   event.Message,
+		Count:     int64(event.Count),
+		FirstSeen: timestamp(event.FirstSeen),
+		LastSeen:  timestamp(event.LastSeen),
+	}
+}
+
+func deployResponse(deploy *DeployResult) *models.ServerDeployResponse {
+	if deploy == nil {
+		return nil
+	}
+
+	r := &models.ServerDeployResponse{
+		Digest:     deploy.Digest,
+		StartedAt:  timestamp(deploy.StartedAt),
+		FinishedAt: timestamp(deploy.FinishedAt),
+		Succeeded:  deploy.Succeeded,
+		Error:      deploy.Error,
+		Hooks:      []*models.ServerHookResultResponse{},
+	}
+
+	for _, hook := range deploy.Hooks {
+		r.Hooks = append(r.Hooks, &models.ServerHookResultResponse{
+			Name:       hook.Name,
+			Phase:      string(hook.Phase),
+			ExitCode:   int64(hook.ExitCode),
+			Output:     hook.Output,
+			Error:      hook.Error,
+			StartedAt:  timestamp(hook.StartedAt),
+			FinishedAt: timestamp(hook.FinishedAt),
+		})
+	}
+
+	return r
+}
+
+func imageResponse(image *registry.ImageDetails) *models.ServerImageResponse {
+	if image == nil {
+		return nil
+	}
+
+	return &models.ServerImageResponse{Digest: image.Digest, Tag: image.Tag, PushedAt: timestamp(image.Pushed)}
+}
+
+// timestamp formats a time for the API, leaving it empty if it isn't known
+func timestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,343 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/models"
+	"beacon/beacond/oci"
+	"beacon/beacond/proxy"
+	"beacon/beacond/registry"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type V1Suite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestV1Suite(t *testing.T) {
+	suite.Run(t, new(V1Suite))
+}
+
+func (v *V1Suite) SetupTest() {
+	v.LogBuff = new(bytes.Buffer)
+	log.SetOutput(v.LogBuff)
+}
+
+// serve sends a request to the API, returning the response
+func (v *V1Suite) serve(method string, target string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	newAPI().ServeHTTP(rec, req)
+
+	return rec
+}
+
+// errorCode reads the error code from an error response
+func (v *V1Suite) errorCode(rec *httptest.ResponseRecorder) string {
+	var r models.ServerErrorResponse
+
+	assert.NoError(v.T(), json.Unmarshal(rec.Body.Bytes(), &r))
+	assert.NotEmpty(v.T(), r.Message)
+
+	return r.Code
+}
+
+// beacon sets the beacon the API acts on to one whose probes never find a digest, so that nothing is deployed
+func (v *V1Suite) beacon(mockController *gomock.Controller) *registry.MockRegistry {
+	registryClient := anonymousRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	Beacon = newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{})
+
+	return registryClient
+}
+
+func (v *V1Suite) TestCreateProbe() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	registryClient := v.beacon(mockController)
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "app").Return(nil).Times(2)
+
+	rec := v.serve(http.MethodPost, "/v1/probes", `{
+		"namespace": "fakeNamespace",
+		"repo": "app",
+		"replicas": 2,
+		"env": {"LOG_LEVEL": "debug"},
+		"route": {"host": "app.example.com", "port": 8080},
+		"volumes": [{"name": "data", "target": "/data"}],
+		"hooks": [{"name": "migrate", "phase": "pre_deploy", "command": ["./migrate"], "timeout": "5m"}],
+		"soak": "10m"
+	}`)
+
+	assert.Equal(v.T(), http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Equal(v.T(), "/v1/probes/fakeNamespace/app", rec.Header().Get("Location"))
+
+	probe, ok := Beacon.GetProbe("fakeNamespace", "app")
+
+	assert.True(v.T(), ok)
+	assert.Equal(v.T(), 2, probe.Replicas)
+	assert.Equal(v.T(), map[string]string{"LOG_LEVEL": "debug"}, probe.Env)
+	assert.Equal(v.T(), &proxy.Route{Host: "app.example.com", Port: 8080}, probe.Route)
+	assert.Equal(v.T(), []VolumeRef{{Name: "data", Target: "/data"}}, probe.Volumes)
+	assert.Equal(v.T(), []Hook{{Name: "migrate", Phase: PreDeploy, Command: []string{"./migrate"}, Timeout: 5 * time.Minute}}, probe.Hooks)
+	assert.Equal(v.T(), 10*time.Minute, probe.Soak)
+
+	rec = v.serve(http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "app"}`)
+
+	assert.Equal(v.T(), http.StatusConflict, rec.Code)
+	assert.Equal(v.T(), "probe_exists", v.errorCode(rec))
+	assert.NoError(v.T(), Beacon.StopProbes(time.Second))
+}
+
+func (v *V1Suite) TestCreateProbeBeyondCapacity() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	registryClient := anonymousRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", gomock.Any()).Return(nil).AnyTimes()
+
+	Beacon = newBeacon(oci.NewMockOCIRuntime(mockController), registryClient, nil, Config{}, host.Capacity{CPUs: 2, Memory: 1 << 30})
+
+	rec := v.serve(http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "big", "replicas": 3, "resources": {"memory": "512m"}}`)
+
+	assert.Equal(v.T(), http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	assert.Equal(v.T(), "insufficient_capacity", v.errorCode(rec))
+
+	rec = v.serve(http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "greedy", "resources": {"cpus": 4}}`)
+
+	assert.Equal(v.T(), http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	assert.Equal(v.T(), "insufficient_capacity", v.errorCode(rec))
+
+	// What is left after a probe that fits is what the next one is checked against
+	rec = v.serve(http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "small", "replicas": 2, "resources": {"memory": "256m"}}`)
+
+	assert.Equal(v.T(), http.StatusCreated, rec.Code, rec.Body.String())
+
+	rec = v.serve(http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "medium", "resources": {"memory": "768m"}}`)
+
+	assert.Equal(v.T(), http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	assert.Equal(v.T(), []string{"fakeNamespace/small"}, Beacon.ListProbes())
+	assert.NoError(v.T(), Beacon.StopProbes(time.Second))
+}
+
+func (v *V1Suite) TestInvalidRequests() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	v.beacon(mockController)
+
+	for _, body := range []string{
+		`not json`,
+		`{"repo": "app"}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "replicas": -1}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "route": {"port": 70000}}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "route": {"port": 80, "path_prefix": "api"}}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "resources": {"memory": "lots"}}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "soak": "soon"}`,
+		`{"namespace": "fakeNamespace", "repo": "app", "hooks": [{"name": "migrate", "timeout": "0s"}]}`,
+	} {
+		rec := v.serve(http.MethodPost, "/v1/probes", body)
+
+		assert.Equal(v.T(), http.StatusBadRequest, rec.Code, body)
+		assert.Equal(v.T(), "invalid_request", v.errorCode(rec), body)
+	}
+
+	rec := v.serve(http.MethodPatch, "/v1/probes/fakeNamespace/app", `{}`)
+
+	assert.Equal(v.T(), http.StatusBadRequest, rec.Code)
+	assert.Equal(v.T(), "invalid_request", v.errorCode(rec))
+
+	rec = v.serve(http.MethodDelete, "/v1/probes/fakeNamespace/app?purge=maybe", "")
+
+	assert.Equal(v.T(), http.StatusBadRequest, rec.Code)
+	assert.Equal(v.T(), "invalid_request", v.errorCode(rec))
+}
+
+func (v *V1Suite) TestErrorCodes() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	registryClient := v.beacon(mockController)
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "missing").Return(registry.NotFoundError{Err: fmt.Errorf("fake error")})
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "down").Return(registry.GeneralServerError{Err: fmt.Errorf("fake error")})
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "app").Return(nil)
+
+	tests := []struct {
+		method string
+		target string
+		body   string
+		status int
+		code   string
+	}{
+		{http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "missing"}`, http.StatusNotFound, "repo_not_found"},
+		{http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "down"}`, http.StatusBadGateway, "registry_error"},
+		{http.MethodPost, "/v1/probes", `{"namespace": "fakeNamespace", "repo": "app", "secrets": [{"name": "db-password", "env": "DB_PASSWORD"}]}`, http.StatusServiceUnavailable, "secrets_disabled"},
+		{http.MethodDelete, "/v1/probes/fakeNamespace/app", "", http.StatusNotFound, "probe_not_found"},
+		{http.MethodPatch, "/v1/probes/fakeNamespace/app", `{"replicas": 2}`, http.StatusNotFound, "probe_not_found"},
+		{http.MethodPost, "/v1/probes/fakeNamespace/app/approve", "", http.StatusNotFound, "probe_not_found"},
+		{http.MethodPost, "/v1/probes/fakeNamespace/app/reject", `{"digest": "fakeDigest"}`, http.StatusNotFound, "probe_not_found"},
+		{http.MethodGet, "/v1/secrets", "", http.StatusServiceUnavailable, "secrets_disabled"},
+		{http.MethodPut, "/v1/secrets/db-password", `{"value": "hunter2"}`, http.StatusServiceUnavailable, "secrets_disabled"},
+	}
+
+	for _, test := range tests {
+		rec := v.serve(test.method, test.target, test.body)
+
+		assert.Equal(v.T(), test.status, rec.Code, "%s %s", test.method, test.target)
+		assert.Equal(v.T(), test.code, v.errorCode(rec), "%s %s", test.method, test.target)
+	}
+}
+
+func (v *V1Suite) TestDecisionBodyIsOptional() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	v.beacon(mockController)
+
+	// Bodies sent chunked have no length, whether or not they are empty
+	for _, test := range []struct {
+		body   string
+		status int
+		code   string
+	}{
+		{"", http.StatusNotFound, "probe_not_found"},
+		{`{"digest": "fakeDigest"}`, http.StatusNotFound, "probe_not_found"},
+		{`{"digest":`, http.StatusBadRequest, "invalid_request"},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/v1/probes/fakeNamespace/app/approve", strings.NewReader(test.body))
+		req.ContentLength = -1
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		newAPI().ServeHTTP(rec, req)
+
+		assert.Equal(v.T(), test.status, rec.Code, test.body)
+		assert.Equal(v.T(), test.code, v.errorCode(rec), test.body)
+	}
+}
+
+func (v *V1Suite) TestLegacyErrorResponses() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	registryClient := v.beacon(mockController)
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "missing").Return(registry.NotFoundError{Err: fmt.Errorf("fake error")})
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "down").Return(registry.GeneralServerError{Err: fmt.Errorf("fake error")})
+	registryClient.EXPECT().TestRepo(gomock.Any(), "fakeNamespace", "app").Return(nil)
+
+	// The unversioned routes respond with the same status codes as the v1 API, keeping their own messages
+	tests := []struct {
+		method  string
+		target  string
+		status  int
+		message string
+	}{
+		{http.MethodPost, "/probe?namespace=fakeNamespace&repo=missing", http.StatusNotFound, "Could not fetch repo missing in namespace fakeNamespace"},
+		{http.MethodPost, "/probe?namespace=fakeNamespace&repo=down", http.StatusBadGateway, "Could not fetch repo down in namespace fakeNamespace"},
+		{http.MethodPost, "/probe?namespace=fakeNamespace&repo=app&secret_env=DB_PASSWORD=db-password", http.StatusServiceUnavailable, "Secrets are not enabled on this beacon"},
+		{http.MethodDelete, "/probe?namespace=fakeNamespace&repo=app", http.StatusNotFound, "Probe not found for repo app at namespace fakeNamespace"},
+		{http.MethodPatch, "/probe?namespace=fakeNamespace&repo=app&replicas=2", http.StatusNotFound, "Probe not found for repo app at namespace fakeNamespace"},
+		{http.MethodPost, "/probe/approve?namespace=fakeNamespace&repo=app", http.StatusNotFound, "Probe not found for repo app at namespace fakeNamespace"},
+		{http.MethodGet, "/secrets", http.StatusServiceUnavailable, "Secrets are not enabled on this beacon"},
+	}
+
+	for _, test := range tests {
+		rec := v.serve(test.method, test.target, "")
+
+		var r models.ServerBaseResponse
+
+		assert.NoError(v.T(), json.Unmarshal(rec.Body.Bytes(), &r))
+		assert.Equal(v.T(), test.status, rec.Code, "%s %s", test.method, test.target)
+		assert.Equal(v.T(), test.message, r.Message, "%s %s", test.method, test.target)
+		assert.NotEmpty(v.T(), r.Error, "%s %s", test.method, test.target)
+	}
+}
+
+func (v *V1Suite) TestListAndGetProbes() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	v.beacon(mockController)
+	assert.NoError(v.T(), Beacon.StartProbe("fakeNamespace", "appA", ProbeOptions{Replicas: 2, Soak: time.Minute}, time.Hour))
+	assert.NoError(v.T(), Beacon.StartProbe("fakeNamespace", "appB", ProbeOptions{}, time.Hour))
+
+	rec := v.serve(http.MethodGet, "/v1/probes?sort=-name&limit=1", "")
+
+	var page models.ServerProbeListResponse
+
+	assert.Equal(v.T(), http.StatusOK, rec.Code, rec.Body.String())
+	assert.NoError(v.T(), json.Unmarshal(rec.Body.Bytes(), &page))
+	assert.Equal(v.T(), int64(2), page.Total)
+	assert.Equal(v.T(), int64(1), page.Limit)
+	assert.Len(v.T(), page.Probes, 1)
+	assert.Equal(v.T(), "fakeNamespace/appB", page.Probes[0].Probe)
+
+	rec = v.serve(http.MethodGet, "/v1/probes/fakeNamespace/appA", "")
+
+	var probe models.ServerProbeResponse
+
+	assert.Equal(v.T(), http.StatusOK, rec.Code, rec.Body.String())
+	assert.NoError(v.T(), json.Unmarshal(rec.Body.Bytes(), &probe))
+	assert.Equal(v.T(), "fakeNamespace/appA", probe.Probe)
+	assert.Equal(v.T(), int64(2), probe.Replicas)
+	assert.Equal(v.T(), "1m0s", probe.Spec.Soak)
+	assert.Empty(v.T(), probe.Containers)
+
+	for _, target := range []string{"/v1/probes?status=broken", "/v1/probes?sort=size", "/v1/probes?limit=-1", "/v1/probes?offset=first"} {
+		rec = v.serve(http.MethodGet, target, "")
+
+		assert.Equal(v.T(), http.StatusBadRequest, rec.Code, target)
+		assert.Equal(v.T(), "invalid_request", v.errorCode(rec), target)
+	}
+
+	rec = v.serve(http.MethodGet, "/v1/probes/fakeNamespace/missing", "")
+
+	assert.Equal(v.T(), http.StatusNotFound, rec.Code)
+	assert.Equal(v.T(), "probe_not_found", v.errorCode(rec))
+	assert.NoError(v.T(), Beacon.StopProbes(time.Second))
+}
+
+func (v *V1Suite) TestUnversionedRoutesAreDeprecated() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	v.beacon(mockController)
+	assert.NoError(v.T(), Beacon.StartProbe("fakeNamespace", "app", ProbeOptions{}, time.Hour))
+
+	rec := v.serve(http.MethodGet, "/probes", "")
+
+	assert.Equal(v.T(), http.StatusOK, rec.Code)
+	assert.Equal(v.T(), "true", rec.Header().Get("Deprecation"))
+	assert.Equal(v.T(), `</v1/probes>; rel="successor-version"`, rec.Header().Get("Link"))
+
+	rec = v.serve(http.MethodGet, "/v1/probes", "")
+
+	assert.Equal(v.T(), http.StatusOK, rec.Code)
+	assert.Empty(v.T(), rec.Header().Get("Deprecation"))
+
+	// Deleting used to respond as if something had been created
+	rec = v.serve(http.MethodDelete, "/probe?namespace=fakeNamespace&repo=app", "")
+
+	assert.Equal(v.T(), http.StatusOK, rec.Code)
+	assert.Equal(v.T(), `</v1/probes/{namespace}/{repo}>; rel="successor-version"`, rec.Header().Get("Link"))
+
+	_, ok := Beacon.GetProbe("fakeNamespace", "app")
+
+	assert.False(v.T(), ok)
+}
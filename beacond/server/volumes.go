@@ -0,0 +1,234 @@
+package server
+
+import (
+	"beacon/beacond/oci"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type BeaconErrorInvalidVolume struct{ error }
+type BeaconErrorVolumeDoesNotExist struct{ error }
+type BeaconErrorVolumeInUse struct{ error }
+
+// Volume names have to be valid in the name of a runtime volume, which is made from them. Hook names follow the same
+// rules
+var volumeNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// VolumeRef mounts a named volume owned by the probe into its containers. The volume outlives the containers, so
+// that a new digest picks up where the last one left off
+type VolumeRef struct {
+	Name string `json:"name"`
+	// An absolute path in the container
+	Target string `json:"target"`
+}
+
+// VolumeDetails describes a volume created for one of the beacon's probes
+type VolumeDetails struct {
+	oci.Volume
+	// The probe (as namespace/repo) the volume was created for, and the name it gave the volume
+	Probe      string
+	VolumeName string
+	// Whether the probe still exists. Volumes are kept when their probe is deleted, unless it is purged
+	ProbeExists bool
+}
+
+// checkVolumeRefs returns an error if any of the volumes of a new probe has an invalid name or isn't mounted at an
+// absolute path, or if two of them share a name or a path
+func checkVolumeRefs(refs []VolumeRef) error {
+	names := map[string]bool{}
+	targets := map[string]bool{}
+
+	for _, ref := range refs {
+		switch {
+		case !volumeNamePattern.MatchString(ref.Name):
+			return BeaconErrorInvalidVolume{fmt.Errorf("volume names can only contain letters, digits, _, . and -, got %q", ref.Name)}
+		case !strings.HasPrefix(ref.Target, "/"):
+			return BeaconErrorInvalidVolume{fmt.Errorf("volume %s must be mounted at an absolute path, got %q", ref.Name, ref.Target)}
+		case names[ref.Name]:
+			return BeaconErrorInvalidVolume{fmt.Errorf("volume %s is given more than once", ref.Name)}
+		case targets[ref.Target]:
+			return BeaconErrorInvalidVolume{fmt.Errorf("more than one volume is mounted at %s", ref.Target)}
+		}
+
+		names[ref.Name] = true
+		targets[ref.Target] = true
+	}
+
+	return nil
+}
+
+// volumeName is the name of the runtime volume for one of the probe's volumes. It only depends on the beacon, the
+// probe and the volume's name, so every container the probe runs mounts the same volume, whatever its digest. The
+// parts can contain the same characters as the separators between them, so the name ends with a hash of them, which
+// keeps the volumes of different probes and of different beacons on the same host apart
+func (b *beacon) volumeName(probe *Probe, name string) string {
+	hash := sha256.Sum256([]byte(b.ID + "\x00" + probe.Ref() + "\x00" + name))
+
+	return fmt.Sprintf("beacon_%s_%s_%s_%s", probe.Namespace, strings.ReplaceAll(probe.Repo, "/", "_"), name, hex.EncodeToString(hash[:6]))
+}
+
+// mountVolumes creates the probe's volumes if they don't exist yet, and adds them to the options its containers are
+// run with. Creating them here, rather than leaving it to the runtime, labels them with the probe that owns them.
+// Creating a volume that already exists succeeds whoever it belongs to, so each volume's labels are checked to make
+// sure the probe doesn't mount somebody else's data
+func (b *beacon) mountVolumes(ctx context.Context, probe *Probe, refs []VolumeRef, options *oci.RunOptions) error {
+	for _, ref := range refs {
+		name := b.volumeName(probe, ref.Name)
+
+		labels := map[string]string{
+			oci.LabelBeaconID: b.ID,
+			oci.LabelProbe:    probe.Ref(),
+			oci.LabelVolume:   ref.Name,
+		}
+
+		if err := b.OCIClient.CreateVolume(ctx, name, labels); err != nil {
+			return err
+		}
+
+		owned, err := b.OCIClient.ListVolumes(ctx, labels)
+
+		if err != nil {
+			return fmt.Errorf("error checking volume %s: %s", name, err)
+		}
+
+		if !containsVolume(owned, name) {
+			return fmt.Errorf("volume %s already exists, but wasn't created for volume %s of probe %s by this beacon", name, ref.Name, probe.Ref())
+		}
+
+		options.Volumes = append(options.Volumes, oci.VolumeMount{Name: name, Target: ref.Target})
+	}
+
+	return nil
+}
+
+func containsVolume(volumes []oci.Volume, name string) bool {
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListVolumes describes every volume created for the beacon's probes, including the probes that have been deleted
+// since, sorted by name
+func (b *beacon) ListVolumes() ([]VolumeDetails, error) {
+	ctx, cancel := context.WithTimeout(b.ctx, volumeTimeout)
+	defer cancel()
+
+	return b.listVolumes(ctx, map[string]string{oci.LabelBeaconID: b.ID})
+}
+
+// GetVolume describes one of the volumes created for the beacon's probes
+func (b *beacon) GetVolume(name string) (VolumeDetails, error) {
+	volumes, err := b.ListVolumes()
+
+	if err != nil {
+		return VolumeDetails{}, err
+	}
+
+	for _, volume := range volumes {
+		if volume.Name == name {
+			return volume, nil
+		}
+	}
+
+	return VolumeDetails{}, BeaconErrorVolumeDoesNotExist{fmt.Errorf("volume %s does not exist or was not created by this beacon", name)}
+}
+
+// RemoveVolume removes one of the volumes created for the beacon's probes, unless its probe still exists. The runtime
+// refuses to remove volumes that containers are still using, even if they are stopped
+func (b *beacon) RemoveVolume(name string) error {
+	volume, err := b.GetVolume(name)
+
+	if err != nil {
+		return err
+	}
+
+	if volume.ProbeExists {
+		return BeaconErrorVolumeInUse{fmt.Errorf("volume %s belongs to probe %s, which still exists", name, volume.Probe)}
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, volumeTimeout)
+	defer cancel()
+
+	return b.OCIClient.RemoveVolume(ctx, name)
+}
+
+// PurgeProbe removes what a deleted probe leaves behind: its containers, whatever state they are in, and its volumes
+func (b *beacon) PurgeProbe(namespace string, repo string) error {
+	if _, ok := b.GetProbe(namespace, repo); ok {
+		return BeaconErrorProbeAlreadyExists{fmt.Errorf("probe %s/%s still exists", namespace, repo)}
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, volumeTimeout)
+	defer cancel()
+
+	labels := map[string]string{oci.LabelBeaconID: b.ID, oci.LabelProbe: fmt.Sprintf("%s/%s", namespace, repo)}
+	containers, err := b.OCIClient.ListContainers(ctx, labels, nil)
+
+	if err != nil {
+		return fmt.Errorf("error listing containers to purge: %s", err)
+	}
+
+	var errs []error
+
+	for _, container := range containers {
+		if err := b.OCIClient.RemoveContainer(ctx, container.ID); err != nil && !errors.Is(err, oci.ErrNoSuchContainer) {
+			errs = append(errs, err)
+		}
+	}
+
+	// Volumes still used by a container that couldn't be removed can't be removed either
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	volumes, err := b.listVolumes(ctx, labels)
+
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range volumes {
+		if err := b.OCIClient.RemoveVolume(ctx, volume.Name); err != nil && !errors.Is(err, oci.ErrNoSuchVolume) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (b *beacon) listVolumes(ctx context.Context, labels map[string]string) ([]VolumeDetails, error) {
+	volumes, err := b.OCIClient.ListVolumes(ctx, labels)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing volumes: %s", err)
+	}
+
+	details := make([]VolumeDetails, 0, len(volumes))
+
+	for _, volume := range volumes {
+		probeRef := volume.Labels[oci.LabelProbe]
+		namespace, repo, _ := strings.Cut(probeRef, "/")
+		_, exists := b.GetProbe(namespace, repo)
+
+		details = append(details, VolumeDetails{
+			Volume:      volume,
+			Probe:       probeRef,
+			VolumeName:  volume.Labels[oci.LabelVolume],
+			ProbeExists: exists,
+		})
+	}
+
+	sort.Slice(details, func(i, j int) bool { return details[i].Name < details[j].Name })
+
+	return details, nil
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"beacon/beacond/host"
+	"beacon/beacond/oci"
+	"beacon/beacond/registry"
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type VolumesSuite struct {
+	suite.Suite
+	LogBuff *bytes.Buffer
+}
+
+func TestVolumesSuite(t *testing.T) {
+	suite.Run(t, new(VolumesSuite))
+}
+
+func (v *VolumesSuite) SetupTest() {
+	v.LogBuff = new(bytes.Buffer)
+	log.SetOutput(v.LogBuff)
+}
+
+func (v *VolumesSuite) TestInvalidVolumesAreRejected() {
+	beacon := newBeacon(nil, nil, nil, Config{}, host.Capacity{})
+
+	for _, volumes := range [][]VolumeRef{
+		{{Name: "../data", Target: "/data"}},
+		{{Name: "data", Target: "data"}},
+		{{Name: "data", Target: "/data"}, {Name: "data", Target: "/other"}},
+		{{Name: "data", Target: "/data"}, {Name: "other", Target: "/data"}},
+	} {
+		err := beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{Volumes: volumes}, time.Hour)
+
+		assert.IsType(v.T(), BeaconErrorInvalidVolume{}, err)
+	}
+
+	assert.Empty(v.T(), beacon.ListProbes())
+}
+
+func (v *VolumesSuite) TestVolumesAreKeptAcrossDigests() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{}, host.Capacity{})
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Volumes: []VolumeRef{{Name: "data", Target: "/data"}}})
+
+	name := beacon.volumeName(probe, "data")
+	labels := map[string]string{oci.LabelBeaconID: beacon.ID, oci.LabelProbe: "fakeNamespace/fakeRepo", oci.LabelVolume: "data"}
+	mounts := []oci.VolumeMount{{Name: name, Target: "/data"}}
+
+	assert.Regexp(v.T(), `^beacon_fakeNamespace_fakeRepo_data_[0-9a-f]{12}$`, name)
+
+	// The volume is created again for every container, which is a no-op once it exists
+	ociClient.EXPECT().CreateVolume(gomock.Any(), name, labels).Return(nil).Times(2)
+	ociClient.EXPECT().ListVolumes(gomock.Any(), labels).Return([]oci.Volume{{Name: name, Labels: labels}}, nil).Times(2)
+
+	for _, digest := range []string{"oldDigest", "newDigest"} {
+		ociClient.EXPECT().RunImage(gomock.Any(), "fakeNamespace/fakeRepo@"+digest, gomock.Any()).DoAndReturn(func(ctx context.Context, imageRef string, options oci.RunOptions) (string, error) {
+			assert.Equal(v.T(), mounts, options.Volumes)
+			return "fakeContainerId", nil
+		})
+
+		_, err := beacon.runImage(context.Background(), probe, digest, 0)
+
+		assert.NoError(v.T(), err)
+	}
+}
+
+func (v *VolumesSuite) TestVolumeNamesDontCollide() {
+	beacon := newBeacon(nil, nil, nil, Config{}, host.Capacity{})
+	other := newBeacon(nil, nil, nil, Config{}, host.Capacity{})
+	app := NewProbe(context.Background(), "ns", "app", ProbeOptions{})
+	appX := NewProbe(context.Background(), "ns", "app_x", ProbeOptions{})
+
+	// Without the hash, both would be beacon_ns_app_x_data
+	assert.NotEqual(v.T(), beacon.volumeName(app, "x_data"), beacon.volumeName(appX, "data"))
+	assert.NotEqual(v.T(), beacon.volumeName(app, "data"), other.volumeName(app, "data"))
+	assert.Equal(v.T(), beacon.volumeName(app, "data"), beacon.volumeName(app, "data"))
+}
+
+func (v *VolumesSuite) TestVolumeOwnedByAnotherProbeIsNotMounted() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{}, host.Capacity{})
+	probe := NewProbe(context.Background(), "fakeNamespace", "fakeRepo", ProbeOptions{Volumes: []VolumeRef{{Name: "data", Target: "/data"}}})
+
+	name := beacon.volumeName(probe, "data")
+	labels := map[string]string{oci.LabelBeaconID: beacon.ID, oci.LabelProbe: "fakeNamespace/fakeRepo", oci.LabelVolume: "data"}
+
+	// A volume with the name already exists without the probe's labels, so creating it is a no-op
+	ociClient.EXPECT().CreateVolume(gomock.Any(), name, labels).Return(nil)
+	ociClient.EXPECT().ListVolumes(gomock.Any(), labels).Return([]oci.Volume{}, nil)
+
+	_, err := beacon.runImage(context.Background(), probe, "fakeDigest", 0)
+
+	assert.EqualError(v.T(), err, fmt.Sprintf("volume %s already exists, but wasn't created for volume data of probe fakeNamespace/fakeRepo by this beacon", name))
+}
+
+func (v *VolumesSuite) TestVolumeIsNotRemovedWhileProbeExists() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	registryClient := registry.NewMockRegistry(mockController)
+	registryClient.EXPECT().LatestImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(registry.ImageDetails{}, fmt.Errorf("fake error")).AnyTimes()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, registryClient, nil, Config{}, host.Capacity{})
+
+	assert.NoError(v.T(), beacon.StartProbe("fakeNamespace", "fakeRepo", ProbeOptions{}, time.Hour))
+
+	ociClient.EXPECT().ListVolumes(gomock.Any(), map[string]string{oci.LabelBeaconID: beacon.ID}).Return([]oci.Volume{
+		{Name: "volumeB", Labels: map[string]string{oci.LabelProbe: "fakeNamespace/deletedRepo", oci.LabelVolume: "cache"}},
+		{Name: "volumeA", Labels: map[string]string{oci.LabelProbe: "fakeNamespace/fakeRepo", oci.LabelVolume: "data"}},
+	}, nil).AnyTimes()
+
+	volumes, err := beacon.ListVolumes()
+
+	assert.NoError(v.T(), err)
+	assert.Equal(v.T(), "volumeA", volumes[0].Name)
+	assert.Equal(v.T(), "fakeNamespace/fakeRepo", volumes[0].Probe)
+	assert.Equal(v.T(), "data", volumes[0].VolumeName)
+	assert.True(v.T(), volumes[0].ProbeExists)
+	assert.False(v.T(), volumes[1].ProbeExists)
+
+	err = beacon.RemoveVolume("volumeA")
+
+	assert.IsType(v.T(), BeaconErrorVolumeInUse{}, err)
+
+	err = beacon.RemoveVolume("missingVolume")
+
+	assert.IsType(v.T(), BeaconErrorVolumeDoesNotExist{}, err)
+
+	ociClient.EXPECT().RemoveVolume(gomock.Any(), "volumeB").Return(nil)
+
+	assert.NoError(v.T(), beacon.RemoveVolume("volumeB"))
+	assert.NoError(v.T(), beacon.StopProbes(time.Second))
+}
+
+func (v *VolumesSuite) TestPurgeRemovesContainersThenVolumes() {
+	mockController := gomock.NewController(v.T())
+	defer mockController.Finish()
+
+	ociClient := oci.NewMockOCIRuntime(mockController)
+	beacon := newBeacon(ociClient, nil, nil, Config{}, host.Capacity{})
+	labels := map[string]string{oci.LabelBeaconID: beacon.ID, oci.LabelProbe: "fakeNamespace/fakeRepo"}
+
+	gomock.InOrder(
+		ociClient.EXPECT().ListContainers(gomock.Any(), labels, nil).Return([]oci.Container{{ID: "runningContainer"}, {ID: "exitedContainer"}}, nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "runningContainer").Return(nil),
+		ociClient.EXPECT().RemoveContainer(gomock.Any(), "exitedContainer").Return(nil),
+		ociClient.EXPECT().ListVolumes(gomock.Any(), labels).Return([]oci.Volume{{Name: "fakeVolume"}}, nil),
+		ociClient.EXPECT().RemoveVolume(gomock.Any(), "fakeVolume").Return(nil),
+	)
+
+	assert.NoError(v.T(), beacon.PurgeProbe("fakeNamespace", "fakeRepo"))
+}
@@ -0,0 +1,166 @@
+package signature
+
+import (
+	"beacon/beacond/registry"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	cosignSignatureMediaType  = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignSignatureType       = "cosign container image signature"
+)
+
+// CosignVerifier verifies signatures created with `cosign sign --key`, which are pushed to the same repo as the
+// image they sign as an OCI artifact tagged `sha256-<digest>.sig`.
+// See https://github.com/sigstore/cosign/blob/main/specs/SIGNATURE_SPEC.md
+type CosignVerifier struct {
+	Distribution *registry.DistributionClient
+	keys         []crypto.PublicKey
+}
+
+// The simple signing payload that is signed by cosign
+type cosignPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]interface{} `json:"optional"`
+}
+
+func NewCosignVerifier(registryURL string, keys []crypto.PublicKey, keychain *registry.Keychain) (Verifier, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one public key is needed to verify cosign signatures")
+	}
+
+	return &CosignVerifier{
+		Distribution: registry.NewDistributionClient(registryURL, keychain),
+		keys:         keys,
+	}, nil
+}
+
+func (c *CosignVerifier) Type() VerifierType {
+	return Cosign
+}
+
+// Verify checks that at least one signature attached to the digest was made by one of the trusted keys
+func (c *CosignVerifier) Verify(ctx context.Context, namespace string, repo string, digest string) error {
+	repository := fmt.Sprintf("%s/%s", namespace, repo)
+	algorithm, hash, ok := strings.Cut(digest, ":")
+
+	if !ok {
+		return fmt.Errorf("invalid digest %s", digest)
+	}
+
+	signatureTag := fmt.Sprintf("%s-%s.sig", algorithm, hash)
+	manifest, err := c.Distribution.Manifest(ctx, repository, signatureTag)
+
+	if err != nil {
+		return fmt.Errorf("error fetching signatures for %s@%s: %s", repository, digest, err)
+	}
+
+	var failures []string
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != cosignSignatureMediaType {
+			continue
+		}
+
+		err := c.verifyLayer(ctx, repository, digest, layer)
+
+		if err == nil {
+			return nil
+		}
+
+		failures = append(failures, err.Error())
+	}
+
+	if len(failures) == 0 {
+		return fmt.Errorf("no signatures found for %s@%s", repository, digest)
+	}
+
+	return fmt.Errorf("no valid signatures found for %s@%s: %s", repository, digest, strings.Join(failures, "; "))
+}
+
+func (c *CosignVerifier) verifyLayer(ctx context.Context, repository string, digest string, layer registry.Descriptor) error {
+	encodedSignature, ok := layer.Annotations[cosignSignatureAnnotation]
+
+	if !ok {
+		return fmt.Errorf("signature layer %s has no signature annotation", layer.Digest)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+
+	if err != nil {
+		return fmt.Errorf("error decoding signature in layer %s: %s", layer.Digest, err)
+	}
+
+	payload, err := c.Distribution.Blob(ctx, repository, layer.Digest)
+
+	if err != nil {
+		return fmt.Errorf("error fetching signature payload %s: %s", layer.Digest, err)
+	}
+
+	payloadHash := sha256.Sum256(payload)
+
+	if layer.Digest != "sha256:"+hex.EncodeToString(payloadHash[:]) {
+		return fmt.Errorf("signature payload does not match layer digest %s", layer.Digest)
+	}
+
+	if !c.verifySignature(payload, payloadHash[:], signature) {
+		return fmt.Errorf("signature in layer %s was not made by a trusted key", layer.Digest)
+	}
+
+	// Only trust the contents of the payload once we know it has been signed by a trusted key
+	var p cosignPayload
+
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("error unmarshalling signature payload %s: %s", layer.Digest, err)
+	}
+
+	if p.Critical.Type != cosignSignatureType {
+		return fmt.Errorf("signature payload %s has unexpected type %q", layer.Digest, p.Critical.Type)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature in layer %s is for digest %s", layer.Digest, p.Critical.Image.DockerManifestDigest)
+	}
+
+	return nil
+}
+
+func (c *CosignVerifier) verifySignature(payload []byte, payloadHash []byte, signature []byte) bool {
+	for _, key := range c.keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, payloadHash, signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, payloadHash, signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,200 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const testDigest = "sha256:e4498843f8684e957e3068546ed930b30d43180e2e8c2579d39d637bd2fe79de"
+
+// fakeRegistry serves manifests and blobs for a single repo, as a local registry would
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	token     string
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		json.NewEncoder(w).Encode(map[string]string{"token": f.token})
+		return
+	}
+
+	if f.token != "" && r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="fake"`, r.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var found []byte
+	var ok bool
+
+	switch dir, name := filepath.Split(r.URL.Path); dir {
+	case "/v2/namespace/repo/manifests/":
+		found, ok = f.manifests[name]
+	case "/v2/namespace/repo/blobs/":
+		found, ok = f.blobs[name]
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Write(found)
+}
+
+// sign pushes a cosign style signature of the digest, signed with the key, to the registry
+func (f *fakeRegistry) sign(key *ecdsa.PrivateKey, digest string) {
+	payload := cosignPayload{}
+	payload.Critical.Identity.DockerReference = "localhost/namespace/repo"
+	payload.Critical.Image.DockerManifestDigest = digest
+	payload.Critical.Type = cosignSignatureType
+
+	payloadBytes, _ := json.Marshal(payload)
+	payloadHash := sha256.Sum256(payloadBytes)
+	signature, _ := ecdsa.SignASN1(rand.Reader, key, payloadHash[:])
+	payloadDigest := "sha256:" + hex.EncodeToString(payloadHash[:])
+
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers": []map[string]interface{}{{
+			"mediaType":   cosignSignatureMediaType,
+			"digest":      payloadDigest,
+			"size":        len(payloadBytes),
+			"annotations": map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+		}},
+	})
+
+	f.manifests["sha256-e4498843f8684e957e3068546ed930b30d43180e2e8c2579d39d637bd2fe79de.sig"] = manifest
+	f.blobs[payloadDigest] = payloadBytes
+}
+
+type CosignSuite struct {
+	suite.Suite
+	Key      *ecdsa.PrivateKey
+	Registry *fakeRegistry
+	Server   *httptest.Server
+}
+
+func TestCosignSuite(t *testing.T) {
+	suite.Run(t, new(CosignSuite))
+}
+
+func (c *CosignSuite) SetupTest() {
+	c.Key, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Registry = &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	c.Server = httptest.NewServer(c.Registry)
+}
+
+func (c *CosignSuite) TearDownTest() {
+	c.Server.Close()
+}
+
+func (c *CosignSuite) verifier(keys ...crypto.PublicKey) Verifier {
+	verifier, err := NewCosignVerifier(c.Server.URL, keys, nil)
+	assert.NoError(c.T(), err)
+
+	return verifier
+}
+
+func (c *CosignSuite) TestType() {
+	assert.Equal(c.T(), Cosign, c.verifier(c.Key.Public()).Type())
+}
+
+func (c *CosignSuite) TestVerifySignedOK() {
+	c.Registry.sign(c.Key, testDigest)
+
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.NoError(c.T(), err)
+}
+
+func (c *CosignSuite) TestVerifyWithBearerTokenOK() {
+	c.Registry.token = "fake-token"
+	c.Registry.sign(c.Key, testDigest)
+
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.NoError(c.T(), err)
+}
+
+func (c *CosignSuite) TestVerifyAnyTrustedKeyOK() {
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Registry.sign(c.Key, testDigest)
+
+	err := c.verifier(otherKey.Public(), c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.NoError(c.T(), err)
+}
+
+func (c *CosignSuite) TestVerifyUnsignedErrors() {
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.ErrorContains(c.T(), err, "error fetching signatures for namespace/repo@"+testDigest)
+}
+
+func (c *CosignSuite) TestVerifyUntrustedKeyErrors() {
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Registry.sign(otherKey, testDigest)
+
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.ErrorContains(c.T(), err, "was not made by a trusted key")
+}
+
+func (c *CosignSuite) TestVerifyWrongDigestErrors() {
+	// A valid signature for another digest must not be accepted if it is copied to this digest's signature tag
+	c.Registry.sign(c.Key, "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.ErrorContains(c.T(), err, "is for digest sha256:0000000000000000000000000000000000000000000000000000000000000000")
+}
+
+func (c *CosignSuite) TestVerifyTamperedPayloadErrors() {
+	c.Registry.sign(c.Key, testDigest)
+
+	for digest := range c.Registry.blobs {
+		c.Registry.blobs[digest] = []byte(`{"critical":{}}`)
+	}
+
+	err := c.verifier(c.Key.Public()).Verify(context.Background(), "namespace", "repo", testDigest)
+	assert.ErrorContains(c.T(), err, "signature payload does not match layer digest")
+}
+
+func (c *CosignSuite) TestNewVerifierFromKeyFileOK() {
+	c.Registry.sign(c.Key, testDigest)
+
+	der, _ := x509.MarshalPKIXPublicKey(c.Key.Public())
+	keyPath := filepath.Join(c.T().TempDir(), "cosign.pub")
+	os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600)
+
+	verifier, err := NewVerifier(Cosign, c.Server.URL, []string{keyPath}, nil)
+	assert.NoError(c.T(), err)
+	assert.NoError(c.T(), verifier.Verify(context.Background(), "namespace", "repo", testDigest))
+}
+
+func TestLoadPublicKeysErrors(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "cosign.pub")
+	os.WriteFile(keyPath, []byte("not a key"), 0600)
+
+	_, err := LoadPublicKeys([]string{keyPath})
+	assert.ErrorContains(t, err, "no public keys found")
+
+	_, err = LoadPublicKeys([]string{filepath.Join(t.TempDir(), "missing.pub")})
+	assert.ErrorContains(t, err, "error reading public key")
+}
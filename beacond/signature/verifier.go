@@ -0,0 +1,73 @@
+package signature
+
+import (
+	"beacon/beacond/registry"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+const (
+	Cosign VerifierType = "cosign"
+)
+
+type VerifierType string
+
+// Verifier checks that an image digest was signed by one of the keys trusted by beacond before it is deployed
+type Verifier interface {
+	Type() VerifierType
+	Verify(context.Context, string, string, string) error
+}
+
+// NewVerifier creates a verifier trusting the keys at keyPaths. Signatures are fetched from the registry at
+// registryURL, with the keychain's credentials for it if it has any
+func NewVerifier(verifierType VerifierType, registryURL string, keyPaths []string, keychain *registry.Keychain) (Verifier, error) {
+	keys, err := LoadPublicKeys(keyPaths)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch verifierType {
+	case Cosign:
+		return NewCosignVerifier(registryURL, keys, keychain)
+	default:
+		return nil, fmt.Errorf("verifier type not supported: %s", verifierType)
+	}
+}
+
+// LoadPublicKeys reads PEM encoded public keys (as generated by `cosign generate-key-pair`) from the paths given
+func LoadPublicKeys(paths []string) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+
+	for _, path := range paths {
+		contents, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading public key %s: %s", path, err)
+		}
+
+		for block, rest := pem.Decode(contents); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "PUBLIC KEY" {
+				continue
+			}
+
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing public key %s: %s", path, err)
+			}
+
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no public keys found in %v", paths)
+	}
+
+	return keys, nil
+}
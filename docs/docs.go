@@ -15,6 +15,24 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/approvals": {
+            "get": {
+                "description": "lists the probes waiting for a new digest to be approved, with the digest, tag and when it was pushed",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lists digests waiting to be approved",
+                "deprecated": true,
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListApprovalsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/beacon": {
             "get": {
                 "description": "describes the current status of beacond",
@@ -22,6 +40,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "summary": "Get beacon details",
+                "deprecated": true,
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -34,11 +53,28 @@ const docTemplate = `{
         },
         "/health": {
             "get": {
-                "description": "reports the health of the beacond server",
+                "description": "reports that the beacond server is running and answering requests, without checking what it depends on",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Liveness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/live": {
+            "get": {
+                "description": "reports that the beacond server is running and answering requests, without checking what it depends on",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Health check",
+                "summary": "Liveness check",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -49,6 +85,29 @@ const docTemplate = `{
                 }
             }
         },
+        "/health/ready": {
+            "get": {
+                "description": "checks the container runtime, the registry and its rate limit, the reconcile loop and the state file, reporting the status and latency of each. The status is ok, degraded or failing, and beacond is only unready while a component is failing",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/probe": {
             "post": {
                 "description": "creates a probe for the namespace and repo provided in the URL query parameters",
@@ -56,6 +115,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "summary": "Create a probe",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
@@ -70,6 +130,164 @@ const docTemplate = `{
                         "name": "repo",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the relative CPU weight of the probe's containers (1024 by default)",
+                        "name": "cpu_shares",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "the number of CPUs the probe's containers can use, enforced as a CPU quota",
+                        "name": "cpus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the memory limit of the probe's containers, in bytes or with a k, m or g suffix",
+                        "name": "memory",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the maximum number of processes in each of the probe's containers",
+                        "name": "pids_limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of containers to run for the probe (1 by default)",
+                        "name": "replicas",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the hostname the proxy routes to the probe's containers (any hostname if left out)",
+                        "name": "host",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the path prefix the proxy routes to the probe's containers (any path if left out)",
+                        "name": "path_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the container port the proxy sends requests to, which gives the probe a route",
+                        "name": "port",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "a path that has to return a 2xx or 3xx response before a new container is routed to",
+                        "name": "health_path",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "an environment variable to set in the probe's containers, as VARIABLE=value. Can be repeated",
+                        "name": "env",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a secret to set as an environment variable, as VARIABLE=secret-name. Can be repeated",
+                        "name": "secret_env",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a secret to mount as a read only file, as /path/in/container=secret-name. Can be repeated",
+                        "name": "secret_file",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a notification sink to tell about the probe, on top of those told about every probe. Can be repeated",
+                        "name": "notify",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether new digests wait to be approved before they are deployed",
+                        "name": "require_approval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "how long a new digest has to stay the latest before it is deployed, such as 10m",
+                        "name": "soak",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a probe, as namespace/repo, that has to be running before the probe's containers are started. Can be repeated",
+                        "name": "depends_on",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether the probe's containers are restarted when one of its dependencies is redeployed",
+                        "name": "restart_with_dependencies",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether the probe's containers stay off the network beacon creates for managed containers",
+                        "name": "no_network",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "an existing network the probe's containers join on top of beacon's network. Can be repeated",
+                        "name": "network",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a named volume owned by the probe, as name:/path/in/container. Can be repeated",
+                        "name": "volume",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "a hook run from each new digest, as JSON such as {\"name\":\"migrate\",\"phase\":\"pre_deploy\",\"command\":[\"./migrate\"],\"env\":{},\"volumes\":[\"data:/data\"],\"timeout\":\"10m\"}. Can be repeated",
+                        "name": "hook",
+                        "in": "query",
+                        "collectionFormat": "multi"
                     }
                 ],
                 "responses": {
@@ -97,11 +315,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/server.BaseResponse"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/server.BaseResponse"
                         }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
                     }
                 }
             },
@@ -111,6 +347,7 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "summary": "Delete a probe",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
@@ -125,11 +362,17 @@ const docTemplate = `{
                         "name": "repo",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether to also remove the probe's containers and volumes",
+                        "name": "purge",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/server.BaseResponse"
                         }
@@ -153,63 +396,2051 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/probes": {
-            "get": {
-                "description": "lists probes that are running for beacond",
+            },
+            "patch": {
+                "description": "changes the number of containers run for the probe, without redeploying the ones that are kept",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Lists all probes",
+                "summary": "Scale a probe",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the repo namespace the probe should check for image updates",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the repo name which the probe should check for image updates",
+                        "name": "repo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of containers to run for the probe",
+                        "name": "replicas",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/server.ListProbesResponse"
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "server.BaseResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                }
-            }
         },
-        "server.BeaconDescribeResponse": {
-            "type": "object",
-            "properties": {
-                "probes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+        "/probe/approve": {
+            "post": {
+                "description": "deploys the digest waiting to be approved for the probe",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Approve a digest",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the repo namespace the probe should check for image updates",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the repo name which the probe should check for image updates",
+                        "name": "repo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the digest expected to be waiting, which is refused if another one is",
+                        "name": "digest",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
                     }
-                },
-                "registry": {
-                    "type": "string"
-                },
-                "runtime": {
-                    "type": "string"
                 }
             }
         },
-        "server.ListProbesResponse": {
-            "type": "object",
-            "properties": {
-                "probes": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+        "/probe/reject": {
+            "post": {
+                "description": "skips the digest waiting to be approved for the probe, which keeps running its current digest",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Reject a digest",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the repo namespace the probe should check for image updates",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the repo name which the probe should check for image updates",
+                        "name": "repo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the digest expected to be waiting, which is refused if another one is",
+                        "name": "digest",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/probes": {
+            "get": {
+                "description": "lists probes that are running for beacond",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lists all probes",
+                "deprecated": true,
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListProbesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/secret": {
+            "get": {
+                "description": "describes the secret named in the URL query parameters. Its value is never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Describe a secret",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.SecretResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "creates or replaces the secret named in the URL query parameters. The value is sent in the body, so that it doesn't end up in access logs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Set a secret",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.SetSecretRequest"
+                        },
+                        "description": "the value of the secret",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "deletes the secret named in the URL query parameters, unless a probe still references it",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a secret",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/secrets": {
+            "get": {
+                "description": "lists the names of the secrets in the beacon's secret store",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lists all secrets",
+                "deprecated": true,
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListSecretsResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/approvals": {
+            "get": {
+                "description": "lists the probes waiting for a new digest to be approved, with the digest, tag and when it was pushed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Lists digests waiting to be approved",
+                "operationId": "listApprovals",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListApprovalsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/beacon": {
+            "get": {
+                "description": "describes the current status of beacond",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Get beacon details",
+                "operationId": "getBeacon",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BeaconDescribeResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/probes": {
+            "get": {
+                "description": "lists the probes with the status filter, in the sort order and on the page asked for, summing up the state of each",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Lists probes",
+                "operationId": "listProbes",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "only list probes with this status, such as exited. Can be repeated",
+                        "name": "status",
+                        "in": "query",
+                        "collectionFormat": "multi"
+                    },
+                    {
+                        "type": "string",
+                        "description": "what to sort by: name (the default), status, last_checked, last_updated or restarts, prefixed with - to reverse the order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the most probes to list (all of them if left out)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of probes to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ProbeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "creates a probe for the namespace and repo in the body, which starts checking the repo for new digests to deploy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Create a probe",
+                "operationId": "createProbe",
+                "parameters": [
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.CreateProbeRequest"
+                        },
+                        "description": "the probe to create",
+                        "name": "probe",
+                        "in": "body",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/probes/{namespace}/{repo}": {
+            "get": {
+                "description": "describes the probe: how it was created, its state, its containers as the runtime sees them, its events and how the latest deploy went",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Describe a probe",
+                "operationId": "getProbe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of the probe's repo",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the probe's repo",
+                        "name": "repo",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ProbeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "deletes the probe, keeping its containers and volumes unless purge is set",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Delete a probe",
+                "operationId": "deleteProbe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of the probe's repo",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the probe's repo",
+                        "name": "repo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether to also remove the probe's containers and volumes",
+                        "name": "purge",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "changes the number of containers run for the probe, without redeploying the ones that are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Update a probe",
+                "operationId": "updateProbe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of the probe's repo",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the probe's repo",
+                        "name": "repo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.UpdateProbeRequest"
+                        },
+                        "description": "the changes to make to the probe",
+                        "name": "update",
+                        "in": "body",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/probes/{namespace}/{repo}/approve": {
+            "post": {
+                "description": "deploys the digest waiting to be approved for the probe",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Approve a digest",
+                "operationId": "approveProbe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of the probe's repo",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the probe's repo",
+                        "name": "repo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.DecisionRequest"
+                        },
+                        "description": "the digest expected to be waiting, which is refused if another one is",
+                        "name": "decision",
+                        "in": "body"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/probes/{namespace}/{repo}/reject": {
+            "post": {
+                "description": "skips the digest waiting to be approved for the probe, which keeps running its current digest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Reject a digest",
+                "operationId": "rejectProbe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of the probe's repo",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the probe's repo",
+                        "name": "repo",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.DecisionRequest"
+                        },
+                        "description": "the digest expected to be waiting, which is refused if another one is",
+                        "name": "decision",
+                        "in": "body"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/secrets": {
+            "get": {
+                "description": "lists the names of the secrets in the beacon's secret store",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Lists all secrets",
+                "operationId": "listSecrets",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListSecretsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/secrets/{name}": {
+            "get": {
+                "description": "describes the secret. Its value is never returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Describe a secret",
+                "operationId": "getSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.SecretResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "creates or replaces the secret. The value is sent in the body, so that it doesn't end up in access logs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Set a secret",
+                "operationId": "setSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "schema": {
+                            "$ref": "#/definitions/server.SetSecretRequest"
+                        },
+                        "description": "the value of the secret",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "deletes the secret, unless a probe still references it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Delete a secret",
+                "operationId": "deleteSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the secret",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/volumes": {
+            "get": {
+                "description": "lists the volumes created for the beacon's probes, including those of probes that have been deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Lists all volumes",
+                "operationId": "listVolumes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListVolumesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/volumes/{name}": {
+            "get": {
+                "description": "describes the volume, with its size and the probe that owns it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Describe a volume",
+                "operationId": "getVolume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the volume",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.VolumeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "deletes the volume, unless the probe that owns it still exists",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "v1"
+                ],
+                "summary": "Delete a volume",
+                "operationId": "deleteVolume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the volume",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/volume": {
+            "get": {
+                "description": "describes the volume named in the URL query parameters, with its size and the probe that owns it",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Describe a volume",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the volume",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.VolumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "deletes the volume named in the URL query parameters, unless the probe that owns it still exists",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a volume",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the volume",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/volumes": {
+            "get": {
+                "description": "lists the volumes created for the beacon's probes, including those of probes that have been deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Lists all volumes",
+                "deprecated": true,
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.ListVolumesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/server.BaseResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "server.Approval": {
+            "type": "object",
+            "properties": {
+                "current_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "found_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "probe": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "pushed_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "tag": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.BaseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.BeaconDescribeResponse": {
+            "type": "object",
+            "properties": {
+                "allocated": {
+                    "$ref": "#/definitions/server.HostResources",
+                    "x-omitempty": false
+                },
+                "capacity": {
+                    "$ref": "#/definitions/server.HostResources",
+                    "x-omitempty": false
+                },
+                "probes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": false
+                },
+                "registry": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "runtime": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ComponentResponse": {
+            "type": "object",
+            "properties": {
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.ContainerResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "container_id": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "exit_code": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "health": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "replica": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "started_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "status": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.CreateProbeRequest": {
+            "type": "object",
+            "required": [
+                "namespace",
+                "repo"
+            ],
+            "properties": {
+                "depends_on": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "hooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.HookRequest"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "networks": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "no_network": {
+                    "type": "boolean"
+                },
+                "notify": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "replicas": {
+                    "type": "integer"
+                },
+                "repo": {
+                    "type": "string"
+                },
+                "require_approval": {
+                    "type": "boolean"
+                },
+                "resources": {
+                    "$ref": "#/definitions/server.ResourcesRequest"
+                },
+                "restart_with_dependencies": {
+                    "type": "boolean"
+                },
+                "route": {
+                    "$ref": "#/definitions/server.RouteRequest"
+                },
+                "secrets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.SecretRefRequest"
+                    }
+                },
+                "soak": {
+                    "type": "string"
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.VolumeRefRequest"
+                    }
+                }
+            }
+        },
+        "server.DecisionRequest": {
+            "type": "object",
+            "properties": {
+                "digest": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.DeployResponse": {
+            "type": "object",
+            "properties": {
+                "digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "error": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "finished_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "hooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.HookResultResponse"
+                    },
+                    "x-omitempty": false
+                },
+                "started_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "succeeded": {
+                    "type": "boolean",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.EventResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "first_seen": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "last_seen": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "message": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "reason": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.HookRequest": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phase": {
+                    "type": "string"
+                },
+                "timeout": {
+                    "type": "string"
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.VolumeRefRequest"
+                    }
+                }
+            }
+        },
+        "server.HookResultResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "exit_code": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "finished_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "name": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "output": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "phase": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "started_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.HostResources": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "type": "number",
+                    "x-omitempty": false
+                },
+                "memory": {
+                    "type": "integer",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ImageResponse": {
+            "type": "object",
+            "properties": {
+                "digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "pushed_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "tag": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ListApprovalsResponse": {
+            "type": "object",
+            "properties": {
+                "approvals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.Approval"
+                    },
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ListProbesResponse": {
+            "type": "object",
+            "properties": {
+                "probes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "server.ListSecretsResponse": {
+            "type": "object",
+            "properties": {
+                "secrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ListVolumesResponse": {
+            "type": "object",
+            "properties": {
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.VolumeResponse"
+                    },
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ProbeListResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "offset": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "probes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.ProbeSummaryResponse"
+                    },
+                    "x-omitempty": false
+                },
+                "total": {
+                    "type": "integer",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ProbeResponse": {
+            "type": "object",
+            "properties": {
+                "candidate": {
+                    "$ref": "#/definitions/server.ImageResponse",
+                    "x-omitempty": false
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.ContainerResponse"
+                    },
+                    "x-omitempty": false
+                },
+                "current_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.EventResponse"
+                    },
+                    "x-omitempty": false
+                },
+                "last_checked": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "last_deploy": {
+                    "$ref": "#/definitions/server.DeployResponse",
+                    "x-omitempty": false
+                },
+                "last_error": {
+                    "$ref": "#/definitions/server.EventResponse",
+                    "x-omitempty": false
+                },
+                "last_updated": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "latest_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "namespace": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "probe": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "rejected_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "replicas": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "repo": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "restarts": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "running": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "soaking": {
+                    "$ref": "#/definitions/server.ImageResponse",
+                    "x-omitempty": false
+                },
+                "soaking_since": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "spec": {
+                    "$ref": "#/definitions/server.CreateProbeRequest",
+                    "x-omitempty": false
+                },
+                "status": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ProbeSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "current_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "last_checked": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "last_error": {
+                    "$ref": "#/definitions/server.EventResponse",
+                    "x-omitempty": false
+                },
+                "last_updated": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "latest_digest": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "namespace": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "probe": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "replicas": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "repo": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "restarts": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "running": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "status": {
+                    "type": "string",
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/server.ComponentResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.ResourcesRequest": {
+            "type": "object",
+            "properties": {
+                "cpu_shares": {
+                    "type": "integer"
+                },
+                "cpus": {
+                    "type": "number"
+                },
+                "memory": {
+                    "type": "string"
+                },
+                "pids_limit": {
+                    "type": "integer"
+                }
+            }
+        },
+        "server.RouteRequest": {
+            "type": "object",
+            "properties": {
+                "health_path": {
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "path_prefix": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                }
+            }
+        },
+        "server.SecretRefRequest": {
+            "type": "object",
+            "properties": {
+                "env": {
+                    "type": "string"
+                },
+                "file": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.SecretResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "updated_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "used_by": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-omitempty": false
+                }
+            }
+        },
+        "server.SetSecretRequest": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.UpdateProbeRequest": {
+            "type": "object",
+            "required": [
+                "replicas"
+            ],
+            "properties": {
+                "replicas": {
+                    "type": "integer"
+                }
+            }
+        },
+        "server.VolumeRefRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "server.VolumeResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "mountpoint": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "name": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "probe": {
+                    "type": "string",
+                    "x-omitempty": false
+                },
+                "probe_exists": {
+                    "type": "boolean",
+                    "x-omitempty": false
+                },
+                "size": {
+                    "type": "integer",
+                    "x-omitempty": false
+                },
+                "volume": {
+                    "type": "string",
+                    "x-omitempty": false
                 }
             }
         }